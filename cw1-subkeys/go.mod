@@ -42,3 +42,12 @@ require (
 	github.com/josharian/intern v1.0.0 // indirect
 	github.com/stretchr/testify v1.8.4
 )
+
+// the subkeys contract forwards the in-repo whitelist's inheritance messages (heartbeat, set, claim
+// and sweep inheritance, the inheritance query) and records admin activity with SaveLastActivity,
+// none of which the published module has yet
+replace github.com/JackalLabs/burrow-contracts/cw1-whitelist => ../cw1-whitelist
+
+require github.com/JackalLabs/burrow-contracts/storage v0.0.0
+
+replace github.com/JackalLabs/burrow-contracts/storage => ../storage
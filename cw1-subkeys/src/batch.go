@@ -0,0 +1,150 @@
+package src
+
+import (
+	"errors"
+	"strconv"
+
+	contractTypes "github.com/JackalLabs/burrow-contracts/cw1-subkeys/src/types"
+
+	"github.com/CosmWasm/cosmwasm-go/std"
+	"github.com/CosmWasm/cosmwasm-go/std/types"
+	cw1WhiteList "github.com/JackalLabs/burrow-contracts/cw1-whitelist/src"
+)
+
+// checkBatch makes sure the sender is an admin and every spender is valid and appears once,
+// before any entry is written
+func checkBatch(deps *std.Deps, sender string, spenders []string) error {
+	state, err := cw1WhiteList.LoadState(deps.Storage)
+	if err != nil {
+		return err
+	}
+
+	// check if sender is admin
+	if !state.IsAdmin(sender) {
+		return errors.New("Unauthorized")
+	}
+
+	if len(spenders) == 0 {
+		return errors.New("Empty batch")
+	}
+
+	seen := make(map[string]bool, len(spenders))
+	for _, spender := range spenders {
+		err = deps.Api.ValidateAddress(spender)
+		if err != nil {
+			return errors.New(spender + ": " + err.Error())
+		}
+
+		// sender can't be spender
+		if spender == sender {
+			return errors.New("Cannot Set Your own Account")
+		}
+
+		if seen[spender] {
+			return errors.New("Duplicate spender " + spender)
+		}
+		seen[spender] = true
+	}
+
+	return nil
+}
+
+// batchAttributes lists every spender of the batch, in order
+func batchAttributes(action string, sender string, spenders []string) []types.EventAttribute {
+	attributes := []types.EventAttribute{
+		{Key: "action", Value: action},
+		{Key: "owner", Value: sender},
+		{Key: "count", Value: strconv.Itoa(len(spenders))},
+	}
+	for _, spender := range spenders {
+		attributes = append(attributes, types.EventAttribute{Key: "spender", Value: spender})
+	}
+	return attributes
+}
+
+func executeBatchIncreaseAllowance(deps *std.Deps, env *types.Env, info *types.MessageInfo, msg *contractTypes.BatchIncreaseAllowance) (*types.Response, error) {
+	sender := info.Sender
+
+	spenders := make([]string, len(msg.Allowances))
+	for i, entry := range msg.Allowances {
+		spenders[i] = entry.Spender
+	}
+
+	err := checkBatch(deps, sender, spenders)
+	if err != nil {
+		return nil, err
+	}
+
+	var messages []types.SubMsg
+	for i := range msg.Allowances {
+		hooks, err := increaseAllowance(deps, env, sender, &msg.Allowances[i])
+		if err != nil {
+			return nil, errors.New(msg.Allowances[i].Spender + ": " + err.Error())
+		}
+		messages = append(messages, hooks...)
+	}
+
+	res := &types.Response{
+		Attributes: batchAttributes("batch_increase_allowance", sender, spenders),
+		Messages:   messages,
+	}
+	return res, nil
+}
+
+func executeBatchDecreaseAllowance(deps *std.Deps, env *types.Env, info *types.MessageInfo, msg *contractTypes.BatchDecreaseAllowance) (*types.Response, error) {
+	sender := info.Sender
+
+	spenders := make([]string, len(msg.Allowances))
+	for i, entry := range msg.Allowances {
+		spenders[i] = entry.Spender
+	}
+
+	err := checkBatch(deps, sender, spenders)
+	if err != nil {
+		return nil, err
+	}
+
+	var messages []types.SubMsg
+	for i := range msg.Allowances {
+		hooks, err := decreaseAllowance(deps, env, sender, &msg.Allowances[i])
+		if err != nil {
+			return nil, errors.New(msg.Allowances[i].Spender + ": " + err.Error())
+		}
+		messages = append(messages, hooks...)
+	}
+
+	res := &types.Response{
+		Attributes: batchAttributes("batch_decrease_allowance", sender, spenders),
+		Messages:   messages,
+	}
+	return res, nil
+}
+
+func executeBatchSetPermissions(deps *std.Deps, env *types.Env, info *types.MessageInfo, msg *contractTypes.BatchSetPermissions) (*types.Response, error) {
+	sender := info.Sender
+
+	spenders := make([]string, len(msg.Permissions))
+	for i, entry := range msg.Permissions {
+		spenders[i] = entry.Spender
+	}
+
+	err := checkBatch(deps, sender, spenders)
+	if err != nil {
+		return nil, err
+	}
+
+	var messages []types.SubMsg
+	for i := range msg.Permissions {
+		hooks, err := setPermissions(deps, env, sender, &msg.Permissions[i])
+		if err != nil {
+			return nil, errors.New(msg.Permissions[i].Spender + ": " + err.Error())
+		}
+		messages = append(messages, hooks...)
+	}
+
+	res := &types.Response{
+		Attributes: batchAttributes("batch_set_permissions", sender, spenders),
+		Messages:   messages,
+	}
+	return res, nil
+}
@@ -4,6 +4,8 @@ import (
 	"errors"
 	"fmt"
 	"os"
+	"slices"
+	"strconv"
 
 	contractTypes "github.com/JackalLabs/burrow-contracts/cw1-subkeys/src/types"
 
@@ -54,6 +56,28 @@ func Execute(deps *std.Deps, env types.Env, info types.MessageInfo, data []byte)
 		return nil, err
 	}
 
+	state, err := cw1WhiteList.LoadState(deps.Storage)
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := execute(deps, env, info, msg)
+	if err != nil {
+		return nil, err
+	}
+
+	// any successful execute by an admin shows they're still around
+	if state.IsAdmin(info.Sender) {
+		err = cw1WhiteList.SaveLastActivity(deps.Storage, env.Block)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return res, nil
+}
+
+func execute(deps *std.Deps, env types.Env, info types.MessageInfo, msg contractTypes.ExecuteMsg) (*types.Response, error) {
 	// we need to find which one is non-empty
 	switch {
 	case msg.ExecuteRequest != nil:
@@ -68,6 +92,56 @@ func Execute(deps *std.Deps, env types.Env, info types.MessageInfo, data []byte)
 		return executeDecreaseAllowance(deps, &env, &info, msg.DecreaseAllowance)
 	case msg.SetPermissions != nil:
 		return executeSetPermissions(deps, &env, &info, msg.SetPermissions)
+	case msg.FundAllowance != nil:
+		return executeFundAllowance(deps, &env, &info, msg.FundAllowance)
+	case msg.SetTopUpConfig != nil:
+		return executeSetTopUpConfig(deps, &env, &info, msg.SetTopUpConfig)
+	case msg.SetOracle != nil:
+		return executeSetOracle(deps, &env, &info, msg.SetOracle)
+	case msg.SetQuoteAllowance != nil:
+		return executeSetQuoteAllowance(deps, &env, &info, msg.SetQuoteAllowance)
+	case msg.UpdateDenyList != nil:
+		return executeUpdateDenyList(deps, &env, &info, msg.UpdateDenyList)
+	case msg.IncreaseCw20Allowance != nil:
+		return executeIncreaseCw20Allowance(deps, &env, &info, msg.IncreaseCw20Allowance)
+	case msg.DecreaseCw20Allowance != nil:
+		return executeDecreaseCw20Allowance(deps, &env, &info, msg.DecreaseCw20Allowance)
+	case msg.BatchIncreaseAllowance != nil:
+		return executeBatchIncreaseAllowance(deps, &env, &info, msg.BatchIncreaseAllowance)
+	case msg.BatchDecreaseAllowance != nil:
+		return executeBatchDecreaseAllowance(deps, &env, &info, msg.BatchDecreaseAllowance)
+	case msg.BatchSetPermissions != nil:
+		return executeBatchSetPermissions(deps, &env, &info, msg.BatchSetPermissions)
+	case msg.SetIbcPermission != nil:
+		return executeSetIbcPermission(deps, &env, &info, msg.SetIbcPermission)
+	case msg.SetStargateAllowlist != nil:
+		return executeSetStargateAllowlist(deps, &env, &info, msg.SetStargateAllowlist)
+	case msg.SetPolicy != nil:
+		return executeSetPolicy(deps, &env, &info, msg.SetPolicy)
+	case msg.Revoke != nil:
+		return executeRevoke(deps, &env, &info, msg.Revoke)
+	case msg.GrantAllowance != nil:
+		return executeGrantAllowance(deps, &env, &info, msg.GrantAllowance)
+	case msg.GrantPermissions != nil:
+		return executeGrantPermissions(deps, &env, &info, msg.GrantPermissions)
+	case msg.RequestSpend != nil:
+		return executeRequestSpend(deps, &env, &info, msg.RequestSpend)
+	case msg.ApproveSpend != nil:
+		return executeApproveSpend(deps, &env, &info, msg.ApproveSpend)
+	case msg.RejectSpend != nil:
+		return executeRejectSpend(deps, &env, &info, msg.RejectSpend)
+	case msg.AddHook != nil:
+		return executeAddHook(deps, &env, &info, msg.AddHook)
+	case msg.RemoveHook != nil:
+		return executeRemoveHook(deps, &env, &info, msg.RemoveHook)
+	case msg.HeartbeatRequest != nil:
+		return cw1WhiteList.ExecuteHeartbeat(deps, &env, &info, msg.HeartbeatRequest)
+	case msg.SetInheritanceRequest != nil:
+		return cw1WhiteList.ExecuteSetInheritance(deps, &env, &info, msg.SetInheritanceRequest)
+	case msg.ClaimInheritanceRequest != nil:
+		return cw1WhiteList.ExecuteClaimInheritance(deps, &env, &info, msg.ClaimInheritanceRequest)
+	case msg.SweepInheritanceRequest != nil:
+		return cw1WhiteList.ExecuteSweepInheritance(deps, &env, &info, msg.SweepInheritanceRequest)
 
 	default:
 		return nil, types.GenericError("Unknown ExecuteMsg")
@@ -90,13 +164,43 @@ func Query(deps *std.Deps, env types.Env, data []byte) ([]byte, error) {
 		res, err = queryCanExecute(deps, &env, msg.QueryCanExecuteRequest)
 	case msg.QueryAllowance != nil:
 		res, err = queryAllowance(deps, &env, msg.QueryAllowance)
+	case msg.QueryTopUpConfig != nil:
+		res, err = queryTopUpConfig(deps, &env, msg.QueryTopUpConfig)
+	case msg.QueryOracle != nil:
+		res, err = queryOracle(deps, &env, msg.QueryOracle)
+	case msg.QueryQuoteAllowance != nil:
+		res, err = queryQuoteAllowance(deps, &env, msg.QueryQuoteAllowance)
+	case msg.QueryDenyList != nil:
+		res, err = queryDenyList(deps, &env, msg.QueryDenyList)
+	case msg.QueryCw20Allowance != nil:
+		res, err = queryCw20Allowance(deps, &env, msg.QueryCw20Allowance)
 	case msg.QueryPermissions != nil:
 		res, err = queryPermissions(deps, &env, msg.QueryPermissions)
 
 	case msg.QueryAllAllowance != nil:
 		res, err = queryAllAllowance(deps, &env, msg.QueryAllAllowance)
-	case msg.QueryPermissions != nil:
+	case msg.QueryAllPermissions != nil:
 		res, err = queryAllPermissions(deps, &env, msg.QueryAllPermissions)
+	case msg.QueryAllowancesExpiring != nil:
+		res, err = queryAllowancesExpiring(deps, &env, msg.QueryAllowancesExpiring)
+	case msg.QueryAllowancesByDenom != nil:
+		res, err = queryAllowancesByDenom(deps, &env, msg.QueryAllowancesByDenom)
+	case msg.QueryPermissionsWith != nil:
+		res, err = queryPermissionsWith(deps, &env, msg.QueryPermissionsWith)
+	case msg.QueryPolicy != nil:
+		res, err = queryPolicy(deps, &env, msg.QueryPolicy)
+	case msg.QueryIbcPermission != nil:
+		res, err = queryIbcPermission(deps, &env, msg.QueryIbcPermission)
+	case msg.QueryStargateAllowlist != nil:
+		res, err = queryStargateAllowlist(deps, &env, msg.QueryStargateAllowlist)
+	case msg.QueryGrants != nil:
+		res, err = queryGrants(deps, &env, msg.QueryGrants)
+	case msg.QuerySpendRequests != nil:
+		res, err = querySpendRequests(deps, &env, msg.QuerySpendRequests)
+	case msg.QueryHooks != nil:
+		res, err = queryHooks(deps, &env, msg.QueryHooks)
+	case msg.QueryInheritanceRequest != nil:
+		res, err = cw1WhiteList.QueryInheritance(deps, &env, msg.QueryInheritanceRequest)
 
 	default:
 		err = types.GenericError("Unknown QueryMsg " + string(data))
@@ -121,44 +225,28 @@ func ExecuteExecute(deps *std.Deps, env *types.Env, info *types.MessageInfo, msg
 		return nil, err
 	}
 
-	if !state.IsAdmin(sender) {
+	attributes := []types.EventAttribute{
+		{Key: "action", Value: "execute"},
+		{Key: "owner", Value: sender},
+	}
+
+	isAdmin := state.IsAdmin(sender)
+	if !isAdmin {
+		check := loadSpendCheck(deps, env.Block, sender)
 		for _, msg := range msg.Msgs {
-			switch {
-
-			case msg.Staking != nil:
-				perm, err := LoadPermissions(deps.Storage, sender)
-				if err != nil {
-					return nil, errors.New("can't find perm")
-				}
-				CheckStakingPermissions(msg.Staking, *perm)
-
-			case msg.Distribution != nil:
-				perm, err := LoadPermissions(deps.Storage, sender)
-				if err != nil {
-					return nil, errors.New("can't find perm")
-				}
-				CheckDistributionPermissions(msg.Distribution, *perm)
-
-			case msg.Bank != nil:
-				allow, err := LoadAllowances(deps.Storage, sender)
-				if err != nil {
-					return nil, errors.New("can't find allowance")
-				}
-				if allow.Expires.IsExpired(env.Block) {
-					return nil, errors.New("Contract Error No Allowance")
-				}
-
-				// Decrease Allowance
-				allow.Balance, err = allow.Balance.Sub(msg.Bank.Send.Amount[0])
-				if err != nil {
-					return nil, errors.New("unable to decrease allowance")
-				}
-
-			default:
-				return nil, errors.New("Contract Error: Type Rejected")
+			rule, err := check.check(env, msg)
+			if err != nil {
+				return nil, err
+			}
+			if rule != "" {
+				attributes = append(attributes, types.EventAttribute{Key: "policy_rule", Value: rule})
 			}
 		}
-		return nil, errors.New("Unauthorized")
+
+		err = check.save(deps.Storage, env.Block.Height)
+		if err != nil {
+			return nil, err
+		}
 	}
 
 	var messages []types.SubMsg
@@ -168,12 +256,22 @@ func ExecuteExecute(deps *std.Deps, env *types.Env, info *types.MessageInfo, msg
 		messages = append(messages, newSub)
 	}
 
+	if !isAdmin {
+		hooks, err := hookMessages(deps.Storage, contractTypes.HookMsg{
+			SubkeySpent: &contractTypes.SubkeySpentHook{
+				Spender: sender,
+				Msgs:    msg.Msgs,
+			},
+		})
+		if err != nil {
+			return nil, err
+		}
+		messages = append(messages, hooks...)
+	}
+
 	res := &types.Response{
-		Attributes: []types.EventAttribute{
-			{Key: "action", Value: "execute"},
-			{Key: "owner", Value: sender},
-		},
-		Messages: messages,
+		Attributes: attributes,
+		Messages:   messages,
 	}
 	return res, nil
 }
@@ -221,6 +319,24 @@ func CheckDistributionPermissions(distributionMsg *types.DistributionMsg, permis
 	return nil
 }
 
+// CheckGovPermissions validates votes, weighted votes aren't supported by GovMsg
+func CheckGovPermissions(govMsg *types.GovMsg, permissions contractTypes.Permissions) error {
+	switch {
+	case govMsg.Vote != nil:
+		if !permissions.Vote {
+			return errors.New("Contract Error: Vote Perm")
+		}
+		if !permissions.CanVote(govMsg.Vote.ProposalId) {
+			return errors.New("Contract Error: Vote Proposal Perm")
+		}
+
+	default:
+		return errors.New("Contract Error: Unsupported Message")
+	}
+
+	return nil
+}
+
 func executeIncreaseAllowance(deps *std.Deps, env *types.Env, info *types.MessageInfo, msg *contractTypes.IncreaseAllowance) (*types.Response, error) {
 	sender := info.Sender
 	state, err := cw1WhiteList.LoadState(deps.Storage)
@@ -233,7 +349,26 @@ func executeIncreaseAllowance(deps *std.Deps, env *types.Env, info *types.Messag
 		return nil, errors.New("Unauthorized")
 	}
 
-	err = deps.Api.ValidateAddress(msg.Spender)
+	hooks, err := increaseAllowance(deps, env, sender, msg)
+	if err != nil {
+		return nil, err
+	}
+
+	res := &types.Response{
+		Attributes: []types.EventAttribute{
+			{Key: "action", Value: "increase_allowance"},
+			{Key: "owner", Value: sender},
+			{Key: "spender", Value: msg.Spender},
+			{Key: "denom", Value: msg.Amount.Denom},
+			{Key: "amount", Value: msg.Amount.Amount.String()},
+		},
+		Messages: hooks,
+	}
+	return res, nil
+}
+
+func increaseAllowance(deps *std.Deps, env *types.Env, sender string, msg *contractTypes.IncreaseAllowance) ([]types.SubMsg, error) {
+	err := deps.Api.ValidateAddress(msg.Spender)
 	if err != nil {
 		return nil, err
 	}
@@ -247,36 +382,130 @@ func executeIncreaseAllowance(deps *std.Deps, env *types.Env, info *types.Messag
 	var emptyExpiration contractTypes.Expiration
 
 	prev, err := LoadAllowances(deps.Storage, msg.Spender)
+	if err == nil {
+		allow = *prev
+	}
 
 	if msg.Expires != emptyExpiration {
 		if msg.Expires.IsExpired(env.Block) {
 			return nil, errors.New("setting expired allowance")
 		}
-
 		allow.Expires = msg.Expires
-		allow.Balance = contractTypes.NativeBalance{
-			Coins: []types.Coin{msg.Amount},
-		}
-	} else if prev.Expires.IsExpired(env.Block) {
+	} else if allow.Expires.IsExpired(env.Block) {
 		return nil, errors.New("setting expired allowance")
-	} else {
-		allow.Balance = prev.Balance.AddAssign(msg.Amount)
 	}
 
-	SaveAllowances(deps.Storage, msg.Spender, &allow)
+	allow.Balance, err = allow.Balance.AddBalance(contractTypes.NativeBalance{Coins: []types.Coin{msg.Amount}})
+	if err != nil {
+		return nil, err
+	}
+
+	err = SaveAllowances(deps.Storage, msg.Spender, &allow, env.Block.Height)
+	if err != nil {
+		return nil, err
+	}
+
+	hooks, err := hookMessages(deps.Storage, contractTypes.HookMsg{
+		AllowanceChanged: &contractTypes.AllowanceChangedHook{
+			Spender:   msg.Spender,
+			Increase:  true,
+			Amount:    msg.Amount,
+			Allowance: allow,
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return hooks, nil
+}
+
+func executeDecreaseAllowance(deps *std.Deps, env *types.Env, info *types.MessageInfo, msg *contractTypes.DecreaseAllowance) (*types.Response, error) {
+	sender := info.Sender
+	state, err := cw1WhiteList.LoadState(deps.Storage)
+	if err != nil {
+		return nil, err
+	}
+
+	// check if sender is admin
+	if !state.IsAdmin(sender) {
+		return nil, errors.New("Unauthorized")
+	}
+
+	hooks, err := decreaseAllowance(deps, env, sender, msg)
+	if err != nil {
+		return nil, err
+	}
+
 	res := &types.Response{
 		Attributes: []types.EventAttribute{
-			{Key: "action", Value: "increase_allowance"},
+			{Key: "action", Value: "decrease_allowance"},
 			{Key: "owner", Value: sender},
 			{Key: "spender", Value: msg.Spender},
 			{Key: "denom", Value: msg.Amount.Denom},
 			{Key: "amount", Value: msg.Amount.Amount.String()},
 		},
+		Messages: hooks,
 	}
 	return res, nil
 }
 
-func executeDecreaseAllowance(deps *std.Deps, env *types.Env, info *types.MessageInfo, msg *contractTypes.DecreaseAllowance) (*types.Response, error) {
+func decreaseAllowance(deps *std.Deps, env *types.Env, sender string, msg *contractTypes.DecreaseAllowance) ([]types.SubMsg, error) {
+	err := deps.Api.ValidateAddress(msg.Spender)
+	if err != nil {
+		return nil, err
+	}
+
+	// sender can't be spender
+	if msg.Spender == sender {
+		return nil, errors.New("Cannot Set Your own Account")
+	}
+
+	var emptyExpiration contractTypes.Expiration
+
+	allow, err := LoadAllowances(deps.Storage, msg.Spender)
+	if err != nil {
+		return nil, errors.New("Contract Error No Allowance")
+	}
+
+	if msg.Expires != emptyExpiration {
+		if msg.Expires.IsExpired(env.Block) {
+			return nil, errors.New("setting expired allowance")
+		}
+		allow.Expires = msg.Expires
+	}
+
+	allow.Balance, err = allow.Balance.SubSaturating(msg.Amount)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(allow.Balance.Coins) == 0 {
+		err = RemoveAllowances(deps.Storage, msg.Spender, env.Block.Height)
+		allow = &contractTypes.Allowances{}
+	} else {
+		err = SaveAllowances(deps.Storage, msg.Spender, allow, env.Block.Height)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	hooks, err := hookMessages(deps.Storage, contractTypes.HookMsg{
+		AllowanceChanged: &contractTypes.AllowanceChangedHook{
+			Spender:   msg.Spender,
+			Increase:  false,
+			Amount:    msg.Amount,
+			Allowance: *allow,
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return hooks, nil
+}
+
+func executeSetPermissions(deps *std.Deps, env *types.Env, info *types.MessageInfo, msg *contractTypes.SetPermissions) (*types.Response, error) {
 	sender := info.Sender
 	state, err := cw1WhiteList.LoadState(deps.Storage)
 	if err != nil {
@@ -288,7 +517,25 @@ func executeDecreaseAllowance(deps *std.Deps, env *types.Env, info *types.Messag
 		return nil, errors.New("Unauthorized")
 	}
 
-	err = deps.Api.ValidateAddress(msg.Spender)
+	hooks, err := setPermissions(deps, env, sender, msg)
+	if err != nil {
+		return nil, err
+	}
+
+	res := &types.Response{
+		Attributes: []types.EventAttribute{
+			{Key: "action", Value: "set_permissions"},
+			{Key: "owner", Value: sender},
+			{Key: "spender", Value: msg.Spender},
+		},
+		Messages: hooks,
+	}
+
+	return res, nil
+}
+
+func setPermissions(deps *std.Deps, env *types.Env, sender string, msg *contractTypes.SetPermissions) ([]types.SubMsg, error) {
+	err := deps.Api.ValidateAddress(msg.Spender)
 	if err != nil {
 		return nil, err
 	}
@@ -298,40 +545,151 @@ func executeDecreaseAllowance(deps *std.Deps, env *types.Env, info *types.Messag
 		return nil, errors.New("Cannot Set Your own Account")
 	}
 
-	var allow contractTypes.Allowances
-	var emptyExpiration contractTypes.Expiration
+	if !msg.Permissions.IsActive(env.Block) {
+		return nil, errors.New("setting expired permissions")
+	}
 
-	prev, err := LoadAllowances(deps.Storage, msg.Spender)
+	err = SavePermissions(deps.Storage, msg.Spender, &msg.Permissions)
+	if err != nil {
+		return nil, err
+	}
 
-	if msg.Expires != emptyExpiration {
-		if msg.Expires.IsExpired(env.Block) {
-			return nil, errors.New("setting expired allowance")
-		}
+	hooks, err := hookMessages(deps.Storage, contractTypes.HookMsg{
+		PermissionsChanged: &contractTypes.PermissionsChangedHook{
+			Spender:     msg.Spender,
+			Permissions: msg.Permissions,
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
 
-		allow.Expires = msg.Expires
-		allow.Balance = contractTypes.NativeBalance{
-			Coins: []types.Coin{msg.Amount},
+	return hooks, nil
+}
+
+func executeSetIbcPermission(deps *std.Deps, env *types.Env, info *types.MessageInfo, msg *contractTypes.SetIbcPermission) (*types.Response, error) {
+	sender := info.Sender
+	state, err := cw1WhiteList.LoadState(deps.Storage)
+	if err != nil {
+		return nil, err
+	}
+
+	// check if sender is admin
+	if !state.IsAdmin(sender) {
+		return nil, errors.New("Unauthorized")
+	}
+
+	err = deps.Api.ValidateAddress(msg.Spender)
+	if err != nil {
+		return nil, err
+	}
+
+	// sender can't be spender
+	if msg.Spender == sender {
+		return nil, errors.New("Cannot Set Your own Account")
+	}
+
+	channels := 0
+	if msg.Permission == nil || len(msg.Permission.Channels) == 0 {
+		msg.Permission = nil
+		err = RemoveIbcPermission(deps.Storage, msg.Spender)
+	} else {
+		err = msg.Permission.Validate()
+		if err != nil {
+			return nil, err
 		}
-	} else if prev.Expires.IsExpired(env.Block) {
-		return nil, errors.New("setting expired allowance")
+		channels = len(msg.Permission.Channels)
+		err = SaveIbcPermission(deps.Storage, msg.Spender, msg.Permission)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	hooks, err := hookMessages(deps.Storage, contractTypes.HookMsg{
+		IbcPermissionChanged: &contractTypes.IbcPermissionChangedHook{
+			Spender:    msg.Spender,
+			Permission: msg.Permission,
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	res := &types.Response{
+		Attributes: []types.EventAttribute{
+			{Key: "action", Value: "set_ibc_permission"},
+			{Key: "owner", Value: sender},
+			{Key: "spender", Value: msg.Spender},
+			{Key: "channels", Value: strconv.Itoa(channels)},
+		},
+		Messages: hooks,
+	}
+
+	return res, nil
+}
+
+func executeSetStargateAllowlist(deps *std.Deps, env *types.Env, info *types.MessageInfo, msg *contractTypes.SetStargateAllowlist) (*types.Response, error) {
+	sender := info.Sender
+	state, err := cw1WhiteList.LoadState(deps.Storage)
+	if err != nil {
+		return nil, err
+	}
+
+	// check if sender is admin
+	if !state.IsAdmin(sender) {
+		return nil, errors.New("Unauthorized")
+	}
+
+	err = deps.Api.ValidateAddress(msg.Spender)
+	if err != nil {
+		return nil, err
+	}
+
+	// sender can't be spender
+	if msg.Spender == sender {
+		return nil, errors.New("Cannot Set Your own Account")
+	}
+
+	count := 0
+	if msg.Allowlist == nil || len(msg.Allowlist.Types) == 0 {
+		msg.Allowlist = nil
+		err = RemoveStargateAllowlist(deps.Storage, msg.Spender)
 	} else {
-		allow.Balance, _ = prev.Balance.SubSaturating(msg.Amount)
+		err = msg.Allowlist.Validate()
+		if err != nil {
+			return nil, err
+		}
+		count = len(msg.Allowlist.Types)
+		err = SaveStargateAllowlist(deps.Storage, msg.Spender, msg.Allowlist)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	hooks, err := hookMessages(deps.Storage, contractTypes.HookMsg{
+		StargateChanged: &contractTypes.StargateChangedHook{
+			Spender:   msg.Spender,
+			Allowlist: msg.Allowlist,
+		},
+	})
+	if err != nil {
+		return nil, err
 	}
 
-	SaveAllowances(deps.Storage, msg.Spender, &allow)
 	res := &types.Response{
 		Attributes: []types.EventAttribute{
-			{Key: "action", Value: "decrease_allowance"},
+			{Key: "action", Value: "set_stargate_allowlist"},
 			{Key: "owner", Value: sender},
 			{Key: "spender", Value: msg.Spender},
-			{Key: "denom", Value: msg.Amount.Denom},
-			{Key: "amount", Value: msg.Amount.Amount.String()},
+			{Key: "types", Value: strconv.Itoa(count)},
 		},
+		Messages: hooks,
 	}
+
 	return res, nil
 }
 
-func executeSetPermissions(deps *std.Deps, env *types.Env, info *types.MessageInfo, msg *contractTypes.SetPermissions) (*types.Response, error) {
+func executeSetPolicy(deps *std.Deps, env *types.Env, info *types.MessageInfo, msg *contractTypes.SetPolicy) (*types.Response, error) {
 	sender := info.Sender
 	state, err := cw1WhiteList.LoadState(deps.Storage)
 	if err != nil {
@@ -353,77 +711,168 @@ func executeSetPermissions(deps *std.Deps, env *types.Env, info *types.MessageIn
 		return nil, errors.New("Cannot Set Your own Account")
 	}
 
-	SavePermissions(deps.Storage, msg.Spender, &msg.Permissions)
+	rules := 0
+	if msg.Policy == nil || len(msg.Policy.Rules) == 0 {
+		msg.Policy = nil
+		err = RemovePolicy(deps.Storage, msg.Spender)
+	} else {
+		err = msg.Policy.Validate()
+		if err != nil {
+			return nil, err
+		}
+		rules = len(msg.Policy.Rules)
+		err = SavePolicy(deps.Storage, msg.Spender, msg.Policy)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	hooks, err := hookMessages(deps.Storage, contractTypes.HookMsg{
+		PolicyChanged: &contractTypes.PolicyChangedHook{
+			Spender: msg.Spender,
+			Policy:  msg.Policy,
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
 
 	res := &types.Response{
 		Attributes: []types.EventAttribute{
-			{Key: "action", Value: "set_permissions"},
+			{Key: "action", Value: "set_policy"},
 			{Key: "owner", Value: sender},
 			{Key: "spender", Value: msg.Spender},
+			{Key: "rules", Value: strconv.Itoa(rules)},
 		},
+		Messages: hooks,
 	}
 
 	return res, nil
 }
 
-func queryCanExecute(deps *std.Deps, env *types.Env, msg *contractTypes.QueryCanExecuteRequest) (*contractTypes.CanExecuteResponse, error) {
+func executeRevoke(deps *std.Deps, env *types.Env, info *types.MessageInfo, msg *contractTypes.Revoke) (*types.Response, error) {
+	sender := info.Sender
 	state, err := cw1WhiteList.LoadState(deps.Storage)
 	if err != nil {
 		return nil, err
 	}
 
-	if state.IsAdmin(msg.Sender) {
-		return &contractTypes.CanExecuteResponse{
-			CanExecute: true,
-		}, nil
+	// admins can revoke anyone, subkeys only what they granted further down
+	if !state.IsAdmin(sender) {
+		chain, err := ancestors(deps.Storage, msg.Spender)
+		if err != nil {
+			return nil, err
+		}
+		if !slices.Contains(chain, sender) {
+			return nil, errors.New("Unauthorized")
+		}
 	}
 
-	sender := msg.Sender
-	cosmosMsg := msg.Msg
+	below, err := descendants(deps.Storage, msg.Spender)
+	if err != nil {
+		return nil, err
+	}
 
-	var can bool
-	var resErr error
+	err = unlinkChild(deps.Storage, msg.Spender)
+	if err != nil {
+		return nil, err
+	}
 
-	switch {
-	case cosmosMsg.Staking != nil:
-		perm, err := LoadPermissions(deps.Storage, sender)
+	var hooks []types.SubMsg
+	for _, spender := range append([]string{msg.Spender}, below...) {
+		err = RemoveAllowances(deps.Storage, spender, env.Block.Height)
 		if err != nil {
-			can = false
-			resErr = err
+			return nil, err
 		}
-		if CheckStakingPermissions(cosmosMsg.Staking, *perm) != nil {
-			can = true
+
+		err = RemovePermissions(deps.Storage, spender)
+		if err != nil {
+			return nil, err
 		}
 
-	case cosmosMsg.Distribution != nil:
-		perm, err := LoadPermissions(deps.Storage, sender)
+		err = RemovePolicy(deps.Storage, spender)
 		if err != nil {
-			can = false
-			resErr = err
+			return nil, err
 		}
-		if CheckDistributionPermissions(cosmosMsg.Distribution, *perm) != nil {
-			can = true
+
+		err = RemoveIbcPermission(deps.Storage, spender)
+		if err != nil {
+			return nil, err
 		}
 
-	case cosmosMsg.Bank.Send != nil:
-		allow, err := LoadAllowances(deps.Storage, sender)
+		err = RemoveCw20Allowances(deps.Storage, spender)
 		if err != nil {
-			can = false
-			resErr = err
+			return nil, err
 		}
-		if !allow.Expires.IsExpired(env.Block) {
-			can = true
-			resErr = err
+
+		err = RemoveQuoteAllowance(deps.Storage, spender)
+		if err != nil {
+			return nil, err
+		}
+
+		err = RemoveStargateAllowlist(deps.Storage, spender)
+		if err != nil {
+			return nil, err
 		}
+
+		err = RemoveGrant(deps.Storage, spender)
+		if err != nil {
+			return nil, err
+		}
+
+		revoked, err := hookMessages(deps.Storage, contractTypes.HookMsg{
+			SubkeyRevoked: &contractTypes.SubkeyRevokedHook{
+				Spender: spender,
+			},
+		})
+		if err != nil {
+			return nil, err
+		}
+		hooks = append(hooks, revoked...)
+	}
+
+	res := &types.Response{
+		Attributes: []types.EventAttribute{
+			{Key: "action", Value: "revoke"},
+			{Key: "owner", Value: sender},
+			{Key: "spender", Value: msg.Spender},
+			{Key: "revoked", Value: strconv.Itoa(len(below) + 1)},
+		},
+		Messages: hooks,
+	}
+
+	return res, nil
+}
+
+func queryCanExecute(deps *std.Deps, env *types.Env, msg *contractTypes.QueryCanExecuteRequest) (*contractTypes.CanExecuteResponse, error) {
+	state, err := cw1WhiteList.LoadState(deps.Storage)
+	if err != nil {
+		return nil, err
 	}
 
+	if state.IsAdmin(msg.Sender) {
+		return &contractTypes.CanExecuteResponse{
+			CanExecute: true,
+		}, nil
+	}
+
+	check := loadSpendCheck(deps, env.Block, msg.Sender)
+	rule, err := check.check(env, msg.Msg)
+
 	return &contractTypes.CanExecuteResponse{
-		CanExecute: can,
-	}, resErr
+		CanExecute: err == nil,
+		Rule:       rule,
+	}, nil
 }
 
 func queryAllowance(deps *std.Deps, env *types.Env, msg *contractTypes.QueryAllowance) (*contractTypes.Allowances, error) {
-	allow, err := LoadAllowances(deps.Storage, msg.Spender)
+	var allow *contractTypes.Allowances
+	var err error
+	if msg.Height == 0 {
+		allow, err = LoadAllowances(deps.Storage, msg.Spender)
+	} else {
+		allow, err = LoadAllowancesAt(deps.Storage, msg.Spender, msg.Height)
+	}
 	if err != nil {
 		return nil, err
 	}
@@ -440,11 +889,43 @@ func queryPermissions(deps *std.Deps, env *types.Env, msg *contractTypes.QueryPe
 		return nil, err
 	}
 
-	return &contractTypes.Permissions{
-		Delegate:   perm.Delegate,
-		Redelegate: perm.Redelegate,
-		Undelegate: perm.Undelegate,
-		Withdraw:   perm.Withdraw,
+	if !perm.IsActive(env.Block) {
+		return nil, errors.New("permissions expired or used up")
+	}
+
+	return perm, nil
+}
+
+func queryIbcPermission(deps *std.Deps, env *types.Env, msg *contractTypes.QueryIbcPermission) (*contractTypes.IbcPermissionResponse, error) {
+	permission, err := LoadIbcPermission(deps.Storage, msg.Spender)
+	if err != nil {
+		return &contractTypes.IbcPermissionResponse{}, nil
+	}
+
+	return &contractTypes.IbcPermissionResponse{
+		Permission: permission,
+	}, nil
+}
+
+func queryStargateAllowlist(deps *std.Deps, env *types.Env, msg *contractTypes.QueryStargateAllowlist) (*contractTypes.StargateAllowlistResponse, error) {
+	allowlist, err := LoadStargateAllowlist(deps.Storage, msg.Spender)
+	if err != nil {
+		return &contractTypes.StargateAllowlistResponse{}, nil
+	}
+
+	return &contractTypes.StargateAllowlistResponse{
+		Allowlist: allowlist,
+	}, nil
+}
+
+func queryPolicy(deps *std.Deps, env *types.Env, msg *contractTypes.QueryPolicy) (*contractTypes.PolicyResponse, error) {
+	policy, err := LoadPolicy(deps.Storage, msg.Spender)
+	if err != nil {
+		return &contractTypes.PolicyResponse{}, nil
+	}
+
+	return &contractTypes.PolicyResponse{
+		Policy: policy,
 	}, nil
 }
 
@@ -454,7 +935,7 @@ const (
 )
 
 func calcLimit(request *uint32) int {
-	if request == nil {
+	if request == nil || *request == 0 {
 		return int(DEFAULT_LIMIT)
 	}
 
@@ -469,11 +950,7 @@ func calcLimit(request *uint32) int {
 func queryAllAllowance(deps *std.Deps, env *types.Env, msg *contractTypes.QueryAllAllowance) (*contractTypes.AllAllowancesResponse, error) {
 	limitValue := calcLimit(&msg.Limit)
 
-	// if msg.StartAfter != "" {
-	// 	start := &msg.StartAfter
-	// }
-
-	allAllow, err := LoadAllAllowances(deps.Storage, limitValue)
+	allAllow, err := LoadAllAllowances(deps.Storage, env.Block, msg.StartAfter, limitValue)
 	if err != nil {
 		return nil, err
 	}
@@ -484,14 +961,28 @@ func queryAllAllowance(deps *std.Deps, env *types.Env, msg *contractTypes.QueryA
 func queryAllPermissions(deps *std.Deps, env *types.Env, msg *contractTypes.QueryAllPermissions) (*contractTypes.AllPermissionsResponse, error) {
 	limitValue := calcLimit(&msg.Limit)
 
-	// if msg.StartAfter != "" {
-	// 	start := &msg.StartAfter
-	// }
-
-	allPerm, err := LoadAllPermissions(deps.Storage, limitValue)
+	allPerm, err := LoadAllPermissions(deps.Storage, env.Block, msg.StartAfter, limitValue)
 	if err != nil {
 		return nil, err
 	}
 
 	return allPerm, nil
 }
+
+func queryAllowancesExpiring(deps *std.Deps, env *types.Env, msg *contractTypes.QueryAllowancesExpiring) (*contractTypes.AllAllowancesResponse, error) {
+	limitValue := calcLimit(&msg.Limit)
+
+	return LoadAllowancesExpiring(deps.Storage, msg.BeforeHeight, msg.StartAfter, limitValue)
+}
+
+func queryAllowancesByDenom(deps *std.Deps, env *types.Env, msg *contractTypes.QueryAllowancesByDenom) (*contractTypes.AllAllowancesResponse, error) {
+	limitValue := calcLimit(&msg.Limit)
+
+	return LoadAllowancesByDenom(deps.Storage, env.Block, msg.Denom, msg.StartAfter, limitValue)
+}
+
+func queryPermissionsWith(deps *std.Deps, env *types.Env, msg *contractTypes.QueryPermissionsWith) (*contractTypes.AllPermissionsResponse, error) {
+	limitValue := calcLimit(&msg.Limit)
+
+	return LoadPermissionsWith(deps.Storage, env.Block, msg.Permission, msg.StartAfter, limitValue)
+}
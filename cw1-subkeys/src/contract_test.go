@@ -2,6 +2,9 @@ package src
 
 import (
 	"encoding/json"
+	"errors"
+	stdmath "math"
+	"strconv"
 	"testing"
 
 	contractTypes "github.com/JackalLabs/burrow-contracts/cw1-subkeys/src/types"
@@ -56,3 +59,1062 @@ func TestInit(t *testing.T) {
 	require.NoError(t, err)
 	assert.Equal(t, []string{"alice", "bob", "charlie"}, qres.Admins)
 }
+
+func TestSpenderPermissions(t *testing.T) {
+	deps, env := defaultInit(t, FUND)
+
+	emsg := []byte(`{"set_permissions":{"spender":"dave","permissions":{"delegate":true}}}`)
+	_, err := Execute(deps, env, mock.Info("alice", nil), emsg)
+	require.NoError(t, err)
+
+	delegate := `{"staking":{"delegate":{"validator":"val","amount":{"denom":"ujkl","amount":"10"}}}}`
+	undelegate := `{"staking":{"undelegate":{"validator":"val","amount":{"denom":"ujkl","amount":"10"}}}}`
+
+	res, err := Execute(deps, env, mock.Info("dave", nil), []byte(`{"execute":{"msgs":[`+delegate+`]}}`))
+	require.NoError(t, err)
+	require.Len(t, res.Messages, 1)
+
+	_, err = Execute(deps, env, mock.Info("dave", nil), []byte(`{"execute":{"msgs":[`+delegate+`,`+undelegate+`]}}`))
+	require.EqualError(t, err, "Contract Error: Undelegate Perm")
+
+	var qres contractTypes.CanExecuteResponse
+	data, err := Query(deps, env, []byte(`{"can_execute":{"sender":"dave","msg":`+undelegate+`}}`))
+	require.NoError(t, err)
+	require.NoError(t, json.Unmarshal(data, &qres))
+	assert.False(t, qres.CanExecute)
+
+	data, err = Query(deps, env, []byte(`{"can_execute":{"sender":"dave","msg":`+delegate+`}}`))
+	require.NoError(t, err)
+	require.NoError(t, json.Unmarshal(data, &qres))
+	assert.True(t, qres.CanExecute)
+}
+
+func TestSpenderPolicy(t *testing.T) {
+	deps, env := defaultInit(t, FUND)
+
+	policy := `{"rules":[
+		{"id":"shop","effect":"allow","kinds":["bank_send"],"denoms":["ujkl"],"max_amount":"1000"},
+		{"id":"no-mallory","effect":"deny","recipients":["mallory"]}
+	]}`
+	_, err := Execute(deps, env, mock.Info("dave", nil), []byte(`{"set_policy":{"spender":"erin","policy":`+policy+`}}`))
+	require.EqualError(t, err, "Unauthorized")
+	_, err = Execute(deps, env, mock.Info("alice", nil), []byte(`{"set_policy":{"spender":"erin","policy":`+policy+`}}`))
+	require.NoError(t, err)
+
+	send := func(to string, amount string) string {
+		return `{"bank":{"send":{"to_address":"` + to + `","amount":[{"denom":"ujkl","amount":"` + amount + `"}]}}}`
+	}
+
+	// the allow rule grants on its own, erin needs no allowance
+	res, err := Execute(deps, env, mock.Info("erin", nil), []byte(`{"execute":{"msgs":[`+send("shop", "500")+`,`+send("shop", "1000")+`]}}`))
+	require.NoError(t, err)
+	assert.Equal(t, types.EventAttribute{Key: "policy_rule", Value: "shop"}, res.Attributes[2])
+
+	// but only what it matches, other kinds still need a grant
+	_, err = Execute(deps, env, mock.Info("erin", nil), []byte(`{"execute":{"msgs":[{"staking":{"delegate":{"validator":"val","amount":{"denom":"ujkl","amount":"5"}}}}]}}`))
+	require.EqualError(t, err, "Contract Error: No policy rule matched")
+
+	// an allowance erin holds is still debited by what the policy allows
+	_, err = Execute(deps, env, mock.Info("alice", nil), []byte(`{"increase_allowance":{"spender":"erin","amount":{"denom":"ujkl","amount":"800"}}}`))
+	require.NoError(t, err)
+	_, err = Execute(deps, env, mock.Info("erin", nil), []byte(`{"execute":{"msgs":[`+send("shop", "500")+`]}}`))
+	require.NoError(t, err)
+
+	// the same message is still allowed by the policy, but the allowance is used up
+	_, err = Execute(deps, env, mock.Info("erin", nil), []byte(`{"execute":{"msgs":[`+send("shop", "500")+`]}}`))
+	require.EqualError(t, err, "unable to decrease allowance")
+
+	_, err = Execute(deps, env, mock.Info("erin", nil), []byte(`{"execute":{"msgs":[`+send("shop", "5000")+`]}}`))
+	require.EqualError(t, err, "Contract Error: No policy rule matched")
+
+	_, err = Execute(deps, env, mock.Info("erin", nil), []byte(`{"execute":{"msgs":[`+send("mallory", "5")+`]}}`))
+	require.EqualError(t, err, "Contract Error: Denied by policy rule no-mallory")
+
+	var qres contractTypes.CanExecuteResponse
+	data, err := Query(deps, env, []byte(`{"can_execute":{"sender":"erin","msg":`+send("mallory", "5")+`}}`))
+	require.NoError(t, err)
+	require.NoError(t, json.Unmarshal(data, &qres))
+	assert.False(t, qres.CanExecute)
+	assert.Equal(t, "no-mallory", qres.Rule)
+
+	// removing the policy leaves erin with just the allowance
+	_, err = Execute(deps, env, mock.Info("alice", nil), []byte(`{"set_policy":{"spender":"erin"}}`))
+	require.NoError(t, err)
+	_, err = Execute(deps, env, mock.Info("erin", nil), []byte(`{"execute":{"msgs":[`+send("mallory", "5")+`]}}`))
+	require.NoError(t, err)
+}
+
+func TestGrantUsesLimit(t *testing.T) {
+	deps, env := defaultInit(t, FUND)
+
+	_, err := Execute(deps, env, mock.Info("alice", nil), []byte(`{"set_permissions":{"spender":"dave","permissions":{"delegate":true,"expires":{"at_height":20000},"uses":2}}}`))
+	require.NoError(t, err)
+
+	// a limited grantor can't hand out more uses, or a longer life, than it holds
+	for _, perm := range []string{
+		`{"delegate":true,"expires":{"at_height":20000}}`,
+		`{"delegate":true,"expires":{"at_height":20000},"uses":3}`,
+		`{"delegate":true,"uses":1}`,
+		`{"delegate":true,"expires":{"at_height":20001},"uses":1}`,
+	} {
+		_, err = Execute(deps, env, mock.Info("dave", nil), []byte(`{"grant_permissions":{"spender":"erin","permissions":`+perm+`}}`))
+		require.EqualError(t, err, "Cannot grant more than own permissions", perm)
+	}
+	_, err = Execute(deps, env, mock.Info("dave", nil), []byte(`{"grant_permissions":{"spender":"erin","permissions":{"delegate":true,"expires":{"at_height":20000},"uses":2}}}`))
+	require.NoError(t, err)
+
+	// every use by erin is also one of dave's
+	delegate := `{"staking":{"delegate":{"validator":"val","amount":{"denom":"ujkl","amount":"10"}}}}`
+	_, err = Execute(deps, env, mock.Info("erin", nil), []byte(`{"execute":{"msgs":[`+delegate+`]}}`))
+	require.NoError(t, err)
+	_, err = Execute(deps, env, mock.Info("dave", nil), []byte(`{"execute":{"msgs":[`+delegate+`]}}`))
+	require.NoError(t, err)
+	_, err = Execute(deps, env, mock.Info("erin", nil), []byte(`{"execute":{"msgs":[`+delegate+`]}}`))
+	require.EqualError(t, err, "Contract Error: Delegate Perm")
+
+	data, err := Query(deps, env, []byte(`{"permissions":{"spender":"erin"}}`))
+	require.NoError(t, err)
+	var pres contractTypes.Permissions
+	require.NoError(t, pres.UnmarshalJSON(data))
+	require.NotNil(t, pres.Uses)
+	assert.Equal(t, uint32(1), *pres.Uses)
+}
+
+func TestHooks(t *testing.T) {
+	deps, env := defaultInit(t, FUND)
+
+	_, err := Execute(deps, env, mock.Info("dave", nil), []byte(`{"add_hook":{"addr":"accounting"}}`))
+	require.EqualError(t, err, "Unauthorized")
+	_, err = Execute(deps, env, mock.Info("alice", nil), []byte(`{"add_hook":{"addr":"accounting"}}`))
+	require.NoError(t, err)
+	_, err = Execute(deps, env, mock.Info("alice", nil), []byte(`{"add_hook":{"addr":"rewards"}}`))
+	require.NoError(t, err)
+	_, err = Execute(deps, env, mock.Info("alice", nil), []byte(`{"add_hook":{"addr":"rewards"}}`))
+	require.EqualError(t, err, "Hook already registered")
+
+	data, err := Query(deps, env, []byte(`{"hooks":{}}`))
+	require.NoError(t, err)
+	var hres contractTypes.HooksResponse
+	require.NoError(t, json.Unmarshal(data, &hres))
+	assert.Equal(t, []string{"accounting", "rewards"}, hres.Hooks)
+
+	// every hook is told about the allowance change
+	res, err := Execute(deps, env, mock.Info("alice", nil), []byte(`{"increase_allowance":{"spender":"dave","amount":{"denom":"ujkl","amount":"100"}}}`))
+	require.NoError(t, err)
+	require.Len(t, res.Messages, 2)
+	assert.Equal(t, "accounting", res.Messages[0].Msg.Wasm.Execute.ContractAddr)
+	assert.Equal(t, "rewards", res.Messages[1].Msg.Wasm.Execute.ContractAddr)
+
+	var hook contractTypes.HookMsg
+	require.NoError(t, hook.UnmarshalJSON(res.Messages[0].Msg.Wasm.Execute.Msg))
+	require.NotNil(t, hook.AllowanceChanged)
+	assert.Equal(t, "dave", hook.AllowanceChanged.Spender)
+	assert.True(t, hook.AllowanceChanged.Increase)
+	assert.Equal(t, "100", hook.AllowanceChanged.Allowance.Balance.Coins[0].Amount.String())
+
+	// spends are dispatched before the hooks
+	send := `{"bank":{"send":{"to_address":"shop","amount":[{"denom":"ujkl","amount":"40"}]}}}`
+	res, err = Execute(deps, env, mock.Info("dave", nil), []byte(`{"execute":{"msgs":[`+send+`]}}`))
+	require.NoError(t, err)
+	require.Len(t, res.Messages, 3)
+	assert.Equal(t, "shop", res.Messages[0].Msg.Bank.Send.ToAddress)
+	hook = contractTypes.HookMsg{}
+	require.NoError(t, hook.UnmarshalJSON(res.Messages[2].Msg.Wasm.Execute.Msg))
+	require.NotNil(t, hook.SubkeySpent)
+	assert.Equal(t, "dave", hook.SubkeySpent.Spender)
+
+	data, err = Query(deps, env, []byte(`{"allowance":{"spender":"dave"}}`))
+	require.NoError(t, err)
+	var ares contractTypes.Allowances
+	require.NoError(t, ares.UnmarshalJSON(data))
+	assert.Equal(t, "60", ares.Balance.Coins[0].Amount.String())
+
+	// admins don't notify hooks when executing
+	res, err = Execute(deps, env, mock.Info("alice", nil), []byte(`{"execute":{"msgs":[`+send+`]}}`))
+	require.NoError(t, err)
+	require.Len(t, res.Messages, 1)
+
+	_, err = Execute(deps, env, mock.Info("alice", nil), []byte(`{"remove_hook":{"addr":"accounting"}}`))
+	require.NoError(t, err)
+
+	res, err = Execute(deps, env, mock.Info("alice", nil), []byte(`{"revoke":{"spender":"dave"}}`))
+	require.NoError(t, err)
+	require.Len(t, res.Messages, 1)
+	hook = contractTypes.HookMsg{}
+	require.NoError(t, hook.UnmarshalJSON(res.Messages[0].Msg.Wasm.Execute.Msg))
+	require.NotNil(t, hook.SubkeyRevoked)
+
+	_, err = Execute(deps, env, mock.Info("dave", nil), []byte(`{"execute":{"msgs":[`+send+`]}}`))
+	require.EqualError(t, err, "can't find allowance")
+}
+
+func TestGrants(t *testing.T) {
+	deps, env := defaultInit(t, FUND)
+
+	_, err := Execute(deps, env, mock.Info("alice", nil), []byte(`{"increase_allowance":{"spender":"dave","amount":{"denom":"ujkl","amount":"100"}}}`))
+	require.NoError(t, err)
+	_, err = Execute(deps, env, mock.Info("alice", nil), []byte(`{"set_permissions":{"spender":"dave","permissions":{"delegate":true,"withdraw":true}}}`))
+	require.NoError(t, err)
+
+	// subkeys can only pass on what they hold
+	_, err = Execute(deps, env, mock.Info("dave", nil), []byte(`{"grant_allowance":{"spender":"erin","amount":{"denom":"ujkl","amount":"101"}}}`))
+	require.EqualError(t, err, "unable to decrease allowance")
+	_, err = Execute(deps, env, mock.Info("dave", nil), []byte(`{"grant_permissions":{"spender":"erin","permissions":{"undelegate":true}}}`))
+	require.EqualError(t, err, "Cannot grant more than own permissions")
+	_, err = Execute(deps, env, mock.Info("dave", nil), []byte(`{"grant_allowance":{"spender":"alice","amount":{"denom":"ujkl","amount":"10"}}}`))
+	require.EqualError(t, err, "Cannot grant to an admin")
+
+	_, err = Execute(deps, env, mock.Info("dave", nil), []byte(`{"grant_allowance":{"spender":"erin","amount":{"denom":"ujkl","amount":"30"}}}`))
+	require.NoError(t, err)
+	_, err = Execute(deps, env, mock.Info("dave", nil), []byte(`{"grant_permissions":{"spender":"erin","permissions":{"delegate":true}}}`))
+	require.NoError(t, err)
+	_, err = Execute(deps, env, mock.Info("erin", nil), []byte(`{"grant_allowance":{"spender":"frank","amount":{"denom":"ujkl","amount":"10"}}}`))
+	require.NoError(t, err)
+	_, err = Execute(deps, env, mock.Info("frank", nil), []byte(`{"grant_allowance":{"spender":"dave","amount":{"denom":"ujkl","amount":"1"}}}`))
+	require.EqualError(t, err, "Spender already has grants")
+
+	data, err := Query(deps, env, []byte(`{"allowance":{"spender":"dave"}}`))
+	require.NoError(t, err)
+	var ares contractTypes.Allowances
+	require.NoError(t, ares.UnmarshalJSON(data))
+	assert.Equal(t, "70", ares.Balance.Coins[0].Amount.String())
+
+	data, err = Query(deps, env, []byte(`{"grants":{"spender":"dave"}}`))
+	require.NoError(t, err)
+	var gres contractTypes.GrantsResponse
+	require.NoError(t, gres.UnmarshalJSON(data))
+	assert.Equal(t, "", gres.Parent)
+	require.Len(t, gres.Tree.Children, 1)
+	assert.Equal(t, "erin", gres.Tree.Children[0].Spender)
+	require.Len(t, gres.Tree.Children[0].Children, 1)
+	assert.Equal(t, "frank", gres.Tree.Children[0].Children[0].Spender)
+
+	// permissions narrow to what the grantor still holds
+	delegate := `{"staking":{"delegate":{"validator":"val","amount":{"denom":"ujkl","amount":"10"}}}}`
+	_, err = Execute(deps, env, mock.Info("erin", nil), []byte(`{"execute":{"msgs":[`+delegate+`]}}`))
+	require.NoError(t, err)
+	_, err = Execute(deps, env, mock.Info("alice", nil), []byte(`{"set_permissions":{"spender":"dave","permissions":{"withdraw":true}}}`))
+	require.NoError(t, err)
+	_, err = Execute(deps, env, mock.Info("erin", nil), []byte(`{"execute":{"msgs":[`+delegate+`]}}`))
+	require.EqualError(t, err, "Contract Error: Delegate Perm")
+
+	// only grantors above a subkey can revoke it
+	_, err = Execute(deps, env, mock.Info("frank", nil), []byte(`{"revoke":{"spender":"erin"}}`))
+	require.EqualError(t, err, "Unauthorized")
+	_, err = Execute(deps, env, mock.Info("dave", nil), []byte(`{"revoke":{"spender":"erin"}}`))
+	require.NoError(t, err)
+
+	send := `{"bank":{"send":{"to_address":"shop","amount":[{"denom":"ujkl","amount":"1"}]}}}`
+	_, err = Execute(deps, env, mock.Info("frank", nil), []byte(`{"execute":{"msgs":[`+send+`]}}`))
+	require.EqualError(t, err, "can't find allowance")
+
+	data, err = Query(deps, env, []byte(`{"grants":{"spender":"dave"}}`))
+	require.NoError(t, err)
+	gres = contractTypes.GrantsResponse{}
+	require.NoError(t, gres.UnmarshalJSON(data))
+	assert.Len(t, gres.Tree.Children, 0)
+}
+
+func TestSpendRequests(t *testing.T) {
+	deps, env := defaultInit(t, FUND)
+
+	_, err := Execute(deps, env, mock.Info("alice", nil), []byte(`{"increase_allowance":{"spender":"dave","amount":{"denom":"ujkl","amount":"50"}}}`))
+	require.NoError(t, err)
+
+	send := `{"bank":{"send":{"to_address":"shop","amount":[{"denom":"ujkl","amount":"80"}]}}}`
+	_, err = Execute(deps, env, mock.Info("dave", nil), []byte(`{"execute":{"msgs":[`+send+`]}}`))
+	require.EqualError(t, err, "unable to decrease allowance")
+
+	_, err = Execute(deps, env, mock.Info("erin", nil), []byte(`{"request_spend":{"msgs":[`+send+`]}}`))
+	require.EqualError(t, err, "Unauthorized")
+	_, err = Execute(deps, env, mock.Info("dave", nil), []byte(`{"request_spend":{"msgs":[`+send+`]}}`))
+	require.NoError(t, err)
+	_, err = Execute(deps, env, mock.Info("dave", nil), []byte(`{"request_spend":{"msgs":[`+send+`]}}`))
+	require.NoError(t, err)
+
+	count, err := SPEND_REQUEST_COUNT.Load(deps.Storage)
+	require.NoError(t, err)
+	assert.Equal(t, uint64(2), count.LastID)
+
+	data, err := Query(deps, env, []byte(`{"spend_requests":{}}`))
+	require.NoError(t, err)
+	var qres contractTypes.SpendRequestsResponse
+	require.NoError(t, qres.UnmarshalJSON(data))
+	require.Len(t, qres.Requests, 2)
+	assert.Equal(t, uint64(1), qres.Requests[0].ID)
+	assert.Equal(t, "dave", qres.Requests[0].Spender)
+
+	data, err = Query(deps, env, []byte(`{"spend_requests":{"start_after":1,"limit":5}}`))
+	require.NoError(t, err)
+	require.NoError(t, qres.UnmarshalJSON(data))
+	require.Len(t, qres.Requests, 1)
+	assert.Equal(t, uint64(2), qres.Requests[0].ID)
+
+	_, err = Execute(deps, env, mock.Info("dave", nil), []byte(`{"approve_spend":{"id":1}}`))
+	require.EqualError(t, err, "Unauthorized")
+
+	res, err := Execute(deps, env, mock.Info("alice", nil), []byte(`{"approve_spend":{"id":1,"top_up":[{"denom":"ujkl","amount":"20"}]}}`))
+	require.NoError(t, err)
+	require.Len(t, res.Messages, 1)
+	assert.Equal(t, "shop", res.Messages[0].Msg.Bank.Send.ToAddress)
+
+	_, err = Execute(deps, env, mock.Info("alice", nil), []byte(`{"approve_spend":{"id":1}}`))
+	require.EqualError(t, err, "Spend request not found")
+
+	data, err = Query(deps, env, []byte(`{"allowance":{"spender":"dave"}}`))
+	require.NoError(t, err)
+	var ares contractTypes.Allowances
+	require.NoError(t, ares.UnmarshalJSON(data))
+	assert.Equal(t, "70", ares.Balance.Coins[0].Amount.String())
+
+	// pending requests lapse after the default expiry
+	later := env
+	later.Block.Time += 8 * 24 * 60 * 60 * 1_000_000_000
+	data, err = Query(deps, later, []byte(`{"spend_requests":{}}`))
+	require.NoError(t, err)
+	require.NoError(t, qres.UnmarshalJSON(data))
+	assert.Len(t, qres.Requests, 0)
+
+	_, err = Execute(deps, later, mock.Info("alice", nil), []byte(`{"approve_spend":{"id":2}}`))
+	require.EqualError(t, err, "Spend request expired")
+	_, err = Execute(deps, later, mock.Info("erin", nil), []byte(`{"reject_spend":{"id":2}}`))
+	require.EqualError(t, err, "Unauthorized")
+	_, err = Execute(deps, later, mock.Info("dave", nil), []byte(`{"reject_spend":{"id":2}}`))
+	require.NoError(t, err)
+}
+
+func TestSpendRequestLimit(t *testing.T) {
+	deps, env := defaultInit(t, FUND)
+
+	_, err := Execute(deps, env, mock.Info("alice", nil), []byte(`{"increase_allowance":{"spender":"dave","amount":{"denom":"ujkl","amount":"50"}}}`))
+	require.NoError(t, err)
+	_, err = Execute(deps, env, mock.Info("alice", nil), []byte(`{"increase_allowance":{"spender":"erin","amount":{"denom":"ujkl","amount":"50"}}}`))
+	require.NoError(t, err)
+
+	request := []byte(`{"request_spend":{"msgs":[{"bank":{"send":{"to_address":"shop","amount":[{"denom":"ujkl","amount":"80"}]}}}]}}`)
+	for i := 0; i < MAX_PENDING_SPEND_REQUESTS; i++ {
+		_, err = Execute(deps, env, mock.Info("dave", nil), request)
+		require.NoError(t, err)
+	}
+	_, err = Execute(deps, env, mock.Info("dave", nil), request)
+	require.EqualError(t, err, "Too many pending spend requests")
+
+	// the cap is per spender
+	_, err = Execute(deps, env, mock.Info("erin", nil), request)
+	require.NoError(t, err)
+
+	// withdrawing a request frees its slot
+	_, err = Execute(deps, env, mock.Info("dave", nil), []byte(`{"reject_spend":{"id":1}}`))
+	require.NoError(t, err)
+	_, err = Execute(deps, env, mock.Info("dave", nil), request)
+	require.NoError(t, err)
+
+	// expired requests are pruned when the spender files a new one
+	later := env
+	later.Block.Time += 8 * 24 * 60 * 60 * 1_000_000_000
+	_, err = Execute(deps, later, mock.Info("dave", nil), request)
+	require.NoError(t, err)
+	_, err = LoadSpendRequest(deps.Storage, 2)
+	require.EqualError(t, err, "Spend request not found")
+
+	pending, err := PruneSpendRequests(deps.Storage, later.Block, "dave")
+	require.NoError(t, err)
+	assert.Equal(t, 1, pending)
+
+	// the other spender's expired request is left until they file again
+	_, err = LoadSpendRequest(deps.Storage, uint64(MAX_PENDING_SPEND_REQUESTS+1))
+	require.NoError(t, err)
+}
+
+func TestBatchGrants(t *testing.T) {
+	deps, env := defaultInit(t, FUND)
+
+	_, err := Execute(deps, env, mock.Info("dave", nil), []byte(`{"batch_increase_allowance":{"allowances":[{"spender":"erin","amount":{"denom":"ujkl","amount":"10"}}]}}`))
+	require.EqualError(t, err, "Unauthorized")
+	_, err = Execute(deps, env, mock.Info("alice", nil), []byte(`{"batch_increase_allowance":{"allowances":[]}}`))
+	require.EqualError(t, err, "Empty batch")
+	_, err = Execute(deps, env, mock.Info("alice", nil), []byte(`{"batch_increase_allowance":{"allowances":[{"spender":"erin","amount":{"denom":"ujkl","amount":"10"}},{"spender":"erin","amount":{"denom":"ujkl","amount":"10"}}]}}`))
+	require.EqualError(t, err, "Duplicate spender erin")
+	_, err = Execute(deps, env, mock.Info("alice", nil), []byte(`{"batch_set_permissions":{"permissions":[{"spender":"erin","permissions":{"delegate":true}},{"spender":"alice","permissions":{"delegate":true}}]}}`))
+	require.EqualError(t, err, "Cannot Set Your own Account")
+
+	data, err := Query(deps, env, []byte(`{"permissions":{"spender":"erin"}}`))
+	require.Error(t, err)
+	require.Nil(t, data)
+
+	res, err := Execute(deps, env, mock.Info("alice", nil), []byte(`{"batch_increase_allowance":{"allowances":[{"spender":"dave","amount":{"denom":"ujkl","amount":"10"}},{"spender":"erin","amount":{"denom":"ujkl","amount":"20"}}]}}`))
+	require.NoError(t, err)
+	assert.Equal(t, []types.EventAttribute{
+		{Key: "action", Value: "batch_increase_allowance"},
+		{Key: "owner", Value: "alice"},
+		{Key: "count", Value: "2"},
+		{Key: "spender", Value: "dave"},
+		{Key: "spender", Value: "erin"},
+	}, res.Attributes)
+
+	res, err = Execute(deps, env, mock.Info("alice", nil), []byte(`{"batch_set_permissions":{"permissions":[{"spender":"dave","permissions":{"delegate":true}},{"spender":"erin","permissions":{"withdraw":true}}]}}`))
+	require.NoError(t, err)
+
+	_, err = Execute(deps, env, mock.Info("alice", nil), []byte(`{"batch_decrease_allowance":{"allowances":[{"spender":"frank","amount":{"denom":"ujkl","amount":"5"}},{"spender":"dave","amount":{"denom":"ujkl","amount":"10"}}]}}`))
+	require.EqualError(t, err, "frank: Contract Error No Allowance")
+
+	data, err = Query(deps, env, []byte(`{"all_allowance":{}}`))
+	require.NoError(t, err)
+	var ares contractTypes.AllAllowancesResponse
+	require.NoError(t, ares.UnmarshalJSON(data))
+	assert.Len(t, ares.Allowances, 2)
+
+	data, err = Query(deps, env, []byte(`{"permissions":{"spender":"erin"}}`))
+	require.NoError(t, err)
+	var pres contractTypes.Permissions
+	require.NoError(t, pres.UnmarshalJSON(data))
+	// no expiration reads back as an explicit never
+	assert.Equal(t, contractTypes.Permissions{Withdraw: true, Expires: contractTypes.Expiration{Never: true}}, pres)
+}
+
+func ibcTransfer(channel string, receiver string, amount uint64) string {
+	msg := types.TransferMsg{
+		ChannelID: channel,
+		ToAddress: receiver,
+		Amount:    types.NewCoin(math.NewUint128FromUint64(amount), "ujkl"),
+		Timeout:   types.IBCTimeout{Block: &types.IBCTimeoutBlock{Revision: 1, Height: 20000}},
+	}.ToMsg()
+	bz, err := msg.MarshalJSON()
+	if err != nil {
+		panic(err)
+	}
+	return string(bz)
+}
+
+func TestIbcTransfer(t *testing.T) {
+	deps, env := defaultInit(t, FUND)
+
+	_, err := Execute(deps, env, mock.Info("alice", nil), []byte(`{"increase_allowance":{"spender":"dave","amount":{"denom":"ujkl","amount":"100"}}}`))
+	require.NoError(t, err)
+
+	transfer := ibcTransfer("channel-0", "osmo1receiver", 40)
+	_, err = Execute(deps, env, mock.Info("dave", nil), []byte(`{"execute":{"msgs":[`+transfer+`]}}`))
+	require.EqualError(t, err, "can't find ibc permission")
+
+	_, err = Execute(deps, env, mock.Info("alice", nil), []byte(`{"set_ibc_permission":{"spender":"dave","permission":{"channels":["channel-0","channel-0"]}}}`))
+	require.EqualError(t, err, "Duplicate channel channel-0")
+	_, err = Execute(deps, env, mock.Info("alice", nil), []byte(`{"set_ibc_permission":{"spender":"dave","permission":{"channels":["channel-0"],"receivers":["osmo1receiver"]}}}`))
+	require.NoError(t, err)
+
+	data, err := Query(deps, env, []byte(`{"ibc_permission":{"spender":"dave"}}`))
+	require.NoError(t, err)
+	var ires contractTypes.IbcPermissionResponse
+	require.NoError(t, ires.UnmarshalJSON(data))
+	require.NotNil(t, ires.Permission)
+	assert.Equal(t, []string{"channel-0"}, ires.Permission.Channels)
+
+	cases := map[string]struct {
+		msg string
+		err string
+	}{
+		"approved":           {msg: ibcTransfer("channel-0", "osmo1receiver", 40)},
+		"other channel":      {msg: ibcTransfer("channel-7", "osmo1receiver", 40), err: "Contract Error: Channel Not Approved"},
+		"other receiver":     {msg: ibcTransfer("channel-0", "osmo1stranger", 40), err: "Contract Error: Receiver Not Approved"},
+		"over the allowance": {msg: ibcTransfer("channel-0", "osmo1receiver", 101), err: "unable to decrease allowance"},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			var qres contractTypes.CanExecuteResponse
+			data, err := Query(deps, env, []byte(`{"can_execute":{"sender":"dave","msg":`+tc.msg+`}}`))
+			require.NoError(t, err)
+			require.NoError(t, json.Unmarshal(data, &qres))
+			assert.Equal(t, tc.err == "", qres.CanExecute)
+		})
+	}
+
+	res, err := Execute(deps, env, mock.Info("dave", nil), []byte(`{"execute":{"msgs":[`+transfer+`]}}`))
+	require.NoError(t, err)
+	require.Len(t, res.Messages, 1)
+	assert.Equal(t, "channel-0", res.Messages[0].Msg.IBC.Transfer.ChannelID)
+
+	_, err = Execute(deps, env, mock.Info("dave", nil), []byte(`{"execute":{"msgs":[`+ibcTransfer("channel-7", "osmo1receiver", 1)+`]}}`))
+	require.EqualError(t, err, "Contract Error: Channel Not Approved")
+
+	data, err = Query(deps, env, []byte(`{"allowance":{"spender":"dave"}}`))
+	require.NoError(t, err)
+	var ares contractTypes.Allowances
+	require.NoError(t, ares.UnmarshalJSON(data))
+	assert.Equal(t, "60", ares.Balance.Coins[0].Amount.String())
+
+	_, err = Execute(deps, env, mock.Info("alice", nil), []byte(`{"set_ibc_permission":{"spender":"dave"}}`))
+	require.NoError(t, err)
+	_, err = Execute(deps, env, mock.Info("dave", nil), []byte(`{"execute":{"msgs":[`+transfer+`]}}`))
+	require.EqualError(t, err, "can't find ibc permission")
+}
+
+func stargateMsg(typeURL string, size int) string {
+	msg := types.StargateMsg{TypeURL: typeURL, Value: make([]byte, size)}.ToMsg()
+	bz, err := msg.MarshalJSON()
+	if err != nil {
+		panic(err)
+	}
+	return string(bz)
+}
+
+func TestStargateAllowlist(t *testing.T) {
+	deps, env := defaultInit(t, FUND)
+
+	postFile := "/canine_chain.storage.MsgPostFile"
+	buyStorage := "/canine_chain.storage.MsgBuyStorage"
+
+	_, err := Execute(deps, env, mock.Info("dave", nil), []byte(`{"execute":{"msgs":[`+stargateMsg(postFile, 10)+`]}}`))
+	require.EqualError(t, err, "can't find stargate allowlist")
+
+	_, err = Execute(deps, env, mock.Info("alice", nil), []byte(`{"set_stargate_allowlist":{"spender":"dave","allowlist":{"types":[{"type_url":"`+postFile+`","max_bytes":64},{"type_url":"`+buyStorage+`"}]}}}`))
+	require.NoError(t, err)
+
+	data, err := Query(deps, env, []byte(`{"stargate_allowlist":{"spender":"dave"}}`))
+	require.NoError(t, err)
+	var sres contractTypes.StargateAllowlistResponse
+	require.NoError(t, sres.UnmarshalJSON(data))
+	require.NotNil(t, sres.Allowlist)
+	assert.Len(t, sres.Allowlist.Types, 2)
+
+	cases := map[string]struct {
+		msg string
+		err string
+	}{
+		"allowed":           {msg: stargateMsg(postFile, 64)},
+		"no size limit":     {msg: stargateMsg(buyStorage, 4096)},
+		"too large":         {msg: stargateMsg(postFile, 65), err: "Contract Error: Stargate message exceeds 64 bytes"},
+		"unlisted type url": {msg: stargateMsg("/cosmos.gov.v1beta1.MsgVote", 10), err: "Contract Error: Type URL Not Allowed"},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			var qres contractTypes.CanExecuteResponse
+			data, err := Query(deps, env, []byte(`{"can_execute":{"sender":"dave","msg":`+tc.msg+`}}`))
+			require.NoError(t, err)
+			require.NoError(t, json.Unmarshal(data, &qres))
+			assert.Equal(t, tc.err == "", qres.CanExecute)
+
+			_, err = Execute(deps, env, mock.Info("dave", nil), []byte(`{"execute":{"msgs":[`+tc.msg+`]}}`))
+			if tc.err == "" {
+				require.NoError(t, err)
+			} else {
+				require.EqualError(t, err, tc.err)
+			}
+		})
+	}
+
+	_, err = Execute(deps, env, mock.Info("alice", nil), []byte(`{"revoke":{"spender":"dave"}}`))
+	require.NoError(t, err)
+	data, err = Query(deps, env, []byte(`{"stargate_allowlist":{"spender":"dave"}}`))
+	require.NoError(t, err)
+	sres = contractTypes.StargateAllowlistResponse{}
+	require.NoError(t, sres.UnmarshalJSON(data))
+	assert.Nil(t, sres.Allowlist)
+}
+
+func TestSpenderVote(t *testing.T) {
+	deps, env := defaultInit(t, FUND)
+
+	vote := func(id uint64) string {
+		bz, err := types.VoteMsg{ProposalId: id, Vote: types.VoteYes}.ToMsg().MarshalJSON()
+		require.NoError(t, err)
+		return string(bz)
+	}
+
+	_, err := Execute(deps, env, mock.Info("alice", nil), []byte(`{"set_permissions":{"spender":"dave","permissions":{"delegate":true}}}`))
+	require.NoError(t, err)
+	_, err = Execute(deps, env, mock.Info("dave", nil), []byte(`{"execute":{"msgs":[`+vote(7)+`]}}`))
+	require.EqualError(t, err, "Contract Error: Vote Perm")
+
+	_, err = Execute(deps, env, mock.Info("alice", nil), []byte(`{"set_permissions":{"spender":"dave","permissions":{"vote":true,"vote_proposals":[7,8]}}}`))
+	require.NoError(t, err)
+
+	res, err := Execute(deps, env, mock.Info("dave", nil), []byte(`{"execute":{"msgs":[`+vote(7)+`]}}`))
+	require.NoError(t, err)
+	assert.Equal(t, uint64(7), res.Messages[0].Msg.Gov.Vote.ProposalId)
+	_, err = Execute(deps, env, mock.Info("dave", nil), []byte(`{"execute":{"msgs":[`+vote(9)+`]}}`))
+	require.EqualError(t, err, "Contract Error: Vote Proposal Perm")
+
+	var qres contractTypes.CanExecuteResponse
+	data, err := Query(deps, env, []byte(`{"can_execute":{"sender":"dave","msg":`+vote(8)+`}}`))
+	require.NoError(t, err)
+	require.NoError(t, json.Unmarshal(data, &qres))
+	assert.True(t, qres.CanExecute)
+	data, err = Query(deps, env, []byte(`{"can_execute":{"sender":"dave","msg":`+vote(9)+`}}`))
+	require.NoError(t, err)
+	require.NoError(t, json.Unmarshal(data, &qres))
+	assert.False(t, qres.CanExecute)
+
+	// sub-grants can only narrow the proposals
+	_, err = Execute(deps, env, mock.Info("dave", nil), []byte(`{"grant_permissions":{"spender":"erin","permissions":{"vote":true}}}`))
+	require.EqualError(t, err, "Cannot grant more than own permissions")
+	_, err = Execute(deps, env, mock.Info("dave", nil), []byte(`{"grant_permissions":{"spender":"erin","permissions":{"vote":true,"vote_proposals":[8]}}}`))
+	require.NoError(t, err)
+	_, err = Execute(deps, env, mock.Info("erin", nil), []byte(`{"execute":{"msgs":[`+vote(7)+`]}}`))
+	require.EqualError(t, err, "Contract Error: Vote Proposal Perm")
+}
+
+func TestSessionPermissions(t *testing.T) {
+	deps, env := defaultInit(t, FUND)
+
+	delegate := `{"staking":{"delegate":{"validator":"val","amount":{"denom":"ujkl","amount":"10"}}}}`
+	canExecute := func(env types.Env) bool {
+		var qres contractTypes.CanExecuteResponse
+		data, err := Query(deps, env, []byte(`{"can_execute":{"sender":"dave","msg":`+delegate+`}}`))
+		require.NoError(t, err)
+		require.NoError(t, json.Unmarshal(data, &qres))
+		return qres.CanExecute
+	}
+	allPermissions := func(env types.Env) []contractTypes.PermissionInfo {
+		data, err := Query(deps, env, []byte(`{"all_permissions":{}}`))
+		require.NoError(t, err)
+		var pres contractTypes.AllPermissionsResponse
+		require.NoError(t, pres.UnmarshalJSON(data))
+		return pres.Permissions
+	}
+
+	_, err := Execute(deps, env, mock.Info("alice", nil), []byte(`{"set_permissions":{"spender":"dave","permissions":{"delegate":true,"expires":{"at_height":12000}}}}`))
+	require.EqualError(t, err, "setting expired permissions")
+	_, err = Execute(deps, env, mock.Info("alice", nil), []byte(`{"set_permissions":{"spender":"dave","permissions":{"delegate":true,"expires":{"at_height":12350},"uses":2}}}`))
+	require.NoError(t, err)
+	_, err = Execute(deps, env, mock.Info("alice", nil), []byte(`{"set_permissions":{"spender":"erin","permissions":{"withdraw":true}}}`))
+	require.NoError(t, err)
+	assert.Len(t, allPermissions(env), 2)
+
+	// every permissioned message uses one up
+	_, err = Execute(deps, env, mock.Info("dave", nil), []byte(`{"execute":{"msgs":[`+delegate+`,`+delegate+`,`+delegate+`]}}`))
+	require.EqualError(t, err, "Contract Error: Permissions Used Up")
+	_, err = Execute(deps, env, mock.Info("dave", nil), []byte(`{"execute":{"msgs":[`+delegate+`]}}`))
+	require.NoError(t, err)
+	assert.True(t, canExecute(env))
+
+	data, err := Query(deps, env, []byte(`{"permissions":{"spender":"dave"}}`))
+	require.NoError(t, err)
+	var perm contractTypes.Permissions
+	require.NoError(t, perm.UnmarshalJSON(data))
+	require.NotNil(t, perm.Uses)
+	assert.Equal(t, uint32(1), *perm.Uses)
+
+	// expired permissions are absent
+	later := env
+	later.Block.Height = 12350
+	assert.False(t, canExecute(later))
+	_, err = Execute(deps, later, mock.Info("dave", nil), []byte(`{"execute":{"msgs":[`+delegate+`]}}`))
+	require.EqualError(t, err, "can't find perm")
+	assert.Equal(t, "erin", allPermissions(later)[0].Spender)
+	assert.Len(t, allPermissions(later), 1)
+
+	// so are used up ones
+	_, err = Execute(deps, env, mock.Info("dave", nil), []byte(`{"execute":{"msgs":[`+delegate+`]}}`))
+	require.NoError(t, err)
+	assert.False(t, canExecute(env))
+	_, err = Query(deps, env, []byte(`{"permissions":{"spender":"dave"}}`))
+	require.EqualError(t, err, "permissions expired or used up")
+	assert.Len(t, allPermissions(env), 1)
+}
+
+func cw20Execute(t *testing.T, token string, msg string, funds ...types.Coin) string {
+	bz, err := types.WasmMsg{Execute: &types.ExecuteMsg{ContractAddr: token, Msg: []byte(msg), Funds: funds}}.ToMsg().MarshalJSON()
+	require.NoError(t, err)
+	return string(bz)
+}
+
+func TestCw20Allowance(t *testing.T) {
+	deps, env := defaultInit(t, FUND)
+
+	transfer := cw20Execute(t, "reward-token", `{"transfer":{"recipient":"shop","amount":"300"}}`)
+	send := cw20Execute(t, "reward-token", `{"send":{"contract":"market","amount":"200","msg":"e30="}}`)
+
+	_, err := Execute(deps, env, mock.Info("dave", nil), []byte(`{"execute":{"msgs":[`+transfer+`]}}`))
+	require.EqualError(t, err, "can't find cw20 allowance")
+
+	_, err = Execute(deps, env, mock.Info("dave", nil), []byte(`{"increase_cw20_allowance":{"spender":"dave","token":"reward-token","amount":"1000"}}`))
+	require.EqualError(t, err, "Unauthorized")
+	_, err = Execute(deps, env, mock.Info("alice", nil), []byte(`{"increase_cw20_allowance":{"spender":"dave","token":"reward-token","amount":"1000"}}`))
+	require.NoError(t, err)
+
+	cases := map[string]struct {
+		msg string
+		err string
+	}{
+		"transfer":        {msg: transfer},
+		"send":            {msg: send},
+		"other token":     {msg: cw20Execute(t, "other-token", `{"transfer":{"recipient":"shop","amount":"1"}}`), err: "unable to decrease cw20 allowance"},
+		"over allowance":  {msg: cw20Execute(t, "reward-token", `{"transfer":{"recipient":"shop","amount":"1001"}}`), err: "unable to decrease cw20 allowance"},
+		"burn":            {msg: cw20Execute(t, "reward-token", `{"burn":{"amount":"1"}}`), err: "Contract Error: Unsupported cw20 Message"},
+		"unfunded native": {msg: cw20Execute(t, "reward-token", `{"transfer":{"recipient":"shop","amount":"1"}}`, types.NewCoin(math.NewUint128FromUint64(5), "ujkl")), err: "can't find allowance"},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			var qres contractTypes.CanExecuteResponse
+			data, err := Query(deps, env, []byte(`{"can_execute":{"sender":"dave","msg":`+tc.msg+`}}`))
+			require.NoError(t, err)
+			require.NoError(t, json.Unmarshal(data, &qres))
+			assert.Equal(t, tc.err == "", qres.CanExecute)
+		})
+	}
+
+	_, err = Execute(deps, env, mock.Info("dave", nil), []byte(`{"execute":{"msgs":[`+transfer+`,`+send+`]}}`))
+	require.NoError(t, err)
+
+	data, err := Query(deps, env, []byte(`{"cw20_allowance":{"spender":"dave"}}`))
+	require.NoError(t, err)
+	var ares contractTypes.Cw20Allowances
+	require.NoError(t, ares.UnmarshalJSON(data))
+	require.Len(t, ares.Tokens, 1)
+	assert.Equal(t, "500", ares.Tokens[0].Amount.String())
+
+	_, err = Execute(deps, env, mock.Info("alice", nil), []byte(`{"decrease_cw20_allowance":{"spender":"dave","token":"reward-token","amount":"600"}}`))
+	require.NoError(t, err)
+	_, err = Query(deps, env, []byte(`{"cw20_allowance":{"spender":"dave"}}`))
+	require.Error(t, err)
+}
+
+func TestFundAllowance(t *testing.T) {
+	deps, env := defaultInit(t, FUND)
+
+	funds := []types.Coin{types.NewCoin(math.NewUint128FromUint64(25), "ujkl")}
+
+	_, err := Execute(deps, env, mock.Info("carl", funds), []byte(`{"fund_allowance":{"spender":"dave"}}`))
+	require.EqualError(t, err, "Contract Error No Allowance")
+
+	_, err = Execute(deps, env, mock.Info("alice", nil), []byte(`{"increase_allowance":{"spender":"dave","amount":{"denom":"ujkl","amount":"10"}}}`))
+	require.NoError(t, err)
+
+	_, err = Execute(deps, env, mock.Info("carl", nil), []byte(`{"fund_allowance":{"spender":"dave"}}`))
+	require.EqualError(t, err, "No funds attached")
+	res, err := Execute(deps, env, mock.Info("carl", funds), []byte(`{"fund_allowance":{"spender":"dave"}}`))
+	require.NoError(t, err)
+	assert.Len(t, res.Messages, 0)
+
+	data, err := Query(deps, env, []byte(`{"allowance":{"spender":"dave"}}`))
+	require.NoError(t, err)
+	var ares contractTypes.Allowances
+	require.NoError(t, ares.UnmarshalJSON(data))
+	assert.Equal(t, "35", ares.Balance.Coins[0].Amount.String())
+
+	// restricted to admins and listed funders
+	_, err = Execute(deps, env, mock.Info("carl", nil), []byte(`{"set_top_up_config":{"restricted":true,"funders":["erin"]}}`))
+	require.EqualError(t, err, "Unauthorized")
+	_, err = Execute(deps, env, mock.Info("alice", nil), []byte(`{"set_top_up_config":{"restricted":true,"funders":["erin"]}}`))
+	require.NoError(t, err)
+
+	data, err = Query(deps, env, []byte(`{"top_up_config":{}}`))
+	require.NoError(t, err)
+	var cres contractTypes.TopUpConfig
+	require.NoError(t, cres.UnmarshalJSON(data))
+	assert.Equal(t, contractTypes.TopUpConfig{Restricted: true, Funders: []string{"erin"}}, cres)
+
+	_, err = Execute(deps, env, mock.Info("carl", funds), []byte(`{"fund_allowance":{"spender":"dave"}}`))
+	require.EqualError(t, err, "Unauthorized")
+	_, err = Execute(deps, env, mock.Info("erin", funds), []byte(`{"fund_allowance":{"spender":"dave"}}`))
+	require.NoError(t, err)
+	_, err = Execute(deps, env, mock.Info("bob", funds), []byte(`{"fund_allowance":{"spender":"dave"}}`))
+	require.NoError(t, err)
+
+	data, err = Query(deps, env, []byte(`{"allowance":{"spender":"dave"}}`))
+	require.NoError(t, err)
+	require.NoError(t, ares.UnmarshalJSON(data))
+	assert.Equal(t, "85", ares.Balance.Coins[0].Amount.String())
+}
+
+// oracleQuerier answers price queries for the "oracle" contract, everything else goes to the mock querier
+type oracleQuerier struct {
+	std.Querier
+	prices map[string]contractTypes.OraclePriceResponse
+}
+
+func (q *oracleQuerier) RawQuery(raw []byte) ([]byte, error) {
+	var request types.QueryRequest
+	err := request.UnmarshalJSON(raw)
+	if err != nil {
+		return nil, err
+	}
+	if request.Wasm == nil || request.Wasm.Smart == nil || request.Wasm.Smart.ContractAddr != "oracle" {
+		return q.Querier.RawQuery(raw)
+	}
+
+	var msg contractTypes.OracleQueryMsg
+	err = msg.UnmarshalJSON(request.Wasm.Smart.Msg)
+	if err != nil {
+		return nil, err
+	}
+	price, ok := q.prices[msg.Price.Denom]
+	if !ok {
+		return nil, errors.New("no price")
+	}
+	return price.MarshalJSON()
+}
+
+func TestQuoteAllowance(t *testing.T) {
+	deps, env := defaultInit(t, FUND)
+	now := env.Block.Time / 1_000_000_000
+	deps.Querier = &oracleQuerier{
+		Querier: deps.Querier,
+		prices: map[string]contractTypes.OraclePriceResponse{
+			// 1 ujkl = 2 uusdc
+			"ujkl": {Price: math.NewUint128FromUint64(2_000_000_000_000_000_000), UpdatedAt: now},
+			// stale, 1 uatom = 10 uusdc
+			"uatom": {Price: math.NewUint128FromUint64(10_000_000_000_000_000_000), UpdatedAt: now - 600},
+			// a zero price is no price
+			"uosmo": {Price: math.ZeroUint128(), UpdatedAt: now},
+			// timestamped after the block, which is no price
+			"ujuno": {Price: contractTypes.PRICE_PRECISION, UpdatedAt: stdmath.MaxUint64},
+		},
+	}
+
+	send := func(denom string, amount string) string {
+		return `{"bank":{"send":{"to_address":"shop","amount":[{"denom":"` + denom + `","amount":"` + amount + `"}]}}}`
+	}
+
+	_, err := Execute(deps, env, mock.Info("dave", nil), []byte(`{"set_oracle":{"config":{"oracle":"oracle","quote":"uusdc"}}}`))
+	require.EqualError(t, err, "Unauthorized")
+	_, err = Execute(deps, env, mock.Info("alice", nil), []byte(`{"set_oracle":{"config":{"oracle":"oracle","quote":"uusdc","fallback":[{"denom":"uosmo","price":"0"}]}}}`))
+	require.EqualError(t, err, "Fallback price for uosmo cannot be zero")
+	_, err = Execute(deps, env, mock.Info("alice", nil), []byte(`{"set_oracle":{"config":{"oracle":"oracle","quote":"uusdc","max_age":300,"fallback":[{"denom":"uatom","price":"5000000000000000000"}]}}}`))
+	require.NoError(t, err)
+	_, err = Execute(deps, env, mock.Info("alice", nil), []byte(`{"set_quote_allowance":{"spender":"dave","amount":"100"}}`))
+	require.NoError(t, err)
+
+	data, err := Query(deps, env, []byte(`{"oracle":{}}`))
+	require.NoError(t, err)
+	var ores contractTypes.OracleResponse
+	require.NoError(t, ores.UnmarshalJSON(data))
+	assert.Equal(t, "uusdc", ores.Config.Quote)
+
+	_, err = Execute(deps, env, mock.Info("dave", nil), []byte(`{"execute":{"msgs":[`+send("ujuno", "1")+`]}}`))
+	require.EqualError(t, err, "Contract Error: No price for ujuno")
+
+	// 30ujkl at 2 + 10uusdc at 1 + 6uatom at the fallback 5 = 100
+	_, err = Execute(deps, env, mock.Info("dave", nil), []byte(`{"execute":{"msgs":[`+send("ujkl", "30")+`,`+send("uusdc", "10")+`,`+send("uatom", "6")+`]}}`))
+	require.NoError(t, err)
+
+	data, err = Query(deps, env, []byte(`{"quote_allowance":{"spender":"dave"}}`))
+	require.NoError(t, err)
+	var qres contractTypes.QuoteAllowance
+	require.NoError(t, qres.UnmarshalJSON(data))
+	assert.Equal(t, "0", qres.Remaining.String())
+
+	_, err = Execute(deps, env, mock.Info("alice", nil), []byte(`{"set_quote_allowance":{"spender":"dave","amount":"10"}}`))
+	require.NoError(t, err)
+	_, err = Execute(deps, env, mock.Info("dave", nil), []byte(`{"execute":{"msgs":[`+send("ujkl", "6")+`]}}`))
+	require.EqualError(t, err, "unable to decrease quote allowance")
+	_, err = Execute(deps, env, mock.Info("dave", nil), []byte(`{"execute":{"msgs":[`+send("uosmo", "1")+`]}}`))
+	require.EqualError(t, err, "Contract Error: No price for uosmo")
+
+	// revoking drops the quote allowance
+	_, err = Execute(deps, env, mock.Info("alice", nil), []byte(`{"revoke":{"spender":"dave"}}`))
+	require.NoError(t, err)
+	_, err = Query(deps, env, []byte(`{"quote_allowance":{"spender":"dave"}}`))
+	require.Error(t, err)
+}
+
+func TestDenyList(t *testing.T) {
+	deps, env := defaultInit(t, FUND)
+
+	_, err := Execute(deps, env, mock.Info("alice", nil), []byte(`{"increase_allowance":{"spender":"dave","amount":{"denom":"ujkl","amount":"100"}}}`))
+	require.NoError(t, err)
+	_, err = Execute(deps, env, mock.Info("alice", nil), []byte(`{"set_permissions":{"spender":"dave","permissions":{"delegate":true,"redelegate":true,"undelegate":true}}}`))
+	require.NoError(t, err)
+	_, err = Execute(deps, env, mock.Info("alice", nil), []byte(`{"set_policy":{"spender":"erin","policy":{"rules":[{"id":"any","effect":"allow","kinds":["bank_send"]}]}}}`))
+	require.NoError(t, err)
+
+	send := `{"bank":{"send":{"to_address":"mallory","amount":[{"denom":"ujkl","amount":"5"}]}}}`
+	delegate := `{"staking":{"delegate":{"validator":"slashed","amount":{"denom":"ujkl","amount":"10"}}}}`
+	redelegate := `{"staking":{"redelegate":{"src_validator":"val","dst_validator":"slashed","amount":{"denom":"ujkl","amount":"10"}}}}`
+	undelegate := `{"staking":{"undelegate":{"validator":"slashed","amount":{"denom":"ujkl","amount":"10"}}}}`
+
+	_, err = Execute(deps, env, mock.Info("dave", nil), []byte(`{"update_deny_list":{"add_recipients":["mallory"]}}`))
+	require.EqualError(t, err, "Unauthorized")
+	_, err = Execute(deps, env, mock.Info("alice", nil), []byte(`{"update_deny_list":{"add_recipients":["mallory"],"add_validators":["slashed"]}}`))
+	require.NoError(t, err)
+
+	data, err := Query(deps, env, []byte(`{"deny_list":{}}`))
+	require.NoError(t, err)
+	var dres contractTypes.DenyList
+	require.NoError(t, dres.UnmarshalJSON(data))
+	assert.Equal(t, []string{"mallory"}, dres.Recipients)
+	assert.Equal(t, []string{"slashed"}, dres.Validators)
+
+	_, err = Execute(deps, env, mock.Info("dave", nil), []byte(`{"execute":{"msgs":[`+send+`]}}`))
+	require.EqualError(t, err, "Contract Error: Recipient mallory is denied")
+	_, err = Execute(deps, env, mock.Info("dave", nil), []byte(`{"execute":{"msgs":[`+delegate+`]}}`))
+	require.EqualError(t, err, "Contract Error: Validator slashed is denied")
+	_, err = Execute(deps, env, mock.Info("dave", nil), []byte(`{"execute":{"msgs":[`+redelegate+`]}}`))
+	require.EqualError(t, err, "Contract Error: Validator slashed is denied")
+	_, err = Execute(deps, env, mock.Info("dave", nil), []byte(`{"execute":{"msgs":[`+undelegate+`]}}`))
+	require.NoError(t, err)
+
+	// policies don't override the deny list
+	_, err = Execute(deps, env, mock.Info("erin", nil), []byte(`{"execute":{"msgs":[`+send+`]}}`))
+	require.EqualError(t, err, "Contract Error: Recipient mallory is denied")
+
+	// neither do cw20 transfers through a token contract
+	_, err = Execute(deps, env, mock.Info("dave", nil), []byte(`{"execute":{"msgs":[`+cw20Execute(t, "token", `{"transfer":{"recipient":"mallory","amount":"1"}}`)+`]}}`))
+	require.EqualError(t, err, "Contract Error: Recipient mallory is denied")
+
+	var qres contractTypes.CanExecuteResponse
+	data, err = Query(deps, env, []byte(`{"can_execute":{"sender":"dave","msg":`+send+`}}`))
+	require.NoError(t, err)
+	require.NoError(t, json.Unmarshal(data, &qres))
+	assert.False(t, qres.CanExecute)
+
+	// admins are exempt
+	_, err = Execute(deps, env, mock.Info("alice", nil), []byte(`{"execute":{"msgs":[`+send+`]}}`))
+	require.NoError(t, err)
+
+	_, err = Execute(deps, env, mock.Info("alice", nil), []byte(`{"update_deny_list":{"remove_recipients":["mallory"]}}`))
+	require.NoError(t, err)
+	_, err = Execute(deps, env, mock.Info("dave", nil), []byte(`{"execute":{"msgs":[`+send+`]}}`))
+	require.NoError(t, err)
+}
+
+func TestIndexedQueries(t *testing.T) {
+	deps, env := defaultInit(t, FUND)
+
+	grant := func(spender string, denom string, height string) {
+		_, err := Execute(deps, env, mock.Info("alice", nil), []byte(`{"increase_allowance":{"spender":"`+spender+`","amount":{"denom":"`+denom+`","amount":"10"},"expires":{"at_height":`+height+`}}}`))
+		require.NoError(t, err)
+	}
+	grant("dave", "ujkl", "20000")
+	grant("erin", "uatom", "15000")
+	grant("frank", "ujkl", "15000")
+	grant("gina", "ujkl", "90000")
+
+	spenders := func(data []byte) []string {
+		var res contractTypes.AllAllowancesResponse
+		require.NoError(t, res.UnmarshalJSON(data))
+		var names []string
+		for _, allow := range res.Allowances {
+			names = append(names, allow.Spender)
+		}
+		return names
+	}
+
+	data, err := Query(deps, env, []byte(`{"allowances_expiring":{"before_height":50000}}`))
+	require.NoError(t, err)
+	assert.Equal(t, []string{"erin", "frank", "dave"}, spenders(data))
+
+	data, err = Query(deps, env, []byte(`{"allowances_expiring":{"before_height":50000,"start_after":"erin","limit":1}}`))
+	require.NoError(t, err)
+	assert.Equal(t, []string{"frank"}, spenders(data))
+
+	// the index follows allowance changes
+	_, err = Execute(deps, env, mock.Info("alice", nil), []byte(`{"decrease_allowance":{"spender":"frank","amount":{"denom":"ujkl","amount":"10"},"expires":{"at_height":99999}}}`))
+	require.NoError(t, err)
+	data, err = Query(deps, env, []byte(`{"allowances_expiring":{"before_height":50000}}`))
+	require.NoError(t, err)
+	assert.Equal(t, []string{"erin", "dave"}, spenders(data))
+
+	data, err = Query(deps, env, []byte(`{"allowances_by_denom":{"denom":"ujkl"}}`))
+	require.NoError(t, err)
+	assert.Equal(t, []string{"dave", "gina"}, spenders(data))
+
+	data, err = Query(deps, env, []byte(`{"allowances_by_denom":{"denom":"ujkl","start_after":"dave"}}`))
+	require.NoError(t, err)
+	assert.Equal(t, []string{"gina"}, spenders(data))
+
+	_, err = Execute(deps, env, mock.Info("alice", nil), []byte(`{"set_permissions":{"spender":"dave","permissions":{"delegate":true,"withdraw":true}}}`))
+	require.NoError(t, err)
+	_, err = Execute(deps, env, mock.Info("alice", nil), []byte(`{"set_permissions":{"spender":"erin","permissions":{"withdraw":true}}}`))
+	require.NoError(t, err)
+
+	data, err = Query(deps, env, []byte(`{"permissions_with":{"permission":"withdraw"}}`))
+	require.NoError(t, err)
+	var pres contractTypes.AllPermissionsResponse
+	require.NoError(t, pres.UnmarshalJSON(data))
+	require.Len(t, pres.Permissions, 2)
+	assert.Equal(t, "dave", pres.Permissions[0].Spender)
+	assert.Equal(t, "erin", pres.Permissions[1].Spender)
+
+	_, err = Execute(deps, env, mock.Info("alice", nil), []byte(`{"revoke":{"spender":"dave"}}`))
+	require.NoError(t, err)
+	data, err = Query(deps, env, []byte(`{"permissions_with":{"permission":"delegate"}}`))
+	require.NoError(t, err)
+	require.NoError(t, pres.UnmarshalJSON(data))
+	assert.Empty(t, pres.Permissions)
+}
+
+func TestAllowanceHistory(t *testing.T) {
+	deps, env := defaultInit(t, FUND)
+	granted := env.Block.Height
+
+	_, err := Execute(deps, env, mock.Info("alice", nil), []byte(`{"increase_allowance":{"spender":"dave","amount":{"denom":"ujkl","amount":"100"}}}`))
+	require.NoError(t, err)
+
+	env.Block.Height += 5
+	send := `{"bank":{"send":{"to_address":"shop","amount":[{"denom":"ujkl","amount":"30"}]}}}`
+	_, err = Execute(deps, env, mock.Info("dave", nil), []byte(`{"execute":{"msgs":[`+send+`]}}`))
+	require.NoError(t, err)
+
+	env.Block.Height += 5
+	_, err = Execute(deps, env, mock.Info("alice", nil), []byte(`{"revoke":{"spender":"dave"}}`))
+	require.NoError(t, err)
+
+	balanceAt := func(height uint64) string {
+		data, err := Query(deps, env, []byte(`{"allowance":{"spender":"dave","height":`+strconv.FormatUint(height, 10)+`}}`))
+		require.NoError(t, err)
+		var ares contractTypes.Allowances
+		require.NoError(t, ares.UnmarshalJSON(data))
+		return ares.Balance.Coins[0].Amount.String()
+	}
+
+	_, err = Query(deps, env, []byte(`{"allowance":{"spender":"dave","height":`+strconv.FormatUint(granted, 10)+`}}`))
+	require.EqualError(t, err, "No allowance at height")
+	assert.Equal(t, "100", balanceAt(granted+1))
+	// the spend's own block still shows the allowance it was checked against
+	assert.Equal(t, "100", balanceAt(granted+5))
+	assert.Equal(t, "70", balanceAt(granted+6))
+	assert.Equal(t, "70", balanceAt(granted+10))
+
+	_, err = Query(deps, env, []byte(`{"allowance":{"spender":"dave","height":`+strconv.FormatUint(granted+11, 10)+`}}`))
+	require.EqualError(t, err, "No allowance at height")
+}
+
+func TestAdminActivity(t *testing.T) {
+	deps, env := defaultInit(t, FUND)
+
+	lastActivity := func() uint64 {
+		data, err := Query(deps, env, []byte(`{"inheritance":{}}`))
+		require.NoError(t, err)
+		var ires cw1WhiteListTypes.InheritanceResponse
+		require.NoError(t, json.Unmarshal(data, &ires))
+		return ires.LastActivity.Height
+	}
+
+	admin := map[string]string{
+		"increase_allowance": `{"increase_allowance":{"spender":"dave","amount":{"denom":"ujkl","amount":"100"}}}`,
+		"set_permissions":    `{"set_permissions":{"spender":"dave","permissions":{"delegate":true}}}`,
+		"set_policy":         `{"set_policy":{"spender":"erin","policy":{"rules":[{"id":"any","effect":"allow"}]}}}`,
+		"add_hook":           `{"add_hook":{"addr":"accounting"}}`,
+		"update_deny_list":   `{"update_deny_list":{"add_recipients":["mallory"]}}`,
+		"set_oracle":         `{"set_oracle":{"config":{"oracle":"oracle","quote":"uusdc"}}}`,
+	}
+	for name, msg := range admin {
+		env.Block.Height++
+		_, err := Execute(deps, env, mock.Info("alice", nil), []byte(msg))
+		require.NoError(t, err, name)
+		assert.Equal(t, env.Block.Height, lastActivity(), name)
+	}
+	recorded := env.Block.Height
+
+	// spenders and failed admin calls aren't admin activity
+	env.Block.Height++
+	send := `{"bank":{"send":{"to_address":"shop","amount":[{"denom":"ujkl","amount":"30"}]}}}`
+	_, err := Execute(deps, env, mock.Info("dave", nil), []byte(`{"execute":{"msgs":[`+send+`]}}`))
+	require.NoError(t, err)
+	_, err = Execute(deps, env, mock.Info("bob", nil), []byte(`{"decrease_allowance":{"spender":"nobody","amount":{"denom":"ujkl","amount":"1"}}}`))
+	require.Error(t, err)
+	assert.Equal(t, recorded, lastActivity())
+}
+
+func TestAlreadyExpired(t *testing.T) {
+	deps, env := defaultInit(t, FUND)
+
+	// zero heights and times decode, and are refused as already expired
+	for _, expires := range []string{`{"at_height":0}`, `{"at_time":"0"}`, `{"at_height":12345}`} {
+		_, err := Execute(deps, env, mock.Info("alice", nil), []byte(`{"increase_allowance":{"spender":"dave","amount":{"denom":"ujkl","amount":"10"},"expires":`+expires+`}}`))
+		require.EqualError(t, err, "setting expired allowance", expires)
+	}
+
+	_, err := Execute(deps, env, mock.Info("alice", nil), []byte(`{"increase_allowance":{"spender":"dave","amount":{"denom":"ujkl","amount":"10"},"expires":{"at_height":12346}}}`))
+	require.NoError(t, err)
+}
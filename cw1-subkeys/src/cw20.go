@@ -0,0 +1,174 @@
+package src
+
+import (
+	"errors"
+
+	contractTypes "github.com/JackalLabs/burrow-contracts/cw1-subkeys/src/types"
+
+	"github.com/CosmWasm/cosmwasm-go/std"
+	"github.com/CosmWasm/cosmwasm-go/std/types"
+	cw1WhiteList "github.com/JackalLabs/burrow-contracts/cw1-whitelist/src"
+)
+
+// checkCw20Grant makes sure an admin is budgeting a valid token for another valid address
+func checkCw20Grant(deps *std.Deps, sender string, spender string, token string) error {
+	state, err := cw1WhiteList.LoadState(deps.Storage)
+	if err != nil {
+		return err
+	}
+
+	// check if sender is admin
+	if !state.IsAdmin(sender) {
+		return errors.New("Unauthorized")
+	}
+
+	err = deps.Api.ValidateAddress(spender)
+	if err != nil {
+		return err
+	}
+
+	err = deps.Api.ValidateAddress(token)
+	if err != nil {
+		return err
+	}
+
+	// sender can't be spender
+	if spender == sender {
+		return errors.New("Cannot Set Your own Account")
+	}
+
+	return nil
+}
+
+func executeIncreaseCw20Allowance(deps *std.Deps, env *types.Env, info *types.MessageInfo, msg *contractTypes.IncreaseCw20Allowance) (*types.Response, error) {
+	sender := info.Sender
+
+	err := checkCw20Grant(deps, sender, msg.Spender, msg.Token)
+	if err != nil {
+		return nil, err
+	}
+
+	var allow contractTypes.Cw20Allowances
+	var emptyExpiration contractTypes.Expiration
+
+	prev, err := LoadCw20Allowances(deps.Storage, msg.Spender)
+	if err == nil {
+		allow = *prev
+	}
+
+	if msg.Expires != emptyExpiration {
+		if msg.Expires.IsExpired(env.Block) {
+			return nil, errors.New("setting expired allowance")
+		}
+		allow.Expires = msg.Expires
+	} else if allow.Expires.IsExpired(env.Block) {
+		return nil, errors.New("setting expired allowance")
+	}
+
+	err = allow.Add(msg.Token, msg.Amount)
+	if err != nil {
+		return nil, err
+	}
+
+	err = SaveCw20Allowances(deps.Storage, msg.Spender, &allow)
+	if err != nil {
+		return nil, err
+	}
+
+	hooks, err := hookMessages(deps.Storage, contractTypes.HookMsg{
+		Cw20AllowanceChanged: &contractTypes.Cw20AllowanceChangedHook{
+			Spender:   msg.Spender,
+			Increase:  true,
+			Token:     msg.Token,
+			Amount:    msg.Amount,
+			Allowance: allow,
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	res := &types.Response{
+		Attributes: []types.EventAttribute{
+			{Key: "action", Value: "increase_cw20_allowance"},
+			{Key: "owner", Value: sender},
+			{Key: "spender", Value: msg.Spender},
+			{Key: "token", Value: msg.Token},
+			{Key: "amount", Value: msg.Amount.String()},
+		},
+		Messages: hooks,
+	}
+	return res, nil
+}
+
+func executeDecreaseCw20Allowance(deps *std.Deps, env *types.Env, info *types.MessageInfo, msg *contractTypes.DecreaseCw20Allowance) (*types.Response, error) {
+	sender := info.Sender
+
+	err := checkCw20Grant(deps, sender, msg.Spender, msg.Token)
+	if err != nil {
+		return nil, err
+	}
+
+	var emptyExpiration contractTypes.Expiration
+
+	allow, err := LoadCw20Allowances(deps.Storage, msg.Spender)
+	if err != nil {
+		return nil, errors.New("Contract Error No Allowance")
+	}
+
+	if msg.Expires != emptyExpiration {
+		if msg.Expires.IsExpired(env.Block) {
+			return nil, errors.New("setting expired allowance")
+		}
+		allow.Expires = msg.Expires
+	}
+
+	err = allow.SubSaturating(msg.Token, msg.Amount)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(allow.Tokens) == 0 {
+		err = RemoveCw20Allowances(deps.Storage, msg.Spender)
+		allow = &contractTypes.Cw20Allowances{}
+	} else {
+		err = SaveCw20Allowances(deps.Storage, msg.Spender, allow)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	hooks, err := hookMessages(deps.Storage, contractTypes.HookMsg{
+		Cw20AllowanceChanged: &contractTypes.Cw20AllowanceChangedHook{
+			Spender:   msg.Spender,
+			Increase:  false,
+			Token:     msg.Token,
+			Amount:    msg.Amount,
+			Allowance: *allow,
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	res := &types.Response{
+		Attributes: []types.EventAttribute{
+			{Key: "action", Value: "decrease_cw20_allowance"},
+			{Key: "owner", Value: sender},
+			{Key: "spender", Value: msg.Spender},
+			{Key: "token", Value: msg.Token},
+			{Key: "amount", Value: msg.Amount.String()},
+		},
+		Messages: hooks,
+	}
+	return res, nil
+}
+
+func queryCw20Allowance(deps *std.Deps, env *types.Env, msg *contractTypes.QueryCw20Allowance) (*contractTypes.Cw20Allowances, error) {
+	allow, err := LoadCw20Allowances(deps.Storage, msg.Spender)
+	if err != nil {
+		return nil, err
+	}
+
+	return allow, nil
+}
@@ -0,0 +1,74 @@
+package src
+
+import (
+	"errors"
+
+	contractTypes "github.com/JackalLabs/burrow-contracts/cw1-subkeys/src/types"
+
+	"github.com/CosmWasm/cosmwasm-go/std"
+	"github.com/CosmWasm/cosmwasm-go/std/types"
+	cw1WhiteList "github.com/JackalLabs/burrow-contracts/cw1-whitelist/src"
+)
+
+func executeUpdateDenyList(deps *std.Deps, env *types.Env, info *types.MessageInfo, msg *contractTypes.UpdateDenyList) (*types.Response, error) {
+	sender := info.Sender
+	state, err := cw1WhiteList.LoadState(deps.Storage)
+	if err != nil {
+		return nil, err
+	}
+
+	// check if sender is admin
+	if !state.IsAdmin(sender) {
+		return nil, errors.New("Unauthorized")
+	}
+
+	for _, addr := range msg.AddRecipients {
+		err = deps.Api.ValidateAddress(addr)
+		if err != nil {
+			return nil, err
+		}
+	}
+	for _, addr := range msg.AddValidators {
+		if addr == "" {
+			return nil, errors.New("Validator cannot be empty")
+		}
+	}
+
+	denyList, err := LoadDenyList(deps.Storage)
+	if err != nil {
+		return nil, err
+	}
+
+	denyList.Update(*msg)
+
+	err = SaveDenyList(deps.Storage, denyList)
+	if err != nil {
+		return nil, err
+	}
+
+	attributes := []types.EventAttribute{
+		{Key: "action", Value: "update_deny_list"},
+		{Key: "owner", Value: sender},
+	}
+	for _, addr := range msg.AddRecipients {
+		attributes = append(attributes, types.EventAttribute{Key: "deny_recipient", Value: addr})
+	}
+	for _, addr := range msg.RemoveRecipients {
+		attributes = append(attributes, types.EventAttribute{Key: "allow_recipient", Value: addr})
+	}
+	for _, addr := range msg.AddValidators {
+		attributes = append(attributes, types.EventAttribute{Key: "deny_validator", Value: addr})
+	}
+	for _, addr := range msg.RemoveValidators {
+		attributes = append(attributes, types.EventAttribute{Key: "allow_validator", Value: addr})
+	}
+
+	res := &types.Response{
+		Attributes: attributes,
+	}
+	return res, nil
+}
+
+func queryDenyList(deps *std.Deps, env *types.Env, msg *contractTypes.QueryDenyList) (*contractTypes.DenyList, error) {
+	return LoadDenyList(deps.Storage)
+}
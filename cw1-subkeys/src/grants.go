@@ -0,0 +1,393 @@
+package src
+
+import (
+	"errors"
+	"slices"
+
+	contractTypes "github.com/JackalLabs/burrow-contracts/cw1-subkeys/src/types"
+
+	"github.com/CosmWasm/cosmwasm-go/std"
+	"github.com/CosmWasm/cosmwasm-go/std/types"
+	cw1WhiteList "github.com/JackalLabs/burrow-contracts/cw1-whitelist/src"
+)
+
+// ancestors returns the grantors above the spender, closest first
+func ancestors(storage std.Storage, spender string) ([]string, error) {
+	var chain []string
+	for {
+		grant, err := LoadGrant(storage, spender)
+		if err != nil {
+			return nil, err
+		}
+		if grant.Parent == "" {
+			return chain, nil
+		}
+		chain = append(chain, grant.Parent)
+		spender = grant.Parent
+	}
+}
+
+// descendants returns every subkey granted below the spender, parents before their children
+func descendants(storage std.Storage, spender string) ([]string, error) {
+	grant, err := LoadGrant(storage, spender)
+	if err != nil {
+		return nil, err
+	}
+
+	var all []string
+	for _, child := range grant.Children {
+		below, err := descendants(storage, child)
+		if err != nil {
+			return nil, err
+		}
+		all = append(all, child)
+		all = append(all, below...)
+	}
+	return all, nil
+}
+
+// effectivePermissions narrows the spender's active permissions to what every grantor above it still holds
+func effectivePermissions(storage std.Storage, block types.BlockInfo, spender string) (*contractTypes.Permissions, error) {
+	perm, err := LoadPermissions(storage, spender)
+	if err != nil {
+		return nil, err
+	}
+	if !perm.IsActive(block) {
+		return nil, errors.New("permissions expired or used up")
+	}
+
+	chain, err := ancestors(storage, spender)
+	if err != nil {
+		return nil, err
+	}
+
+	effective := *perm
+	for _, grantor := range chain {
+		grantorPerm, err := LoadPermissions(storage, grantor)
+		if err != nil || !grantorPerm.IsActive(block) {
+			return &contractTypes.Permissions{}, nil
+		}
+		effective = effective.Intersect(*grantorPerm)
+	}
+
+	return &effective, nil
+}
+
+// heldPermissions are the stored permissions of the spender or one of its grantors
+type heldPermissions struct {
+	spender     string
+	permissions *contractTypes.Permissions
+}
+
+// permissionHolders returns the stored permissions of the spender and of every grantor above it that has any
+func permissionHolders(storage std.Storage, spender string) ([]heldPermissions, error) {
+	chain, err := ancestors(storage, spender)
+	if err != nil {
+		return nil, err
+	}
+
+	var holders []heldPermissions
+	for _, holder := range append([]string{spender}, chain...) {
+		perm, err := LoadPermissions(storage, holder)
+		if err != nil {
+			continue
+		}
+		holders = append(holders, heldPermissions{spender: holder, permissions: perm})
+	}
+	return holders, nil
+}
+
+// checkGrantor makes sure a subkey is granting to another, valid, non-admin address
+func checkGrantor(deps *std.Deps, sender string, spender string) error {
+	state, err := cw1WhiteList.LoadState(deps.Storage)
+	if err != nil {
+		return err
+	}
+
+	if state.IsAdmin(sender) {
+		return errors.New("Admins cannot sub-grant")
+	}
+
+	err = deps.Api.ValidateAddress(spender)
+	if err != nil {
+		return err
+	}
+
+	if state.IsAdmin(spender) {
+		return errors.New("Cannot grant to an admin")
+	}
+
+	// sender can't be spender
+	if spender == sender {
+		return errors.New("Cannot Set Your own Account")
+	}
+
+	return nil
+}
+
+// linkChild records parent as the grantor of child
+func linkChild(storage std.Storage, parent string, child string) error {
+	childGrant, err := LoadGrant(storage, child)
+	if err != nil {
+		return err
+	}
+
+	switch {
+	case childGrant.Parent == parent:
+		return nil
+	case childGrant.Parent != "":
+		return errors.New("Spender already granted by " + childGrant.Parent)
+	}
+
+	// subkeys set up by admins can't be taken over
+	_, permErr := LoadPermissions(storage, child)
+	_, allowErr := LoadAllowances(storage, child)
+	if permErr == nil || allowErr == nil {
+		return errors.New("Spender already has grants")
+	}
+
+	chain, err := ancestors(storage, parent)
+	if err != nil {
+		return err
+	}
+	if slices.Contains(chain, child) {
+		return errors.New("Cannot grant to a grantor")
+	}
+
+	childGrant.Parent = parent
+	err = SaveGrant(storage, child, childGrant)
+	if err != nil {
+		return err
+	}
+
+	parentGrant, err := LoadGrant(storage, parent)
+	if err != nil {
+		return err
+	}
+	parentGrant.Children = append(parentGrant.Children, child)
+
+	return SaveGrant(storage, parent, parentGrant)
+}
+
+// unlinkChild removes child from its grantor's children
+func unlinkChild(storage std.Storage, child string) error {
+	childGrant, err := LoadGrant(storage, child)
+	if err != nil {
+		return err
+	}
+
+	if childGrant.Parent == "" {
+		return nil
+	}
+
+	parentGrant, err := LoadGrant(storage, childGrant.Parent)
+	if err != nil {
+		return err
+	}
+
+	idx := slices.Index(parentGrant.Children, child)
+	if idx != -1 {
+		parentGrant.Children = slices.Delete(parentGrant.Children, idx, idx+1)
+	}
+
+	return SaveGrant(storage, childGrant.Parent, parentGrant)
+}
+
+func executeGrantAllowance(deps *std.Deps, env *types.Env, info *types.MessageInfo, msg *contractTypes.GrantAllowance) (*types.Response, error) {
+	sender := info.Sender
+
+	err := checkGrantor(deps, sender, msg.Spender)
+	if err != nil {
+		return nil, err
+	}
+
+	parentAllow, err := LoadAllowances(deps.Storage, sender)
+	if err != nil {
+		return nil, errors.New("can't find allowance")
+	}
+
+	if parentAllow.Expires.IsExpired(env.Block) {
+		return nil, errors.New("Contract Error No Allowance")
+	}
+
+	var emptyExpiration contractTypes.Expiration
+
+	expires := msg.Expires
+	if expires == emptyExpiration {
+		expires = parentAllow.Expires
+	}
+	if expires.IsExpired(env.Block) {
+		return nil, errors.New("setting expired allowance")
+	}
+	if !expires.Within(parentAllow.Expires) {
+		return nil, errors.New("Cannot grant past own expiration")
+	}
+
+	// the granted amount leaves the grantor's allowance, so the tree can never spend more than it was given
+	parentAllow.Balance, err = parentAllow.Balance.Sub(msg.Amount)
+	if err != nil {
+		return nil, errors.New("unable to decrease allowance")
+	}
+
+	err = linkChild(deps.Storage, sender, msg.Spender)
+	if err != nil {
+		return nil, err
+	}
+
+	var childAllow contractTypes.Allowances
+	prev, err := LoadAllowances(deps.Storage, msg.Spender)
+	if err == nil {
+		childAllow = *prev
+	}
+	childAllow.Expires = expires
+	childAllow.Balance, err = childAllow.Balance.AddBalance(contractTypes.NativeBalance{Coins: []types.Coin{msg.Amount}})
+	if err != nil {
+		return nil, err
+	}
+
+	if len(parentAllow.Balance.Coins) == 0 {
+		err = RemoveAllowances(deps.Storage, sender, env.Block.Height)
+	} else {
+		err = SaveAllowances(deps.Storage, sender, parentAllow, env.Block.Height)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	err = SaveAllowances(deps.Storage, msg.Spender, &childAllow, env.Block.Height)
+	if err != nil {
+		return nil, err
+	}
+
+	parentHooks, err := hookMessages(deps.Storage, contractTypes.HookMsg{
+		AllowanceChanged: &contractTypes.AllowanceChangedHook{
+			Spender:   sender,
+			Increase:  false,
+			Amount:    msg.Amount,
+			Allowance: *parentAllow,
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	childHooks, err := hookMessages(deps.Storage, contractTypes.HookMsg{
+		AllowanceChanged: &contractTypes.AllowanceChangedHook{
+			Spender:   msg.Spender,
+			Increase:  true,
+			Amount:    msg.Amount,
+			Allowance: childAllow,
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	res := &types.Response{
+		Attributes: []types.EventAttribute{
+			{Key: "action", Value: "grant_allowance"},
+			{Key: "owner", Value: sender},
+			{Key: "spender", Value: msg.Spender},
+			{Key: "denom", Value: msg.Amount.Denom},
+			{Key: "amount", Value: msg.Amount.Amount.String()},
+		},
+		Messages: append(parentHooks, childHooks...),
+	}
+	return res, nil
+}
+
+func executeGrantPermissions(deps *std.Deps, env *types.Env, info *types.MessageInfo, msg *contractTypes.GrantPermissions) (*types.Response, error) {
+	sender := info.Sender
+
+	err := checkGrantor(deps, sender, msg.Spender)
+	if err != nil {
+		return nil, err
+	}
+
+	own, err := effectivePermissions(deps.Storage, env.Block, sender)
+	if err != nil {
+		return nil, errors.New("can't find perm")
+	}
+
+	if !msg.Permissions.IsActive(env.Block) {
+		return nil, errors.New("setting expired permissions")
+	}
+
+	// own holds the fewest uses and earliest expiration up the chain, so the sub-grant can't outlast any of them
+	if !own.Covers(msg.Permissions) {
+		return nil, errors.New("Cannot grant more than own permissions")
+	}
+
+	err = linkChild(deps.Storage, sender, msg.Spender)
+	if err != nil {
+		return nil, err
+	}
+
+	err = SavePermissions(deps.Storage, msg.Spender, &msg.Permissions)
+	if err != nil {
+		return nil, err
+	}
+
+	hooks, err := hookMessages(deps.Storage, contractTypes.HookMsg{
+		PermissionsChanged: &contractTypes.PermissionsChangedHook{
+			Spender:     msg.Spender,
+			Permissions: msg.Permissions,
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	res := &types.Response{
+		Attributes: []types.EventAttribute{
+			{Key: "action", Value: "grant_permissions"},
+			{Key: "owner", Value: sender},
+			{Key: "spender", Value: msg.Spender},
+		},
+		Messages: hooks,
+	}
+	return res, nil
+}
+
+// grantNode builds the grant tree below the spender
+func grantNode(storage std.Storage, spender string) (*contractTypes.GrantNode, error) {
+	node := contractTypes.GrantNode{
+		Spender:  spender,
+		Children: []contractTypes.GrantNode{},
+	}
+
+	node.Allowance, _ = LoadAllowances(storage, spender)
+	node.Permissions, _ = LoadPermissions(storage, spender)
+
+	grant, err := LoadGrant(storage, spender)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, child := range grant.Children {
+		childNode, err := grantNode(storage, child)
+		if err != nil {
+			return nil, err
+		}
+		node.Children = append(node.Children, *childNode)
+	}
+
+	return &node, nil
+}
+
+func queryGrants(deps *std.Deps, env *types.Env, msg *contractTypes.QueryGrants) (*contractTypes.GrantsResponse, error) {
+	grant, err := LoadGrant(deps.Storage, msg.Spender)
+	if err != nil {
+		return nil, err
+	}
+
+	tree, err := grantNode(deps.Storage, msg.Spender)
+	if err != nil {
+		return nil, err
+	}
+
+	return &contractTypes.GrantsResponse{
+		Parent: grant.Parent,
+		Tree:   *tree,
+	}, nil
+}
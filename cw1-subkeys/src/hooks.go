@@ -0,0 +1,133 @@
+package src
+
+import (
+	"errors"
+	"slices"
+
+	contractTypes "github.com/JackalLabs/burrow-contracts/cw1-subkeys/src/types"
+
+	"github.com/CosmWasm/cosmwasm-go/std"
+	"github.com/CosmWasm/cosmwasm-go/std/types"
+	cw1WhiteList "github.com/JackalLabs/burrow-contracts/cw1-whitelist/src"
+)
+
+// hookMessages builds one execute message per registered hook carrying the given payload
+func hookMessages(storage std.Storage, hook contractTypes.HookMsg) ([]types.SubMsg, error) {
+	hooks, err := LoadHooks(storage)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(hooks.Hooks) == 0 {
+		return nil, nil
+	}
+
+	bz, err := hook.MarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+
+	var messages []types.SubMsg
+	for _, addr := range hooks.Hooks {
+		execute := types.ExecuteMsg{
+			ContractAddr: addr,
+			Msg:          bz,
+		}
+		messages = append(messages, types.NewSubMsg(execute))
+	}
+
+	return messages, nil
+}
+
+func executeAddHook(deps *std.Deps, env *types.Env, info *types.MessageInfo, msg *contractTypes.AddHook) (*types.Response, error) {
+	sender := info.Sender
+	state, err := cw1WhiteList.LoadState(deps.Storage)
+	if err != nil {
+		return nil, err
+	}
+
+	// check if sender is admin
+	if !state.IsAdmin(sender) {
+		return nil, errors.New("Unauthorized")
+	}
+
+	err = deps.Api.ValidateAddress(msg.Addr)
+	if err != nil {
+		return nil, err
+	}
+
+	hooks, err := LoadHooks(deps.Storage)
+	if err != nil {
+		return nil, err
+	}
+
+	if slices.Contains(hooks.Hooks, msg.Addr) {
+		return nil, errors.New("Hook already registered")
+	}
+
+	hooks.Hooks = append(hooks.Hooks, msg.Addr)
+
+	err = SaveHooks(deps.Storage, hooks)
+	if err != nil {
+		return nil, err
+	}
+
+	res := &types.Response{
+		Attributes: []types.EventAttribute{
+			{Key: "action", Value: "add_hook"},
+			{Key: "owner", Value: sender},
+			{Key: "hook", Value: msg.Addr},
+		},
+	}
+	return res, nil
+}
+
+func executeRemoveHook(deps *std.Deps, env *types.Env, info *types.MessageInfo, msg *contractTypes.RemoveHook) (*types.Response, error) {
+	sender := info.Sender
+	state, err := cw1WhiteList.LoadState(deps.Storage)
+	if err != nil {
+		return nil, err
+	}
+
+	// check if sender is admin
+	if !state.IsAdmin(sender) {
+		return nil, errors.New("Unauthorized")
+	}
+
+	hooks, err := LoadHooks(deps.Storage)
+	if err != nil {
+		return nil, err
+	}
+
+	idx := slices.Index(hooks.Hooks, msg.Addr)
+	if idx == -1 {
+		return nil, errors.New("Hook not registered")
+	}
+
+	hooks.Hooks = slices.Delete(hooks.Hooks, idx, idx+1)
+
+	err = SaveHooks(deps.Storage, hooks)
+	if err != nil {
+		return nil, err
+	}
+
+	res := &types.Response{
+		Attributes: []types.EventAttribute{
+			{Key: "action", Value: "remove_hook"},
+			{Key: "owner", Value: sender},
+			{Key: "hook", Value: msg.Addr},
+		},
+	}
+	return res, nil
+}
+
+func queryHooks(deps *std.Deps, env *types.Env, msg *contractTypes.QueryHooks) (*contractTypes.HooksResponse, error) {
+	hooks, err := LoadHooks(deps.Storage)
+	if err != nil {
+		return nil, err
+	}
+
+	return &contractTypes.HooksResponse{
+		Hooks: hooks.Hooks,
+	}, nil
+}
@@ -0,0 +1,118 @@
+package src
+
+import (
+	"errors"
+
+	contractTypes "github.com/JackalLabs/burrow-contracts/cw1-subkeys/src/types"
+
+	"github.com/CosmWasm/cosmwasm-go/std"
+	"github.com/CosmWasm/cosmwasm-go/std/types"
+	cw1WhiteList "github.com/JackalLabs/burrow-contracts/cw1-whitelist/src"
+)
+
+func executeSetOracle(deps *std.Deps, env *types.Env, info *types.MessageInfo, msg *contractTypes.SetOracle) (*types.Response, error) {
+	sender := info.Sender
+	state, err := cw1WhiteList.LoadState(deps.Storage)
+	if err != nil {
+		return nil, err
+	}
+
+	// check if sender is admin
+	if !state.IsAdmin(sender) {
+		return nil, errors.New("Unauthorized")
+	}
+
+	oracle := ""
+	if msg.Config == nil {
+		RemoveOracle(deps.Storage)
+	} else {
+		err = msg.Config.Validate()
+		if err != nil {
+			return nil, err
+		}
+
+		err = deps.Api.ValidateAddress(msg.Config.Oracle)
+		if err != nil {
+			return nil, err
+		}
+
+		oracle = msg.Config.Oracle
+		err = SaveOracle(deps.Storage, msg.Config)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	res := &types.Response{
+		Attributes: []types.EventAttribute{
+			{Key: "action", Value: "set_oracle"},
+			{Key: "owner", Value: sender},
+			{Key: "oracle", Value: oracle},
+		},
+	}
+	return res, nil
+}
+
+func executeSetQuoteAllowance(deps *std.Deps, env *types.Env, info *types.MessageInfo, msg *contractTypes.SetQuoteAllowance) (*types.Response, error) {
+	sender := info.Sender
+	state, err := cw1WhiteList.LoadState(deps.Storage)
+	if err != nil {
+		return nil, err
+	}
+
+	// check if sender is admin
+	if !state.IsAdmin(sender) {
+		return nil, errors.New("Unauthorized")
+	}
+
+	err = deps.Api.ValidateAddress(msg.Spender)
+	if err != nil {
+		return nil, err
+	}
+
+	// sender can't be spender
+	if msg.Spender == sender {
+		return nil, errors.New("Cannot Set Your own Account")
+	}
+
+	if msg.Amount.IsZero() {
+		err = RemoveQuoteAllowance(deps.Storage, msg.Spender)
+	} else {
+		if msg.Expires.IsExpired(env.Block) {
+			return nil, errors.New("setting expired allowance")
+		}
+
+		err = SaveQuoteAllowance(deps.Storage, msg.Spender, &contractTypes.QuoteAllowance{
+			Remaining: msg.Amount,
+			Expires:   msg.Expires,
+		})
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	res := &types.Response{
+		Attributes: []types.EventAttribute{
+			{Key: "action", Value: "set_quote_allowance"},
+			{Key: "owner", Value: sender},
+			{Key: "spender", Value: msg.Spender},
+			{Key: "amount", Value: msg.Amount.String()},
+		},
+	}
+	return res, nil
+}
+
+func queryOracle(deps *std.Deps, env *types.Env, msg *contractTypes.QueryOracle) (*contractTypes.OracleResponse, error) {
+	config, err := LoadOracle(deps.Storage)
+	if err != nil {
+		return &contractTypes.OracleResponse{}, nil
+	}
+
+	return &contractTypes.OracleResponse{
+		Config: config,
+	}, nil
+}
+
+func queryQuoteAllowance(deps *std.Deps, env *types.Env, msg *contractTypes.QueryQuoteAllowance) (*contractTypes.QuoteAllowance, error) {
+	return LoadQuoteAllowance(deps.Storage, msg.Spender)
+}
@@ -0,0 +1,227 @@
+package src
+
+import (
+	"errors"
+	"strconv"
+
+	contractTypes "github.com/JackalLabs/burrow-contracts/cw1-subkeys/src/types"
+
+	"github.com/CosmWasm/cosmwasm-go/std"
+	"github.com/CosmWasm/cosmwasm-go/std/types"
+	cw1WhiteList "github.com/JackalLabs/burrow-contracts/cw1-whitelist/src"
+)
+
+// DEFAULT_SPEND_REQUEST_EXPIRY applies to spend requests filed without an expiration
+var DEFAULT_SPEND_REQUEST_EXPIRY = contractTypes.Duration{Time: 7 * 24 * 60 * 60}
+
+// MAX_PENDING_SPEND_REQUESTS is how many unexpired requests a spender can have waiting for the admins
+var MAX_PENDING_SPEND_REQUESTS = 10
+
+func executeRequestSpend(deps *std.Deps, env *types.Env, info *types.MessageInfo, msg *contractTypes.RequestSpend) (*types.Response, error) {
+	sender := info.Sender
+	state, err := cw1WhiteList.LoadState(deps.Storage)
+	if err != nil {
+		return nil, err
+	}
+
+	// admins can execute directly
+	if state.IsAdmin(sender) {
+		return nil, errors.New("Admins cannot request spends")
+	}
+
+	// only subkeys can file requests
+	check := loadSpendCheck(deps, env.Block, sender)
+	if check.allowance == nil && check.quote == nil && check.cw20 == nil && check.permissions == nil && check.policy == nil {
+		return nil, errors.New("Unauthorized")
+	}
+
+	if len(msg.Msgs) == 0 {
+		return nil, errors.New("No messages to request")
+	}
+
+	var emptyExpiration contractTypes.Expiration
+
+	expires := msg.Expires
+	if expires == emptyExpiration {
+		var err error
+		expires, err = DEFAULT_SPEND_REQUEST_EXPIRY.After(env.Block)
+		if err != nil {
+			return nil, err
+		}
+	}
+	if expires.IsExpired(env.Block) {
+		return nil, errors.New("setting expired spend request")
+	}
+
+	// expired requests are dropped here, so they don't count against the spender
+	pending, err := PruneSpendRequests(deps.Storage, env.Block, sender)
+	if err != nil {
+		return nil, err
+	}
+	if pending >= MAX_PENDING_SPEND_REQUESTS {
+		return nil, errors.New("Too many pending spend requests")
+	}
+
+	id, err := NextSpendRequestID(deps.Storage)
+	if err != nil {
+		return nil, err
+	}
+
+	request := contractTypes.SpendRequest{
+		ID:      id,
+		Spender: sender,
+		Msgs:    msg.Msgs,
+		Expires: expires,
+	}
+
+	err = SaveSpendRequest(deps.Storage, &request)
+	if err != nil {
+		return nil, err
+	}
+
+	res := &types.Response{
+		Attributes: []types.EventAttribute{
+			{Key: "action", Value: "request_spend"},
+			{Key: "spender", Value: sender},
+			{Key: "request_id", Value: strconv.FormatUint(request.ID, 10)},
+		},
+	}
+	return res, nil
+}
+
+func executeApproveSpend(deps *std.Deps, env *types.Env, info *types.MessageInfo, msg *contractTypes.ApproveSpend) (*types.Response, error) {
+	sender := info.Sender
+	state, err := cw1WhiteList.LoadState(deps.Storage)
+	if err != nil {
+		return nil, err
+	}
+
+	// check if sender is admin
+	if !state.IsAdmin(sender) {
+		return nil, errors.New("Unauthorized")
+	}
+
+	request, err := LoadSpendRequest(deps.Storage, msg.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	if request.Expires.IsExpired(env.Block) {
+		return nil, errors.New("Spend request expired")
+	}
+
+	err = RemoveSpendRequest(deps.Storage, msg.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	var messages []types.SubMsg
+	for _, m := range request.Msgs {
+		messages = append(messages, types.NewSubMsg(m))
+	}
+
+	if len(msg.TopUp) > 0 {
+		var allow contractTypes.Allowances
+		prev, err := LoadAllowances(deps.Storage, request.Spender)
+		if err == nil {
+			allow = *prev
+		}
+
+		if allow.Expires.IsExpired(env.Block) {
+			return nil, errors.New("setting expired allowance")
+		}
+
+		allow.Balance, err = allow.Balance.AddBalance(contractTypes.NativeBalance{Coins: msg.TopUp})
+		if err != nil {
+			return nil, err
+		}
+
+		err = SaveAllowances(deps.Storage, request.Spender, &allow, env.Block.Height)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, coin := range msg.TopUp {
+			hooks, err := hookMessages(deps.Storage, contractTypes.HookMsg{
+				AllowanceChanged: &contractTypes.AllowanceChangedHook{
+					Spender:   request.Spender,
+					Increase:  true,
+					Amount:    coin,
+					Allowance: allow,
+				},
+			})
+			if err != nil {
+				return nil, err
+			}
+			messages = append(messages, hooks...)
+		}
+	}
+
+	hooks, err := hookMessages(deps.Storage, contractTypes.HookMsg{
+		SubkeySpent: &contractTypes.SubkeySpentHook{
+			Spender: request.Spender,
+			Msgs:    request.Msgs,
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+	messages = append(messages, hooks...)
+
+	res := &types.Response{
+		Attributes: []types.EventAttribute{
+			{Key: "action", Value: "approve_spend"},
+			{Key: "owner", Value: sender},
+			{Key: "spender", Value: request.Spender},
+			{Key: "request_id", Value: strconv.FormatUint(request.ID, 10)},
+		},
+		Messages: messages,
+	}
+	return res, nil
+}
+
+func executeRejectSpend(deps *std.Deps, env *types.Env, info *types.MessageInfo, msg *contractTypes.RejectSpend) (*types.Response, error) {
+	sender := info.Sender
+	state, err := cw1WhiteList.LoadState(deps.Storage)
+	if err != nil {
+		return nil, err
+	}
+
+	request, err := LoadSpendRequest(deps.Storage, msg.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	// the subkey can withdraw its own request
+	if !state.IsAdmin(sender) && sender != request.Spender {
+		return nil, errors.New("Unauthorized")
+	}
+
+	err = RemoveSpendRequest(deps.Storage, msg.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	res := &types.Response{
+		Attributes: []types.EventAttribute{
+			{Key: "action", Value: "reject_spend"},
+			{Key: "owner", Value: sender},
+			{Key: "spender", Value: request.Spender},
+			{Key: "request_id", Value: strconv.FormatUint(request.ID, 10)},
+		},
+	}
+	return res, nil
+}
+
+func querySpendRequests(deps *std.Deps, env *types.Env, msg *contractTypes.QuerySpendRequests) (*contractTypes.SpendRequestsResponse, error) {
+	limitValue := calcLimit(&msg.Limit)
+
+	requests, err := LoadSpendRequests(deps.Storage, env.Block, msg.StartAfter, limitValue)
+	if err != nil {
+		return nil, err
+	}
+
+	return &contractTypes.SpendRequestsResponse{
+		Requests: requests,
+	}, nil
+}
@@ -0,0 +1,343 @@
+package src
+
+import (
+	"errors"
+
+	contractTypes "github.com/JackalLabs/burrow-contracts/cw1-subkeys/src/types"
+
+	"github.com/CosmWasm/cosmwasm-go/std"
+	"github.com/CosmWasm/cosmwasm-go/std/math"
+	"github.com/CosmWasm/cosmwasm-go/std/types"
+)
+
+// spendCheck holds the grants of a non-admin sender while the messages of a single execute are checked.
+// Allowance debits build up here and are only stored once every message passed.
+type spendCheck struct {
+	spender     string
+	querier     std.QuerierWrapper
+	denyList    *contractTypes.DenyList
+	policy      *contractTypes.Policy
+	permissions *contractTypes.Permissions
+	holders     []heldPermissions
+	ibc         *contractTypes.IbcPermission
+	stargate    *contractTypes.StargateAllowlist
+	allowance   *contractTypes.Allowances
+	cw20        *contractTypes.Cw20Allowances
+	quote       *contractTypes.QuoteAllowance
+	oracle      *contractTypes.OracleConfig
+	prices      map[string]math.Uint128
+	spent       bool
+	quoteSpent  bool
+	cw20Spent   bool
+	used        bool
+}
+
+// loadSpendCheck loads the spender's grants, missing grants are left nil.
+// Expired or used up permissions count as missing
+func loadSpendCheck(deps *std.Deps, block types.BlockInfo, spender string) *spendCheck {
+	storage := deps.Storage
+	check := spendCheck{
+		spender: spender,
+		querier: std.QuerierWrapper{Querier: deps.Querier},
+		prices:  make(map[string]math.Uint128),
+	}
+
+	check.denyList, _ = LoadDenyList(storage)
+	check.policy, _ = LoadPolicy(storage, spender)
+	check.permissions, _ = effectivePermissions(storage, block, spender)
+	if check.permissions != nil {
+		check.holders, _ = permissionHolders(storage, spender)
+	}
+	check.ibc, _ = LoadIbcPermission(storage, spender)
+	check.stargate, _ = LoadStargateAllowlist(storage, spender)
+	check.allowance, _ = LoadAllowances(storage, spender)
+	check.cw20, _ = LoadCw20Allowances(storage, spender)
+	check.quote, _ = LoadQuoteAllowance(storage, spender)
+	if check.quote != nil {
+		check.oracle, _ = LoadOracle(storage)
+	}
+
+	return &check
+}
+
+// check returns an error if the spender can't execute the message,
+// and the policy rule that decided if the spender has a policy
+func (c *spendCheck) check(env *types.Env, msg types.CosmosMsg) (string, error) {
+	// the deny list overrides any grant or policy
+	if c.denyList != nil {
+		err := c.denyList.Check(msg)
+		if err != nil {
+			return "", err
+		}
+	}
+
+	// an allow rule is authority of its own: grants the spender holds for the message are still
+	// checked and debited, but a missing grant doesn't reject what the policy allowed
+	var rule string
+	if c.policy != nil {
+		decision := c.policy.Evaluate(msg, env.Block)
+		switch {
+		case decision.Allowed:
+			rule = decision.Rule
+		case decision.Rule != "":
+			return decision.Rule, errors.New("Contract Error: Denied by policy rule " + decision.Rule)
+		default:
+			return "", errors.New("Contract Error: No policy rule matched")
+		}
+	}
+
+	return rule, c.checkGrants(env, msg, rule != "")
+}
+
+// missingGrant returns err for a grant the spender doesn't hold, unless a policy rule allowed the message
+func missingGrant(allowed bool, err error) error {
+	if allowed {
+		return nil
+	}
+	return err
+}
+
+// checkGrants returns an error if the spender's grants don't cover the message, debiting them if they do.
+// Grants the spender doesn't hold are only an error if no policy rule allowed the message
+func (c *spendCheck) checkGrants(env *types.Env, msg types.CosmosMsg, allowed bool) error {
+	switch {
+	case msg.Staking != nil:
+		if c.permissions == nil {
+			return missingGrant(allowed, errors.New("can't find perm"))
+		}
+		err := CheckStakingPermissions(msg.Staking, *c.permissions)
+		if err != nil {
+			return err
+		}
+		return c.use()
+
+	case msg.Distribution != nil:
+		if c.permissions == nil {
+			return missingGrant(allowed, errors.New("can't find perm"))
+		}
+		err := CheckDistributionPermissions(msg.Distribution, *c.permissions)
+		if err != nil {
+			return err
+		}
+		return c.use()
+
+	case msg.Gov != nil:
+		if c.permissions == nil {
+			return missingGrant(allowed, errors.New("can't find perm"))
+		}
+		err := CheckGovPermissions(msg.Gov, *c.permissions)
+		if err != nil {
+			return err
+		}
+		return c.use()
+
+	case msg.Bank != nil && msg.Bank.Send != nil:
+		return c.spend(env, msg.Bank.Send.Amount, allowed)
+
+	case msg.IBC != nil && msg.IBC.Transfer != nil:
+		if c.ibc == nil {
+			err := missingGrant(allowed, errors.New("can't find ibc permission"))
+			if err != nil {
+				return err
+			}
+		} else {
+			err := c.ibc.Check(msg.IBC.Transfer)
+			if err != nil {
+				return err
+			}
+		}
+		return c.spend(env, []types.Coin{msg.IBC.Transfer.Amount}, allowed)
+
+	case msg.Wasm != nil && msg.Wasm.Execute != nil:
+		err := c.spendCw20(env, msg.Wasm.Execute, allowed)
+		if err != nil {
+			return err
+		}
+		if len(msg.Wasm.Execute.Funds) == 0 {
+			return nil
+		}
+		return c.spend(env, msg.Wasm.Execute.Funds, allowed)
+
+	case msg.Stargate != nil:
+		if c.stargate == nil {
+			return missingGrant(allowed, errors.New("can't find stargate allowlist"))
+		}
+		return c.stargate.Check(msg.Stargate)
+
+	default:
+		return missingGrant(allowed, errors.New("Contract Error: Type Rejected"))
+	}
+}
+
+// use counts one permissioned message against the uses of the spender and of every grantor above it
+func (c *spendCheck) use() error {
+	for _, holder := range c.holders {
+		if holder.permissions.Uses != nil && *holder.permissions.Uses == 0 {
+			return errors.New("Contract Error: Permissions Used Up")
+		}
+	}
+
+	for _, holder := range c.holders {
+		if holder.permissions.Uses != nil {
+			uses := *holder.permissions.Uses - 1
+			holder.permissions.Uses = &uses
+			c.used = true
+		}
+	}
+	return nil
+}
+
+// spend debits the coins from the quote allowance if the spender has one, or else from the allowance
+func (c *spendCheck) spend(env *types.Env, coins []types.Coin, allowed bool) error {
+	if c.quote != nil {
+		return c.spendQuote(env, coins)
+	}
+
+	if c.allowance == nil {
+		return missingGrant(allowed, errors.New("can't find allowance"))
+	}
+	if c.allowance.Expires.IsExpired(env.Block) {
+		return errors.New("Contract Error No Allowance")
+	}
+
+	// Decrease Allowance
+	balance, err := c.allowance.Balance.SubBalance(contractTypes.NativeBalance{Coins: coins})
+	if err != nil {
+		return errors.New("unable to decrease allowance")
+	}
+	c.allowance.Balance = balance
+	c.spent = true
+	return nil
+}
+
+// spendQuote values the coins in the quote denom and debits them from the quote allowance
+func (c *spendCheck) spendQuote(env *types.Env, coins []types.Coin) error {
+	if c.quote.Expires.IsExpired(env.Block) {
+		return errors.New("Contract Error No Allowance")
+	}
+	if c.oracle == nil {
+		return errors.New("Contract Error: No oracle configured")
+	}
+
+	for _, coin := range coins {
+		price, err := c.price(env, coin.Denom)
+		if err != nil {
+			return err
+		}
+
+		value, err := contractTypes.QuoteValue(coin.Amount, price)
+		if err != nil {
+			return err
+		}
+
+		remaining, err := c.quote.Remaining.SafeSub(value)
+		if err != nil {
+			return errors.New("unable to decrease quote allowance")
+		}
+		c.quote.Remaining = remaining
+	}
+	c.quoteSpent = true
+	return nil
+}
+
+// price asks the oracle for the denom's price, using the fallback price if the oracle fails or is stale
+func (c *spendCheck) price(env *types.Env, denom string) (math.Uint128, error) {
+	if denom == c.oracle.Quote {
+		return contractTypes.PRICE_PRECISION, nil
+	}
+
+	if price, ok := c.prices[denom]; ok {
+		return price, nil
+	}
+
+	query := contractTypes.OracleQueryMsg{
+		Price: &contractTypes.OraclePriceQuery{
+			Denom: denom,
+			Quote: c.oracle.Quote,
+		},
+	}
+
+	var res contractTypes.OraclePriceResponse
+	err := c.querier.QuerySmart(c.oracle.Oracle, &query, &res)
+
+	// a zero price would value every spend at nothing, so it counts as no price,
+	// and so does one timestamped after the block, which no oracle can have seen yet
+	now := env.Block.Time / 1_000_000_000
+	fresh := err == nil && !res.Price.IsZero() && res.UpdatedAt <= now &&
+		(c.oracle.MaxAge == 0 || now-res.UpdatedAt <= c.oracle.MaxAge)
+
+	price := res.Price
+	if !fresh {
+		var ok bool
+		price, ok = c.oracle.FallbackPrice(denom)
+		if !ok {
+			return math.ZeroUint128(), errors.New("Contract Error: No price for " + denom)
+		}
+	}
+
+	c.prices[denom] = price
+	return price, nil
+}
+
+// spendCw20 decodes a cw20 transfer or send and debits it from the token contract's allowance
+func (c *spendCheck) spendCw20(env *types.Env, execute *types.ExecuteMsg, allowed bool) error {
+	if c.cw20 == nil {
+		return missingGrant(allowed, errors.New("can't find cw20 allowance"))
+	}
+	if c.cw20.Expires.IsExpired(env.Block) {
+		return errors.New("Contract Error No Allowance")
+	}
+
+	var cw20Msg contractTypes.Cw20ExecuteMsg
+	err := cw20Msg.UnmarshalJSON(execute.Msg)
+	if err != nil {
+		return errors.New("Contract Error: Unsupported cw20 Message")
+	}
+
+	amount, err := cw20Msg.Amount()
+	if err != nil {
+		return err
+	}
+
+	err = c.cw20.Sub(execute.ContractAddr, amount)
+	if err != nil {
+		return errors.New("unable to decrease cw20 allowance")
+	}
+	c.cw20Spent = true
+	return nil
+}
+
+// save stores the allowances if any message spent from them, and the permissions if any message used them
+func (c *spendCheck) save(storage std.Storage, height uint64) error {
+	if c.quoteSpent {
+		err := SaveQuoteAllowance(storage, c.spender, c.quote)
+		if err != nil {
+			return err
+		}
+	}
+
+	if c.cw20Spent {
+		err := SaveCw20Allowances(storage, c.spender, c.cw20)
+		if err != nil {
+			return err
+		}
+	}
+
+	if c.used {
+		for _, holder := range c.holders {
+			if holder.permissions.Uses == nil {
+				continue
+			}
+			err := SavePermissions(storage, holder.spender, holder.permissions)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	if !c.spent {
+		return nil
+	}
+
+	return SaveAllowances(storage, c.spender, c.allowance, height)
+}
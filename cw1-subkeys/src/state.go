@@ -2,257 +2,480 @@ package src
 
 import (
 	"errors"
-	"strconv"
 
 	"github.com/CosmWasm/cosmwasm-go/std"
+	"github.com/CosmWasm/cosmwasm-go/std/types"
 	contractTypes "github.com/JackalLabs/burrow-contracts/cw1-subkeys/src/types"
+	"github.com/JackalLabs/burrow-contracts/storage"
 )
 
 var (
-	PERMISSIONS_MAP = []byte("permissions_map")
-	ALLOWANCES_MAP  = []byte("allowances_map")
-	CONTRACT_INFO   = []byte("contract_info")
+	CONTRACT_INFO = storage.NewItem[contractTypes.ContractInfo]("contract_info")
+	HOOKS         = storage.NewItem[contractTypes.Hooks]("hooks")
+	TOP_UP_CONFIG = storage.NewItem[contractTypes.TopUpConfig]("top_up_config")
+	ORACLE        = storage.NewItem[contractTypes.OracleConfig]("oracle")
+	DENY_LIST     = storage.NewItem[contractTypes.DenyList]("deny_list")
+
+	// secondary indexes over the spender entries, keyed by spender
+	ALLOWANCES_BY_EXPIRY = storage.NewMultiIndex[uint64, string]("allowances__expiry", func(allow *contractTypes.Allowances) []uint64 {
+		if allow.Expires.AtHeight == nil {
+			return nil
+		}
+		return []uint64{*allow.Expires.AtHeight}
+	})
+	ALLOWANCES_BY_DENOM = storage.NewMultiIndex[string, string]("allowances__denom", func(allow *contractTypes.Allowances) []string {
+		denoms := make([]string, len(allow.Balance.Coins))
+		for i, coin := range allow.Balance.Coins {
+			denoms[i] = coin.Denom
+		}
+		return denoms
+	})
+	PERMISSIONS_BY_FLAG = storage.NewMultiIndex[string, string]("permissions__flag", func(perm *contractTypes.Permissions) []string {
+		return perm.Flags()
+	})
+	SPEND_REQUESTS_BY_SPENDER = storage.NewMultiIndex[string, uint64]("spend_requests__spender", func(request *contractTypes.SpendRequest) []string {
+		return []string{request.Spender}
+	})
+
+	// spender entries, keyed by spender. Allowances and permissions are written on most executes,
+	// so they're kept in the compact binary format
+	ALLOWANCES  = storage.NewIndexedSnapshotMap[string, contractTypes.Allowances]("allowances", "allowances__changelog", ALLOWANCES_BY_EXPIRY, ALLOWANCES_BY_DENOM).WithFormat(storage.FormatBinary)
+	PERMISSIONS = storage.NewIndexedMap[string, contractTypes.Permissions]("permissions", PERMISSIONS_BY_FLAG).WithFormat(storage.FormatBinary)
+	CW20        = storage.NewMap[string, contractTypes.Cw20Allowances]("cw20")
+	QUOTES      = storage.NewMap[string, contractTypes.QuoteAllowance]("quotes")
+	POLICIES    = storage.NewMap[string, contractTypes.Policy]("policies")
+	GRANTS      = storage.NewMap[string, contractTypes.Grant]("grants")
+	IBC         = storage.NewMap[string, contractTypes.IbcPermission]("ibc")
+	STARGATE    = storage.NewMap[string, contractTypes.StargateAllowlist]("stargate")
+
+	SPEND_REQUESTS      = storage.NewIndexedMap[uint64, contractTypes.SpendRequest]("spend_requests", SPEND_REQUESTS_BY_SPENDER)
+	SPEND_REQUEST_COUNT = storage.NewItem[contractTypes.SpendRequestCount]("spend_request_count")
 )
 
-func LoadPermissions(storage std.Storage, key string) (*contractTypes.Permissions, error) {
-	// Load storage for map
-	// Map should return a key
-	// Load storage for the value of that key
-	data := storage.Get(PERMISSIONS_MAP)
-	if data == nil {
-		return nil, errors.New("PERMISSIONS_MAP not found")
-	}
+func LoadPermissions(store std.Storage, key string) (*contractTypes.Permissions, error) {
+	return PERMISSIONS.Load(store, key)
+}
 
-	var bigMap contractTypes.BigMap
-	err := bigMap.UnmarshalJSON(data)
-	if err != nil {
-		return nil, errors.New("bigMap not found")
-	}
+func SavePermissions(store std.Storage, spender string, permissions *contractTypes.Permissions) error {
+	return PERMISSIONS.Save(store, spender, permissions)
+}
+
+func RemovePermissions(store std.Storage, spender string) error {
+	return PERMISSIONS.Remove(store, spender)
+}
 
-	byteKey := bigMap.Keys[key]
-	if byteKey == nil {
-		return nil, errors.New("byteKey doesn't exist")
+func LoadAllowances(store std.Storage, key string) (*contractTypes.Allowances, error) {
+	return ALLOWANCES.Load(store, key)
+}
+
+// LoadAllowancesAt returns the spender's allowance as it was at the start of the block height
+func LoadAllowancesAt(store std.Storage, key string, height uint64) (*contractTypes.Allowances, error) {
+	allow, err := ALLOWANCES.MayLoadAt(store, key, height)
+	if err == nil && allow == nil {
+		return nil, errors.New("No allowance at height")
 	}
+	return allow, err
+}
+
+// SaveAllowances stores the allowance, keeping the previous one for queries at past heights
+func SaveAllowances(store std.Storage, spender string, allowances *contractTypes.Allowances, height uint64) error {
+	return ALLOWANCES.Save(store, spender, allowances, height)
+}
+
+func RemoveAllowances(store std.Storage, spender string, height uint64) error {
+	return ALLOWANCES.Remove(store, spender, height)
+}
+
+func LoadCw20Allowances(store std.Storage, key string) (*contractTypes.Cw20Allowances, error) {
+	return CW20.Load(store, key)
+}
+
+func SaveCw20Allowances(store std.Storage, spender string, allow *contractTypes.Cw20Allowances) error {
+	return CW20.Save(store, spender, allow)
+}
+
+func RemoveCw20Allowances(store std.Storage, spender string) error {
+	CW20.Remove(store, spender)
+	return nil
+}
+
+func LoadQuoteAllowance(store std.Storage, key string) (*contractTypes.QuoteAllowance, error) {
+	return QUOTES.Load(store, key)
+}
+
+func SaveQuoteAllowance(store std.Storage, spender string, allow *contractTypes.QuoteAllowance) error {
+	return QUOTES.Save(store, spender, allow)
+}
+
+func RemoveQuoteAllowance(store std.Storage, spender string) error {
+	QUOTES.Remove(store, spender)
+	return nil
+}
+
+func LoadPolicy(store std.Storage, key string) (*contractTypes.Policy, error) {
+	return POLICIES.Load(store, key)
+}
+
+func SavePolicy(store std.Storage, spender string, policy *contractTypes.Policy) error {
+	return POLICIES.Save(store, spender, policy)
+}
+
+func RemovePolicy(store std.Storage, spender string) error {
+	POLICIES.Remove(store, spender)
+	return nil
+}
 
-	data = storage.Get(byteKey)
-	if data == nil {
-		return nil, errors.New("byteKey doesn't have any value")
+// LoadAllAllowances returns up to limit allowances after startAfter, ordered by spender and skipping expired ones
+func LoadAllAllowances(store std.Storage, block types.BlockInfo, startAfter string, limit int) (*contractTypes.AllAllowancesResponse, error) {
+	allAllow := contractTypes.AllAllowancesResponse{
+		Allowances: []contractTypes.AllowanceInfo{},
+	}
+	if limit <= 0 {
+		return &allAllow, nil
 	}
 
-	var permissions contractTypes.Permissions
-	err = permissions.UnmarshalJSON(data)
+	err := ALLOWANCES.Walk(store, storage.Exclusive(startAfter), nil, std.Ascending, func(record storage.Record[string, contractTypes.Allowances]) (bool, error) {
+		if !record.Value.Expires.IsExpired(block) {
+			allAllow.Allowances = append(allAllow.Allowances, contractTypes.AllowanceInfo{
+				Spender: record.Key,
+				Balance: record.Value.Balance,
+				Expires: record.Value.Expires,
+			})
+		}
+		return len(allAllow.Allowances) < limit, nil
+	})
 	if err != nil {
 		return nil, err
 	}
-	return &permissions, nil
+
+	return &allAllow, nil
 }
 
-func SavePermissions(storage std.Storage, spender string, permissions *contractTypes.Permissions) error {
-	bz, err := permissions.MarshalJSON()
+// LoadAllPermissions returns up to limit permissions after startAfter, ordered by spender and skipping inactive ones
+func LoadAllPermissions(store std.Storage, block types.BlockInfo, startAfter string, limit int) (*contractTypes.AllPermissionsResponse, error) {
+	allPerm := contractTypes.AllPermissionsResponse{
+		Permissions: []contractTypes.PermissionInfo{},
+	}
+	if limit <= 0 {
+		return &allPerm, nil
+	}
+
+	err := PERMISSIONS.Walk(store, storage.Exclusive(startAfter), nil, std.Ascending, func(record storage.Record[string, contractTypes.Permissions]) (bool, error) {
+		if record.Value.IsActive(block) {
+			allPerm.Permissions = append(allPerm.Permissions, contractTypes.PermissionInfo{
+				Spender:     record.Key,
+				Permissions: *record.Value,
+			})
+		}
+		return len(allPerm.Permissions) < limit, nil
+	})
 	if err != nil {
-		return err
+		return nil, err
 	}
 
-	// save permission with spender as byteKey
-	byteKey := []byte("permissions_" + spender)
-	storage.Set(byteKey, bz)
+	return &allPerm, nil
+}
 
-	// save this byteKey to bigMap - update PERMISSIONS_MAP
-	data := storage.Get(PERMISSIONS_MAP)
-	if data == nil {
-		return errors.New("PERMISSIONS_MAP not found")
+// LoadAllowancesExpiring returns up to limit allowances expiring below height before, soonest first.
+// Allowances that already expired are included
+func LoadAllowancesExpiring(store std.Storage, before uint64, startAfter string, limit int) (*contractTypes.AllAllowancesResponse, error) {
+	var after *storage.IndexEntry[uint64, string]
+	if startAfter != "" {
+		allow, err := LoadAllowances(store, startAfter)
+		if err != nil {
+			return nil, err
+		}
+		after = &storage.IndexEntry[uint64, string]{Key: startAfter}
+		if allow.Expires.AtHeight != nil {
+			after.Index = *allow.Expires.AtHeight
+		}
 	}
 
-	var bigMap contractTypes.BigMap
-	err = bigMap.UnmarshalJSON(data)
+	entries, err := ALLOWANCES_BY_EXPIRY.Range(store, nil, storage.Exclusive(before), after, std.Ascending, limit)
 	if err != nil {
-		return errors.New("bigMap not found")
+		return nil, err
 	}
 
-	bigMap.Keys[spender] = byteKey
-	bz, err = bigMap.MarshalJSON()
-	if err != nil {
-		return err
+	allAllow := contractTypes.AllAllowancesResponse{
+		Allowances: []contractTypes.AllowanceInfo{},
 	}
-	storage.Set(PERMISSIONS_MAP, bz)
+	for _, entry := range entries {
+		allow, err := LoadAllowances(store, entry.Key)
+		if err != nil {
+			return nil, err
+		}
 
-	return nil
+		allAllow.Allowances = append(allAllow.Allowances, contractTypes.AllowanceInfo{
+			Spender: entry.Key,
+			Balance: allow.Balance,
+			Expires: allow.Expires,
+		})
+	}
+
+	return &allAllow, nil
 }
 
-func LoadAllowances(storage std.Storage, key string) (*contractTypes.Allowances, error) {
-	// Load storage for map
-	// Map should return a key
-	// Load storage for the value of that key
-	data := storage.Get(ALLOWANCES_MAP)
-	if data == nil {
-		return nil, errors.New("ALLOWANCES_MAP not found")
+// LoadAllowancesByDenom returns up to limit allowances holding the denom after startAfter, skipping expired ones
+func LoadAllowancesByDenom(store std.Storage, block types.BlockInfo, denom string, startAfter string, limit int) (*contractTypes.AllAllowancesResponse, error) {
+	var after *string
+	if startAfter != "" {
+		after = &startAfter
 	}
 
-	var bigMap contractTypes.BigMap
-	err := bigMap.UnmarshalJSON(data)
+	allAllow := contractTypes.AllAllowancesResponse{
+		Allowances: []contractTypes.AllowanceInfo{},
+	}
+	if limit <= 0 {
+		return &allAllow, nil
+	}
+
+	err := ALLOWANCES_BY_DENOM.WalkPrefix(store, denom, after, func(spender string) (bool, error) {
+		allow, err := LoadAllowances(store, spender)
+		if err != nil {
+			return false, err
+		}
+		if !allow.Expires.IsExpired(block) {
+			allAllow.Allowances = append(allAllow.Allowances, contractTypes.AllowanceInfo{
+				Spender: spender,
+				Balance: allow.Balance,
+				Expires: allow.Expires,
+			})
+		}
+		return len(allAllow.Allowances) < limit, nil
+	})
 	if err != nil {
-		return nil, errors.New("bigMap not found")
+		return nil, err
 	}
 
-	byteKey := bigMap.Keys[key]
-	if byteKey == nil {
-		return nil, errors.New("byteKey doesn't exist")
+	return &allAllow, nil
+}
+
+// LoadPermissionsWith returns up to limit permissions with the flag set after startAfter, skipping inactive ones
+func LoadPermissionsWith(store std.Storage, block types.BlockInfo, flag string, startAfter string, limit int) (*contractTypes.AllPermissionsResponse, error) {
+	var after *string
+	if startAfter != "" {
+		after = &startAfter
 	}
 
-	data = storage.Get(byteKey)
-	if data == nil {
-		return nil, errors.New("byteKey doesn't have any value")
+	allPerm := contractTypes.AllPermissionsResponse{
+		Permissions: []contractTypes.PermissionInfo{},
+	}
+	if limit <= 0 {
+		return &allPerm, nil
 	}
 
-	var allowances contractTypes.Allowances
-	err = allowances.UnmarshalJSON(data)
+	err := PERMISSIONS_BY_FLAG.WalkPrefix(store, flag, after, func(spender string) (bool, error) {
+		perm, err := LoadPermissions(store, spender)
+		if err != nil {
+			return false, err
+		}
+		if perm.IsActive(block) {
+			allPerm.Permissions = append(allPerm.Permissions, contractTypes.PermissionInfo{
+				Spender:     spender,
+				Permissions: *perm,
+			})
+		}
+		return len(allPerm.Permissions) < limit, nil
+	})
 	if err != nil {
 		return nil, err
 	}
-	return &allowances, nil
+
+	return &allPerm, nil
 }
 
-func SaveAllowances(storage std.Storage, spender string, allowances *contractTypes.Allowances) error {
-	bz, err := allowances.MarshalJSON()
-	if err != nil {
-		return err
+func LoadContractInfo(store std.Storage) (*contractTypes.ContractInfo, error) {
+	state, err := CONTRACT_INFO.MayLoad(store)
+	if err == nil && state == nil {
+		return nil, errors.New("state not found")
 	}
+	return state, err
+}
 
-	// save allowances with spender as byteKey
-	byteKey := []byte("allowances_" + spender)
-	storage.Set(byteKey, bz)
+func SaveContractInfo(store std.Storage, state *contractTypes.ContractInfo) error {
+	return CONTRACT_INFO.Save(store, state)
+}
 
-	// save this byteKey to bigMap - load then update ALLOWANCES_MAP
-	data := storage.Get(ALLOWANCES_MAP)
-	if data == nil {
-		return errors.New("ALLOWANCES_MAP not found")
-	}
+func LoadIbcPermission(store std.Storage, key string) (*contractTypes.IbcPermission, error) {
+	return IBC.Load(store, key)
+}
 
-	var bigMap contractTypes.BigMap
-	err = bigMap.UnmarshalJSON(data)
-	if err != nil {
-		return errors.New("bigMap not found")
-	}
+func SaveIbcPermission(store std.Storage, spender string, permission *contractTypes.IbcPermission) error {
+	return IBC.Save(store, spender, permission)
+}
 
-	bigMap.Keys[spender] = byteKey
-	bz, err = bigMap.MarshalJSON()
-	if err != nil {
-		return err
-	}
-	storage.Set(ALLOWANCES_MAP, bz)
+func RemoveIbcPermission(store std.Storage, spender string) error {
+	IBC.Remove(store, spender)
+	return nil
+}
 
+func LoadStargateAllowlist(store std.Storage, key string) (*contractTypes.StargateAllowlist, error) {
+	return STARGATE.Load(store, key)
+}
+
+func SaveStargateAllowlist(store std.Storage, spender string, allowlist *contractTypes.StargateAllowlist) error {
+	return STARGATE.Save(store, spender, allowlist)
+}
+
+func RemoveStargateAllowlist(store std.Storage, spender string) error {
+	STARGATE.Remove(store, spender)
 	return nil
 }
 
-func LoadAllAllowances(storage std.Storage, limit int) (*contractTypes.AllAllowancesResponse, error) {
-	// load big map that contains all the keys to the allowances
-	data := storage.Get(ALLOWANCES_MAP)
-	if data == nil {
-		return nil, errors.New("ALLOWANCES_MAP not found")
+// LoadGrant returns the spender's place in the grant tree, which is empty if it neither granted nor was granted by a subkey
+func LoadGrant(store std.Storage, spender string) (*contractTypes.Grant, error) {
+	grant, err := GRANTS.MayLoad(store, spender)
+	if err == nil && grant == nil {
+		grant = &contractTypes.Grant{}
 	}
+	return grant, err
+}
 
-	var bigMap contractTypes.BigMap
-	err := bigMap.UnmarshalJSON(data)
-	if err != nil {
-		return nil, errors.New("bigMap not found")
+func SaveGrant(store std.Storage, spender string, grant *contractTypes.Grant) error {
+	if grant.Parent == "" && len(grant.Children) == 0 {
+		return RemoveGrant(store, spender)
 	}
 
-	var allAllow contractTypes.AllAllowancesResponse
+	return GRANTS.Save(store, spender, grant)
+}
 
-	for i, byteKey := range bigMap.Keys {
-		if i == strconv.Itoa(limit) {
-			break
-		}
-		// load a single allowance now
-		data = storage.Get(byteKey)
-		if data == nil {
-			return nil, errors.New("byteKey doesn't have any value")
-		}
+func RemoveGrant(store std.Storage, spender string) error {
+	GRANTS.Remove(store, spender)
+	return nil
+}
 
-		var allow contractTypes.Allowances
-		err = allow.UnmarshalJSON(data)
-		if err != nil {
-			return nil, err
-		}
+// LoadHooks returns the registered hooks, which are empty if none were ever added
+func LoadHooks(store std.Storage) (*contractTypes.Hooks, error) {
+	hooks, err := HOOKS.MayLoad(store)
+	if err == nil && hooks == nil {
+		hooks = &contractTypes.Hooks{}
+	}
+	return hooks, err
+}
 
-		allowInfo := contractTypes.AllowanceInfo{
-			Spender: i,
-			Balance: allow.Balance,
-			Expires: allow.Expires,
+func SaveHooks(store std.Storage, hooks *contractTypes.Hooks) error {
+	return HOOKS.Save(store, hooks)
+}
+
+// NextSpendRequestID returns a fresh id, ids start at 1
+func NextSpendRequestID(store std.Storage) (uint64, error) {
+	count, err := SPEND_REQUEST_COUNT.Update(store, func(count *contractTypes.SpendRequestCount) (*contractTypes.SpendRequestCount, error) {
+		if count == nil {
+			count = &contractTypes.SpendRequestCount{}
 		}
+		count.LastID++
+		return count, nil
+	})
+	if err != nil {
+		return 0, err
+	}
+	return count.LastID, nil
+}
 
-		// add allowance to all allowances
-		allAllow.Allowances = append(allAllow.Allowances, allowInfo)
+func LoadSpendRequest(store std.Storage, id uint64) (*contractTypes.SpendRequest, error) {
+	request, err := SPEND_REQUESTS.MayLoad(store, id)
+	if err == nil && request == nil {
+		return nil, errors.New("Spend request not found")
 	}
+	return request, err
+}
 
-	return &allAllow, nil
+func SaveSpendRequest(store std.Storage, request *contractTypes.SpendRequest) error {
+	return SPEND_REQUESTS.Save(store, request.ID, request)
 }
 
-func LoadAllPermissions(storage std.Storage, limit int) (*contractTypes.AllPermissionsResponse, error) {
-	// load big map that contains all the keys to the permissions
-	data := storage.Get(PERMISSIONS_MAP)
-	if data == nil {
-		return nil, errors.New("PERMISSIONS_MAP not found")
-	}
+func RemoveSpendRequest(store std.Storage, id uint64) error {
+	return SPEND_REQUESTS.Remove(store, id)
+}
 
-	var bigMap contractTypes.BigMap
-	err := bigMap.UnmarshalJSON(data)
+// PruneSpendRequests removes the spender's expired requests, returning how many are still pending
+func PruneSpendRequests(store std.Storage, block types.BlockInfo, spender string) (int, error) {
+	var ids []uint64
+	err := SPEND_REQUESTS_BY_SPENDER.WalkPrefix(store, spender, nil, func(id uint64) (bool, error) {
+		ids = append(ids, id)
+		return true, nil
+	})
 	if err != nil {
-		return nil, errors.New("bigMap not found")
+		return 0, err
 	}
 
-	var allPerm contractTypes.AllPermissionsResponse
-
-	for i, byteKey := range bigMap.Keys {
-		if i == strconv.Itoa(limit) {
-			break
-		}
-		// load a single permissions now
-		data = storage.Get(byteKey)
-		if data == nil {
-			return nil, errors.New("byteKey doesn't have any value")
-		}
-
-		var perm contractTypes.Permissions
-		err = perm.UnmarshalJSON(data)
+	pending := 0
+	for _, id := range ids {
+		request, err := LoadSpendRequest(store, id)
 		if err != nil {
-			return nil, err
+			return 0, err
 		}
 
-		permInfo := contractTypes.PermissionInfo{
-			Spender:     i,
-			Permissions: perm,
+		if !request.Expires.IsExpired(block) {
+			pending++
+			continue
 		}
 
-		// add allowance to all allowances
-		allPerm.Permissions = append(allPerm.Permissions, permInfo)
+		err = RemoveSpendRequest(store, id)
+		if err != nil {
+			return 0, err
+		}
 	}
 
-	return &allPerm, nil
+	return pending, nil
 }
 
-func LoadContractInfo(storage std.Storage) (*contractTypes.ContractInfo, error) {
-	data := storage.Get(CONTRACT_INFO)
-	if data == nil {
-		return nil, errors.New("state not found")
+// LoadSpendRequests returns up to limit requests with an id above startAfter, skipping the ones that expired
+func LoadSpendRequests(store std.Storage, block types.BlockInfo, startAfter uint64, limit int) ([]contractTypes.SpendRequest, error) {
+	requests := []contractTypes.SpendRequest{}
+	if limit <= 0 {
+		return requests, nil
 	}
 
-	var state contractTypes.ContractInfo
-	err := state.UnmarshalJSON(data)
+	err := SPEND_REQUESTS.Walk(store, storage.Exclusive(startAfter), nil, std.Ascending, func(record storage.Record[uint64, contractTypes.SpendRequest]) (bool, error) {
+		if !record.Value.Expires.IsExpired(block) {
+			requests = append(requests, *record.Value)
+		}
+		return len(requests) < limit, nil
+	})
 	if err != nil {
 		return nil, err
 	}
 
-	return &state, nil
+	return requests, nil
 }
 
-func SaveContractInfo(storage std.Storage, state *contractTypes.ContractInfo) error {
-	bz, err := state.MarshalJSON()
-	if err != nil {
-		return err
+func LoadTopUpConfig(store std.Storage) (*contractTypes.TopUpConfig, error) {
+	config, err := TOP_UP_CONFIG.MayLoad(store)
+	if err == nil && config == nil {
+		config = &contractTypes.TopUpConfig{}
 	}
+	return config, err
+}
 
-	storage.Set(CONTRACT_INFO, bz)
+func SaveTopUpConfig(store std.Storage, config *contractTypes.TopUpConfig) error {
+	return TOP_UP_CONFIG.Save(store, config)
+}
 
-	return nil
+func LoadOracle(store std.Storage) (*contractTypes.OracleConfig, error) {
+	config, err := ORACLE.MayLoad(store)
+	if err == nil && config == nil {
+		return nil, errors.New("Contract Error: No oracle configured")
+	}
+	return config, err
+}
+
+func SaveOracle(store std.Storage, config *contractTypes.OracleConfig) error {
+	return ORACLE.Save(store, config)
+}
+
+func RemoveOracle(store std.Storage) {
+	ORACLE.Remove(store)
+}
+
+func LoadDenyList(store std.Storage) (*contractTypes.DenyList, error) {
+	denyList, err := DENY_LIST.MayLoad(store)
+	if err == nil && denyList == nil {
+		denyList = &contractTypes.DenyList{}
+	}
+	return denyList, err
+}
+
+func SaveDenyList(store std.Storage, denyList *contractTypes.DenyList) error {
+	return DENY_LIST.Save(store, denyList)
 }
@@ -0,0 +1,125 @@
+package src
+
+import (
+	"errors"
+	"strconv"
+
+	contractTypes "github.com/JackalLabs/burrow-contracts/cw1-subkeys/src/types"
+
+	"github.com/CosmWasm/cosmwasm-go/std"
+	"github.com/CosmWasm/cosmwasm-go/std/types"
+	cw1WhiteList "github.com/JackalLabs/burrow-contracts/cw1-whitelist/src"
+)
+
+// executeFundAllowance credits the coins sent along with the message to the spender's allowance.
+// The coins stay with the proxy, so the subkey spends exactly what it was funded
+func executeFundAllowance(deps *std.Deps, env *types.Env, info *types.MessageInfo, msg *contractTypes.FundAllowance) (*types.Response, error) {
+	sender := info.Sender
+	state, err := cw1WhiteList.LoadState(deps.Storage)
+	if err != nil {
+		return nil, err
+	}
+
+	if !state.IsAdmin(sender) {
+		config, err := LoadTopUpConfig(deps.Storage)
+		if err != nil {
+			return nil, err
+		}
+		if !config.CanFund(sender) {
+			return nil, errors.New("Unauthorized")
+		}
+	}
+
+	if len(info.Funds) == 0 {
+		return nil, errors.New("No funds attached")
+	}
+
+	// only existing subkeys can be topped up
+	allow, err := LoadAllowances(deps.Storage, msg.Spender)
+	if err != nil {
+		return nil, errors.New("Contract Error No Allowance")
+	}
+
+	if allow.Expires.IsExpired(env.Block) {
+		return nil, errors.New("Contract Error No Allowance")
+	}
+
+	allow.Balance, err = allow.Balance.AddBalance(contractTypes.NativeBalance{Coins: info.Funds})
+	if err != nil {
+		return nil, err
+	}
+
+	err = SaveAllowances(deps.Storage, msg.Spender, allow, env.Block.Height)
+	if err != nil {
+		return nil, err
+	}
+
+	var messages []types.SubMsg
+	for _, coin := range info.Funds {
+		hooks, err := hookMessages(deps.Storage, contractTypes.HookMsg{
+			AllowanceChanged: &contractTypes.AllowanceChangedHook{
+				Spender:   msg.Spender,
+				Increase:  true,
+				Amount:    coin,
+				Allowance: *allow,
+			},
+		})
+		if err != nil {
+			return nil, err
+		}
+		messages = append(messages, hooks...)
+	}
+
+	attributes := []types.EventAttribute{
+		{Key: "action", Value: "fund_allowance"},
+		{Key: "funder", Value: sender},
+		{Key: "spender", Value: msg.Spender},
+	}
+	for _, coin := range info.Funds {
+		attributes = append(attributes, types.EventAttribute{Key: "amount", Value: coin.Amount.String() + coin.Denom})
+	}
+
+	res := &types.Response{
+		Attributes: attributes,
+		Messages:   messages,
+	}
+	return res, nil
+}
+
+func executeSetTopUpConfig(deps *std.Deps, env *types.Env, info *types.MessageInfo, msg *contractTypes.TopUpConfig) (*types.Response, error) {
+	sender := info.Sender
+	state, err := cw1WhiteList.LoadState(deps.Storage)
+	if err != nil {
+		return nil, err
+	}
+
+	// check if sender is admin
+	if !state.IsAdmin(sender) {
+		return nil, errors.New("Unauthorized")
+	}
+
+	for _, funder := range msg.Funders {
+		err = deps.Api.ValidateAddress(funder)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	err = SaveTopUpConfig(deps.Storage, msg)
+	if err != nil {
+		return nil, err
+	}
+
+	res := &types.Response{
+		Attributes: []types.EventAttribute{
+			{Key: "action", Value: "set_top_up_config"},
+			{Key: "owner", Value: sender},
+			{Key: "restricted", Value: strconv.FormatBool(msg.Restricted)},
+		},
+	}
+	return res, nil
+}
+
+func queryTopUpConfig(deps *std.Deps, env *types.Env, msg *contractTypes.QueryTopUpConfig) (*contractTypes.TopUpConfig, error) {
+	return LoadTopUpConfig(deps.Storage)
+}
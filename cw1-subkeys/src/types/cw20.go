@@ -0,0 +1,108 @@
+package types
+
+import (
+	"errors"
+
+	"github.com/CosmWasm/cosmwasm-go/std/math"
+)
+
+// Cw20Allowances budgets cw20 tokens, one amount per token contract
+type Cw20Allowances struct {
+	Tokens  []Cw20Coin `json:"tokens"`
+	Expires Expiration `json:"expires"`
+}
+
+type Cw20Coin struct {
+	Address string       `json:"address"`
+	Amount  math.Uint128 `json:"amount"`
+}
+
+// Find returns the index of the token contract, or -1 if it has no allowance
+func (a Cw20Allowances) Find(address string) int {
+	for i, c := range a.Tokens {
+		if c.Address == address {
+			return i
+		}
+	}
+	return -1
+}
+
+// Add increases the allowance for the token contract
+func (a *Cw20Allowances) Add(address string, amount math.Uint128) error {
+	idx := a.Find(address)
+	if idx == -1 {
+		a.Tokens = append(a.Tokens, Cw20Coin{Address: address, Amount: amount})
+		return nil
+	}
+
+	sum, err := a.Tokens[idx].Amount.SafeAdd(amount)
+	if err != nil {
+		return errors.New("overflow error")
+	}
+	a.Tokens[idx].Amount = sum
+	return nil
+}
+
+// Sub decreases the allowance for the token contract, failing if it's too small
+func (a *Cw20Allowances) Sub(address string, amount math.Uint128) error {
+	idx := a.Find(address)
+	if idx == -1 {
+		return errors.New("overflow error")
+	}
+
+	remainder, err := a.Tokens[idx].Amount.SafeSub(amount)
+	if err != nil {
+		return errors.New("overflow error")
+	}
+
+	if remainder.IsZero() {
+		a.Tokens = append(a.Tokens[:idx], a.Tokens[idx+1:]...)
+	} else {
+		a.Tokens[idx].Amount = remainder
+	}
+	return nil
+}
+
+// SubSaturating is similar to Sub, but removes the token when the allowance is too small
+func (a *Cw20Allowances) SubSaturating(address string, amount math.Uint128) error {
+	idx := a.Find(address)
+	if idx == -1 {
+		return errors.New("overflow error")
+	}
+
+	if a.Tokens[idx].Amount.LTE(amount) {
+		a.Tokens = append(a.Tokens[:idx], a.Tokens[idx+1:]...)
+	} else {
+		a.Tokens[idx].Amount = a.Tokens[idx].Amount.Sub(amount)
+	}
+	return nil
+}
+
+// Cw20ExecuteMsg is the part of the cw20 execute interface that subkeys can spend with
+type Cw20ExecuteMsg struct {
+	Transfer *Cw20Transfer `json:"transfer,omitempty"`
+	Send     *Cw20Send     `json:"send,omitempty"`
+}
+
+type Cw20Transfer struct {
+	Recipient string       `json:"recipient"`
+	Amount    math.Uint128 `json:"amount"`
+}
+
+type Cw20Send struct {
+	Contract string       `json:"contract"`
+	Amount   math.Uint128 `json:"amount"`
+	Msg      []byte       `json:"msg"`
+}
+
+// Amount returns the tokens moved by the message
+func (m Cw20ExecuteMsg) Amount() (math.Uint128, error) {
+	switch {
+	case m.Transfer != nil:
+		return m.Transfer.Amount, nil
+	case m.Send != nil:
+		return m.Send.Amount, nil
+	default:
+		return math.ZeroUint128(), errors.New("Contract Error: Unsupported cw20 Message")
+	}
+}
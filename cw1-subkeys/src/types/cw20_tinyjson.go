@@ -0,0 +1,454 @@
+// Code generated by tinyjson for marshaling/unmarshaling. DO NOT EDIT.
+
+package types
+
+import (
+	tinyjson "github.com/CosmWasm/tinyjson"
+	jlexer "github.com/CosmWasm/tinyjson/jlexer"
+	jwriter "github.com/CosmWasm/tinyjson/jwriter"
+)
+
+// suppress unused package warning
+var (
+	_ *jlexer.Lexer
+	_ *jwriter.Writer
+	_ tinyjson.Marshaler
+)
+
+func tinyjsonD01d8a6cDecodeGithubComJackalLabsBurrowContractsCw1SubkeysSrcTypes(in *jlexer.Lexer, out *Cw20Transfer) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "recipient":
+			out.Recipient = string(in.String())
+		case "amount":
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.Amount).UnmarshalJSON(data))
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func tinyjsonD01d8a6cEncodeGithubComJackalLabsBurrowContractsCw1SubkeysSrcTypes(out *jwriter.Writer, in Cw20Transfer) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"recipient\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.Recipient))
+	}
+	{
+		const prefix string = ",\"amount\":"
+		out.RawString(prefix)
+		out.Raw((in.Amount).MarshalJSON())
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v Cw20Transfer) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	tinyjsonD01d8a6cEncodeGithubComJackalLabsBurrowContractsCw1SubkeysSrcTypes(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalTinyJSON supports tinyjson.Marshaler interface
+func (v Cw20Transfer) MarshalTinyJSON(w *jwriter.Writer) {
+	tinyjsonD01d8a6cEncodeGithubComJackalLabsBurrowContractsCw1SubkeysSrcTypes(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *Cw20Transfer) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	tinyjsonD01d8a6cDecodeGithubComJackalLabsBurrowContractsCw1SubkeysSrcTypes(&r, v)
+	return r.Error()
+}
+
+// UnmarshalTinyJSON supports tinyjson.Unmarshaler interface
+func (v *Cw20Transfer) UnmarshalTinyJSON(l *jlexer.Lexer) {
+	tinyjsonD01d8a6cDecodeGithubComJackalLabsBurrowContractsCw1SubkeysSrcTypes(l, v)
+}
+func tinyjsonD01d8a6cDecodeGithubComJackalLabsBurrowContractsCw1SubkeysSrcTypes1(in *jlexer.Lexer, out *Cw20Send) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "contract":
+			out.Contract = string(in.String())
+		case "amount":
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.Amount).UnmarshalJSON(data))
+			}
+		case "msg":
+			if in.IsNull() {
+				in.Skip()
+				out.Msg = nil
+			} else {
+				out.Msg = in.Bytes()
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func tinyjsonD01d8a6cEncodeGithubComJackalLabsBurrowContractsCw1SubkeysSrcTypes1(out *jwriter.Writer, in Cw20Send) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"contract\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.Contract))
+	}
+	{
+		const prefix string = ",\"amount\":"
+		out.RawString(prefix)
+		out.Raw((in.Amount).MarshalJSON())
+	}
+	{
+		const prefix string = ",\"msg\":"
+		out.RawString(prefix)
+		out.Base64Bytes(in.Msg)
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v Cw20Send) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	tinyjsonD01d8a6cEncodeGithubComJackalLabsBurrowContractsCw1SubkeysSrcTypes1(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalTinyJSON supports tinyjson.Marshaler interface
+func (v Cw20Send) MarshalTinyJSON(w *jwriter.Writer) {
+	tinyjsonD01d8a6cEncodeGithubComJackalLabsBurrowContractsCw1SubkeysSrcTypes1(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *Cw20Send) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	tinyjsonD01d8a6cDecodeGithubComJackalLabsBurrowContractsCw1SubkeysSrcTypes1(&r, v)
+	return r.Error()
+}
+
+// UnmarshalTinyJSON supports tinyjson.Unmarshaler interface
+func (v *Cw20Send) UnmarshalTinyJSON(l *jlexer.Lexer) {
+	tinyjsonD01d8a6cDecodeGithubComJackalLabsBurrowContractsCw1SubkeysSrcTypes1(l, v)
+}
+func tinyjsonD01d8a6cDecodeGithubComJackalLabsBurrowContractsCw1SubkeysSrcTypes2(in *jlexer.Lexer, out *Cw20ExecuteMsg) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "transfer":
+			if in.IsNull() {
+				in.Skip()
+				out.Transfer = nil
+			} else {
+				if out.Transfer == nil {
+					out.Transfer = new(Cw20Transfer)
+				}
+				(*out.Transfer).UnmarshalTinyJSON(in)
+			}
+		case "send":
+			if in.IsNull() {
+				in.Skip()
+				out.Send = nil
+			} else {
+				if out.Send == nil {
+					out.Send = new(Cw20Send)
+				}
+				(*out.Send).UnmarshalTinyJSON(in)
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func tinyjsonD01d8a6cEncodeGithubComJackalLabsBurrowContractsCw1SubkeysSrcTypes2(out *jwriter.Writer, in Cw20ExecuteMsg) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	if in.Transfer != nil {
+		const prefix string = ",\"transfer\":"
+		first = false
+		out.RawString(prefix[1:])
+		(*in.Transfer).MarshalTinyJSON(out)
+	}
+	if in.Send != nil {
+		const prefix string = ",\"send\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		(*in.Send).MarshalTinyJSON(out)
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v Cw20ExecuteMsg) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	tinyjsonD01d8a6cEncodeGithubComJackalLabsBurrowContractsCw1SubkeysSrcTypes2(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalTinyJSON supports tinyjson.Marshaler interface
+func (v Cw20ExecuteMsg) MarshalTinyJSON(w *jwriter.Writer) {
+	tinyjsonD01d8a6cEncodeGithubComJackalLabsBurrowContractsCw1SubkeysSrcTypes2(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *Cw20ExecuteMsg) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	tinyjsonD01d8a6cDecodeGithubComJackalLabsBurrowContractsCw1SubkeysSrcTypes2(&r, v)
+	return r.Error()
+}
+
+// UnmarshalTinyJSON supports tinyjson.Unmarshaler interface
+func (v *Cw20ExecuteMsg) UnmarshalTinyJSON(l *jlexer.Lexer) {
+	tinyjsonD01d8a6cDecodeGithubComJackalLabsBurrowContractsCw1SubkeysSrcTypes2(l, v)
+}
+func tinyjsonD01d8a6cDecodeGithubComJackalLabsBurrowContractsCw1SubkeysSrcTypes3(in *jlexer.Lexer, out *Cw20Coin) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "address":
+			out.Address = string(in.String())
+		case "amount":
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.Amount).UnmarshalJSON(data))
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func tinyjsonD01d8a6cEncodeGithubComJackalLabsBurrowContractsCw1SubkeysSrcTypes3(out *jwriter.Writer, in Cw20Coin) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"address\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.Address))
+	}
+	{
+		const prefix string = ",\"amount\":"
+		out.RawString(prefix)
+		out.Raw((in.Amount).MarshalJSON())
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v Cw20Coin) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	tinyjsonD01d8a6cEncodeGithubComJackalLabsBurrowContractsCw1SubkeysSrcTypes3(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalTinyJSON supports tinyjson.Marshaler interface
+func (v Cw20Coin) MarshalTinyJSON(w *jwriter.Writer) {
+	tinyjsonD01d8a6cEncodeGithubComJackalLabsBurrowContractsCw1SubkeysSrcTypes3(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *Cw20Coin) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	tinyjsonD01d8a6cDecodeGithubComJackalLabsBurrowContractsCw1SubkeysSrcTypes3(&r, v)
+	return r.Error()
+}
+
+// UnmarshalTinyJSON supports tinyjson.Unmarshaler interface
+func (v *Cw20Coin) UnmarshalTinyJSON(l *jlexer.Lexer) {
+	tinyjsonD01d8a6cDecodeGithubComJackalLabsBurrowContractsCw1SubkeysSrcTypes3(l, v)
+}
+func tinyjsonD01d8a6cDecodeGithubComJackalLabsBurrowContractsCw1SubkeysSrcTypes4(in *jlexer.Lexer, out *Cw20Allowances) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "tokens":
+			if in.IsNull() {
+				in.Skip()
+				out.Tokens = nil
+			} else {
+				in.Delim('[')
+				if out.Tokens == nil {
+					if !in.IsDelim(']') {
+						out.Tokens = make([]Cw20Coin, 0, 2)
+					} else {
+						out.Tokens = []Cw20Coin{}
+					}
+				} else {
+					out.Tokens = (out.Tokens)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v4 Cw20Coin
+					(v4).UnmarshalTinyJSON(in)
+					out.Tokens = append(out.Tokens, v4)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "expires":
+			(out.Expires).UnmarshalTinyJSON(in)
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func tinyjsonD01d8a6cEncodeGithubComJackalLabsBurrowContractsCw1SubkeysSrcTypes4(out *jwriter.Writer, in Cw20Allowances) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"tokens\":"
+		out.RawString(prefix[1:])
+		if in.Tokens == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v5, v6 := range in.Tokens {
+				if v5 > 0 {
+					out.RawByte(',')
+				}
+				(v6).MarshalTinyJSON(out)
+			}
+			out.RawByte(']')
+		}
+	}
+	{
+		const prefix string = ",\"expires\":"
+		out.RawString(prefix)
+		(in.Expires).MarshalTinyJSON(out)
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v Cw20Allowances) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	tinyjsonD01d8a6cEncodeGithubComJackalLabsBurrowContractsCw1SubkeysSrcTypes4(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalTinyJSON supports tinyjson.Marshaler interface
+func (v Cw20Allowances) MarshalTinyJSON(w *jwriter.Writer) {
+	tinyjsonD01d8a6cEncodeGithubComJackalLabsBurrowContractsCw1SubkeysSrcTypes4(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *Cw20Allowances) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	tinyjsonD01d8a6cDecodeGithubComJackalLabsBurrowContractsCw1SubkeysSrcTypes4(&r, v)
+	return r.Error()
+}
+
+// UnmarshalTinyJSON supports tinyjson.Unmarshaler interface
+func (v *Cw20Allowances) UnmarshalTinyJSON(l *jlexer.Lexer) {
+	tinyjsonD01d8a6cDecodeGithubComJackalLabsBurrowContractsCw1SubkeysSrcTypes4(l, v)
+}
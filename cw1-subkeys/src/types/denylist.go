@@ -0,0 +1,91 @@
+package types
+
+import (
+	"errors"
+	"slices"
+
+	"github.com/CosmWasm/cosmwasm-go/std/types"
+)
+
+// DenyList blocks destinations for every subkey, whatever its grants or policy allow.
+// Admins aren't affected
+type DenyList struct {
+	Recipients []string `json:"recipients"`
+	Validators []string `json:"validators"`
+}
+
+// Update adds and removes entries, removals are applied last
+func (d *DenyList) Update(msg UpdateDenyList) {
+	for _, addr := range msg.AddRecipients {
+		if !slices.Contains(d.Recipients, addr) {
+			d.Recipients = append(d.Recipients, addr)
+		}
+	}
+	for _, addr := range msg.AddValidators {
+		if !slices.Contains(d.Validators, addr) {
+			d.Validators = append(d.Validators, addr)
+		}
+	}
+
+	d.Recipients = slices.DeleteFunc(d.Recipients, func(addr string) bool {
+		return slices.Contains(msg.RemoveRecipients, addr)
+	})
+	d.Validators = slices.DeleteFunc(d.Validators, func(addr string) bool {
+		return slices.Contains(msg.RemoveValidators, addr)
+	})
+}
+
+// Check rejects messages that send to a denied recipient or delegate to a denied validator.
+// Undelegating from a denied validator stays possible so funds can be moved out
+func (d DenyList) Check(msg types.CosmosMsg) error {
+	switch {
+	case msg.Bank != nil && msg.Bank.Send != nil:
+		return d.checkRecipient(msg.Bank.Send.ToAddress)
+
+	case msg.IBC != nil && msg.IBC.Transfer != nil:
+		return d.checkRecipient(msg.IBC.Transfer.ToAddress)
+
+	case msg.Wasm != nil && msg.Wasm.Execute != nil:
+		err := d.checkRecipient(msg.Wasm.Execute.ContractAddr)
+		if err != nil {
+			return err
+		}
+
+		// cw20 tokens leave to the recipient of the transfer, not the token contract
+		var cw20Msg Cw20ExecuteMsg
+		if cw20Msg.UnmarshalJSON(msg.Wasm.Execute.Msg) != nil {
+			return nil
+		}
+		switch {
+		case cw20Msg.Transfer != nil:
+			return d.checkRecipient(cw20Msg.Transfer.Recipient)
+		case cw20Msg.Send != nil:
+			return d.checkRecipient(cw20Msg.Send.Contract)
+		}
+
+	case msg.Distribution != nil && msg.Distribution.SetWithdrawAddress != nil:
+		return d.checkRecipient(msg.Distribution.SetWithdrawAddress.Address)
+
+	case msg.Staking != nil && msg.Staking.Delegate != nil:
+		return d.checkValidator(msg.Staking.Delegate.Validator)
+
+	case msg.Staking != nil && msg.Staking.Redelegate != nil:
+		return d.checkValidator(msg.Staking.Redelegate.DstValidator)
+	}
+
+	return nil
+}
+
+func (d DenyList) checkRecipient(addr string) error {
+	if slices.Contains(d.Recipients, addr) {
+		return errors.New("Contract Error: Recipient " + addr + " is denied")
+	}
+	return nil
+}
+
+func (d DenyList) checkValidator(addr string) error {
+	if slices.Contains(d.Validators, addr) {
+		return errors.New("Contract Error: Validator " + addr + " is denied")
+	}
+	return nil
+}
@@ -0,0 +1,154 @@
+// Code generated by tinyjson for marshaling/unmarshaling. DO NOT EDIT.
+
+package types
+
+import (
+	tinyjson "github.com/CosmWasm/tinyjson"
+	jlexer "github.com/CosmWasm/tinyjson/jlexer"
+	jwriter "github.com/CosmWasm/tinyjson/jwriter"
+)
+
+// suppress unused package warning
+var (
+	_ *jlexer.Lexer
+	_ *jwriter.Writer
+	_ tinyjson.Marshaler
+)
+
+func tinyjson99a69afaDecodeGithubComJackalLabsBurrowContractsCw1SubkeysSrcTypes(in *jlexer.Lexer, out *DenyList) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "recipients":
+			if in.IsNull() {
+				in.Skip()
+				out.Recipients = nil
+			} else {
+				in.Delim('[')
+				if out.Recipients == nil {
+					if !in.IsDelim(']') {
+						out.Recipients = make([]string, 0, 4)
+					} else {
+						out.Recipients = []string{}
+					}
+				} else {
+					out.Recipients = (out.Recipients)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v1 string
+					v1 = string(in.String())
+					out.Recipients = append(out.Recipients, v1)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "validators":
+			if in.IsNull() {
+				in.Skip()
+				out.Validators = nil
+			} else {
+				in.Delim('[')
+				if out.Validators == nil {
+					if !in.IsDelim(']') {
+						out.Validators = make([]string, 0, 4)
+					} else {
+						out.Validators = []string{}
+					}
+				} else {
+					out.Validators = (out.Validators)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v2 string
+					v2 = string(in.String())
+					out.Validators = append(out.Validators, v2)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func tinyjson99a69afaEncodeGithubComJackalLabsBurrowContractsCw1SubkeysSrcTypes(out *jwriter.Writer, in DenyList) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"recipients\":"
+		out.RawString(prefix[1:])
+		if in.Recipients == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v3, v4 := range in.Recipients {
+				if v3 > 0 {
+					out.RawByte(',')
+				}
+				out.String(string(v4))
+			}
+			out.RawByte(']')
+		}
+	}
+	{
+		const prefix string = ",\"validators\":"
+		out.RawString(prefix)
+		if in.Validators == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v5, v6 := range in.Validators {
+				if v5 > 0 {
+					out.RawByte(',')
+				}
+				out.String(string(v6))
+			}
+			out.RawByte(']')
+		}
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v DenyList) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	tinyjson99a69afaEncodeGithubComJackalLabsBurrowContractsCw1SubkeysSrcTypes(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalTinyJSON supports tinyjson.Marshaler interface
+func (v DenyList) MarshalTinyJSON(w *jwriter.Writer) {
+	tinyjson99a69afaEncodeGithubComJackalLabsBurrowContractsCw1SubkeysSrcTypes(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *DenyList) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	tinyjson99a69afaDecodeGithubComJackalLabsBurrowContractsCw1SubkeysSrcTypes(&r, v)
+	return r.Error()
+}
+
+// UnmarshalTinyJSON supports tinyjson.Unmarshaler interface
+func (v *DenyList) UnmarshalTinyJSON(l *jlexer.Lexer) {
+	tinyjson99a69afaDecodeGithubComJackalLabsBurrowContractsCw1SubkeysSrcTypes(l, v)
+}
@@ -0,0 +1,69 @@
+package types
+
+import (
+	"github.com/CosmWasm/cosmwasm-go/std/math"
+	"github.com/CosmWasm/cosmwasm-go/std/types"
+)
+
+// HookMsg is executed on every registered hook contract when a subkey changes.
+// Exactly one field is set.
+type HookMsg struct {
+	AllowanceChanged     *AllowanceChangedHook     `json:"allowance_changed_hook,omitempty"`
+	Cw20AllowanceChanged *Cw20AllowanceChangedHook `json:"cw20_allowance_changed_hook,omitempty"`
+	PermissionsChanged   *PermissionsChangedHook   `json:"permissions_changed_hook,omitempty"`
+	IbcPermissionChanged *IbcPermissionChangedHook `json:"ibc_permission_changed_hook,omitempty"`
+	StargateChanged      *StargateChangedHook      `json:"stargate_changed_hook,omitempty"`
+	PolicyChanged        *PolicyChangedHook        `json:"policy_changed_hook,omitempty"`
+	SubkeyRevoked        *SubkeyRevokedHook        `json:"subkey_revoked_hook,omitempty"`
+	SubkeySpent          *SubkeySpentHook          `json:"subkey_spent_hook,omitempty"`
+}
+
+// AllowanceChangedHook carries the change and the resulting allowance, which is empty if it was removed
+type AllowanceChangedHook struct {
+	Spender   string     `json:"spender"`
+	Increase  bool       `json:"increase"`
+	Amount    types.Coin `json:"amount"`
+	Allowance Allowances `json:"allowance"`
+}
+
+// Cw20AllowanceChangedHook carries the change and the resulting cw20 allowances, which are empty if they were removed
+type Cw20AllowanceChangedHook struct {
+	Spender   string         `json:"spender"`
+	Increase  bool           `json:"increase"`
+	Token     string         `json:"token"`
+	Amount    math.Uint128   `json:"amount"`
+	Allowance Cw20Allowances `json:"allowance"`
+}
+
+type PermissionsChangedHook struct {
+	Spender     string      `json:"spender"`
+	Permissions Permissions `json:"permissions"`
+}
+
+// IbcPermissionChangedHook carries the new IBC permission, which is nil if it was removed
+type IbcPermissionChangedHook struct {
+	Spender    string         `json:"spender"`
+	Permission *IbcPermission `json:"permission,omitempty"`
+}
+
+// StargateChangedHook carries the new Stargate allowlist, which is nil if it was removed
+type StargateChangedHook struct {
+	Spender   string             `json:"spender"`
+	Allowlist *StargateAllowlist `json:"allowlist,omitempty"`
+}
+
+// PolicyChangedHook carries the new policy, which is nil if it was removed
+type PolicyChangedHook struct {
+	Spender string  `json:"spender"`
+	Policy  *Policy `json:"policy,omitempty"`
+}
+
+type SubkeyRevokedHook struct {
+	Spender string `json:"spender"`
+}
+
+// SubkeySpentHook carries the messages a subkey had the proxy execute
+type SubkeySpentHook struct {
+	Spender string            `json:"spender"`
+	Msgs    []types.CosmosMsg `json:"msgs"`
+}
@@ -0,0 +1,903 @@
+// Code generated by tinyjson for marshaling/unmarshaling. DO NOT EDIT.
+
+package types
+
+import (
+	types "github.com/CosmWasm/cosmwasm-go/std/types"
+	tinyjson "github.com/CosmWasm/tinyjson"
+	jlexer "github.com/CosmWasm/tinyjson/jlexer"
+	jwriter "github.com/CosmWasm/tinyjson/jwriter"
+)
+
+// suppress unused package warning
+var (
+	_ *jlexer.Lexer
+	_ *jwriter.Writer
+	_ tinyjson.Marshaler
+)
+
+func tinyjsonCee9c9b2DecodeGithubComJackalLabsBurrowContractsCw1SubkeysSrcTypes(in *jlexer.Lexer, out *SubkeySpentHook) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "spender":
+			out.Spender = string(in.String())
+		case "msgs":
+			if in.IsNull() {
+				in.Skip()
+				out.Msgs = nil
+			} else {
+				in.Delim('[')
+				if out.Msgs == nil {
+					if !in.IsDelim(']') {
+						out.Msgs = make([]types.CosmosMsg, 0, 0)
+					} else {
+						out.Msgs = []types.CosmosMsg{}
+					}
+				} else {
+					out.Msgs = (out.Msgs)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v1 types.CosmosMsg
+					(v1).UnmarshalTinyJSON(in)
+					out.Msgs = append(out.Msgs, v1)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func tinyjsonCee9c9b2EncodeGithubComJackalLabsBurrowContractsCw1SubkeysSrcTypes(out *jwriter.Writer, in SubkeySpentHook) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"spender\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.Spender))
+	}
+	{
+		const prefix string = ",\"msgs\":"
+		out.RawString(prefix)
+		if in.Msgs == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v2, v3 := range in.Msgs {
+				if v2 > 0 {
+					out.RawByte(',')
+				}
+				(v3).MarshalTinyJSON(out)
+			}
+			out.RawByte(']')
+		}
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v SubkeySpentHook) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	tinyjsonCee9c9b2EncodeGithubComJackalLabsBurrowContractsCw1SubkeysSrcTypes(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalTinyJSON supports tinyjson.Marshaler interface
+func (v SubkeySpentHook) MarshalTinyJSON(w *jwriter.Writer) {
+	tinyjsonCee9c9b2EncodeGithubComJackalLabsBurrowContractsCw1SubkeysSrcTypes(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *SubkeySpentHook) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	tinyjsonCee9c9b2DecodeGithubComJackalLabsBurrowContractsCw1SubkeysSrcTypes(&r, v)
+	return r.Error()
+}
+
+// UnmarshalTinyJSON supports tinyjson.Unmarshaler interface
+func (v *SubkeySpentHook) UnmarshalTinyJSON(l *jlexer.Lexer) {
+	tinyjsonCee9c9b2DecodeGithubComJackalLabsBurrowContractsCw1SubkeysSrcTypes(l, v)
+}
+func tinyjsonCee9c9b2DecodeGithubComJackalLabsBurrowContractsCw1SubkeysSrcTypes1(in *jlexer.Lexer, out *SubkeyRevokedHook) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "spender":
+			out.Spender = string(in.String())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func tinyjsonCee9c9b2EncodeGithubComJackalLabsBurrowContractsCw1SubkeysSrcTypes1(out *jwriter.Writer, in SubkeyRevokedHook) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"spender\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.Spender))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v SubkeyRevokedHook) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	tinyjsonCee9c9b2EncodeGithubComJackalLabsBurrowContractsCw1SubkeysSrcTypes1(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalTinyJSON supports tinyjson.Marshaler interface
+func (v SubkeyRevokedHook) MarshalTinyJSON(w *jwriter.Writer) {
+	tinyjsonCee9c9b2EncodeGithubComJackalLabsBurrowContractsCw1SubkeysSrcTypes1(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *SubkeyRevokedHook) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	tinyjsonCee9c9b2DecodeGithubComJackalLabsBurrowContractsCw1SubkeysSrcTypes1(&r, v)
+	return r.Error()
+}
+
+// UnmarshalTinyJSON supports tinyjson.Unmarshaler interface
+func (v *SubkeyRevokedHook) UnmarshalTinyJSON(l *jlexer.Lexer) {
+	tinyjsonCee9c9b2DecodeGithubComJackalLabsBurrowContractsCw1SubkeysSrcTypes1(l, v)
+}
+func tinyjsonCee9c9b2DecodeGithubComJackalLabsBurrowContractsCw1SubkeysSrcTypes2(in *jlexer.Lexer, out *StargateChangedHook) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "spender":
+			out.Spender = string(in.String())
+		case "allowlist":
+			if in.IsNull() {
+				in.Skip()
+				out.Allowlist = nil
+			} else {
+				if out.Allowlist == nil {
+					out.Allowlist = new(StargateAllowlist)
+				}
+				(*out.Allowlist).UnmarshalTinyJSON(in)
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func tinyjsonCee9c9b2EncodeGithubComJackalLabsBurrowContractsCw1SubkeysSrcTypes2(out *jwriter.Writer, in StargateChangedHook) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"spender\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.Spender))
+	}
+	if in.Allowlist != nil {
+		const prefix string = ",\"allowlist\":"
+		out.RawString(prefix)
+		(*in.Allowlist).MarshalTinyJSON(out)
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v StargateChangedHook) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	tinyjsonCee9c9b2EncodeGithubComJackalLabsBurrowContractsCw1SubkeysSrcTypes2(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalTinyJSON supports tinyjson.Marshaler interface
+func (v StargateChangedHook) MarshalTinyJSON(w *jwriter.Writer) {
+	tinyjsonCee9c9b2EncodeGithubComJackalLabsBurrowContractsCw1SubkeysSrcTypes2(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *StargateChangedHook) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	tinyjsonCee9c9b2DecodeGithubComJackalLabsBurrowContractsCw1SubkeysSrcTypes2(&r, v)
+	return r.Error()
+}
+
+// UnmarshalTinyJSON supports tinyjson.Unmarshaler interface
+func (v *StargateChangedHook) UnmarshalTinyJSON(l *jlexer.Lexer) {
+	tinyjsonCee9c9b2DecodeGithubComJackalLabsBurrowContractsCw1SubkeysSrcTypes2(l, v)
+}
+func tinyjsonCee9c9b2DecodeGithubComJackalLabsBurrowContractsCw1SubkeysSrcTypes3(in *jlexer.Lexer, out *PolicyChangedHook) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "spender":
+			out.Spender = string(in.String())
+		case "policy":
+			if in.IsNull() {
+				in.Skip()
+				out.Policy = nil
+			} else {
+				if out.Policy == nil {
+					out.Policy = new(Policy)
+				}
+				(*out.Policy).UnmarshalTinyJSON(in)
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func tinyjsonCee9c9b2EncodeGithubComJackalLabsBurrowContractsCw1SubkeysSrcTypes3(out *jwriter.Writer, in PolicyChangedHook) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"spender\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.Spender))
+	}
+	if in.Policy != nil {
+		const prefix string = ",\"policy\":"
+		out.RawString(prefix)
+		(*in.Policy).MarshalTinyJSON(out)
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v PolicyChangedHook) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	tinyjsonCee9c9b2EncodeGithubComJackalLabsBurrowContractsCw1SubkeysSrcTypes3(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalTinyJSON supports tinyjson.Marshaler interface
+func (v PolicyChangedHook) MarshalTinyJSON(w *jwriter.Writer) {
+	tinyjsonCee9c9b2EncodeGithubComJackalLabsBurrowContractsCw1SubkeysSrcTypes3(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *PolicyChangedHook) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	tinyjsonCee9c9b2DecodeGithubComJackalLabsBurrowContractsCw1SubkeysSrcTypes3(&r, v)
+	return r.Error()
+}
+
+// UnmarshalTinyJSON supports tinyjson.Unmarshaler interface
+func (v *PolicyChangedHook) UnmarshalTinyJSON(l *jlexer.Lexer) {
+	tinyjsonCee9c9b2DecodeGithubComJackalLabsBurrowContractsCw1SubkeysSrcTypes3(l, v)
+}
+func tinyjsonCee9c9b2DecodeGithubComJackalLabsBurrowContractsCw1SubkeysSrcTypes4(in *jlexer.Lexer, out *PermissionsChangedHook) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "spender":
+			out.Spender = string(in.String())
+		case "permissions":
+			(out.Permissions).UnmarshalTinyJSON(in)
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func tinyjsonCee9c9b2EncodeGithubComJackalLabsBurrowContractsCw1SubkeysSrcTypes4(out *jwriter.Writer, in PermissionsChangedHook) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"spender\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.Spender))
+	}
+	{
+		const prefix string = ",\"permissions\":"
+		out.RawString(prefix)
+		(in.Permissions).MarshalTinyJSON(out)
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v PermissionsChangedHook) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	tinyjsonCee9c9b2EncodeGithubComJackalLabsBurrowContractsCw1SubkeysSrcTypes4(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalTinyJSON supports tinyjson.Marshaler interface
+func (v PermissionsChangedHook) MarshalTinyJSON(w *jwriter.Writer) {
+	tinyjsonCee9c9b2EncodeGithubComJackalLabsBurrowContractsCw1SubkeysSrcTypes4(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *PermissionsChangedHook) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	tinyjsonCee9c9b2DecodeGithubComJackalLabsBurrowContractsCw1SubkeysSrcTypes4(&r, v)
+	return r.Error()
+}
+
+// UnmarshalTinyJSON supports tinyjson.Unmarshaler interface
+func (v *PermissionsChangedHook) UnmarshalTinyJSON(l *jlexer.Lexer) {
+	tinyjsonCee9c9b2DecodeGithubComJackalLabsBurrowContractsCw1SubkeysSrcTypes4(l, v)
+}
+func tinyjsonCee9c9b2DecodeGithubComJackalLabsBurrowContractsCw1SubkeysSrcTypes5(in *jlexer.Lexer, out *IbcPermissionChangedHook) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "spender":
+			out.Spender = string(in.String())
+		case "permission":
+			if in.IsNull() {
+				in.Skip()
+				out.Permission = nil
+			} else {
+				if out.Permission == nil {
+					out.Permission = new(IbcPermission)
+				}
+				(*out.Permission).UnmarshalTinyJSON(in)
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func tinyjsonCee9c9b2EncodeGithubComJackalLabsBurrowContractsCw1SubkeysSrcTypes5(out *jwriter.Writer, in IbcPermissionChangedHook) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"spender\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.Spender))
+	}
+	if in.Permission != nil {
+		const prefix string = ",\"permission\":"
+		out.RawString(prefix)
+		(*in.Permission).MarshalTinyJSON(out)
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v IbcPermissionChangedHook) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	tinyjsonCee9c9b2EncodeGithubComJackalLabsBurrowContractsCw1SubkeysSrcTypes5(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalTinyJSON supports tinyjson.Marshaler interface
+func (v IbcPermissionChangedHook) MarshalTinyJSON(w *jwriter.Writer) {
+	tinyjsonCee9c9b2EncodeGithubComJackalLabsBurrowContractsCw1SubkeysSrcTypes5(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *IbcPermissionChangedHook) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	tinyjsonCee9c9b2DecodeGithubComJackalLabsBurrowContractsCw1SubkeysSrcTypes5(&r, v)
+	return r.Error()
+}
+
+// UnmarshalTinyJSON supports tinyjson.Unmarshaler interface
+func (v *IbcPermissionChangedHook) UnmarshalTinyJSON(l *jlexer.Lexer) {
+	tinyjsonCee9c9b2DecodeGithubComJackalLabsBurrowContractsCw1SubkeysSrcTypes5(l, v)
+}
+func tinyjsonCee9c9b2DecodeGithubComJackalLabsBurrowContractsCw1SubkeysSrcTypes6(in *jlexer.Lexer, out *HookMsg) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "allowance_changed_hook":
+			if in.IsNull() {
+				in.Skip()
+				out.AllowanceChanged = nil
+			} else {
+				if out.AllowanceChanged == nil {
+					out.AllowanceChanged = new(AllowanceChangedHook)
+				}
+				(*out.AllowanceChanged).UnmarshalTinyJSON(in)
+			}
+		case "cw20_allowance_changed_hook":
+			if in.IsNull() {
+				in.Skip()
+				out.Cw20AllowanceChanged = nil
+			} else {
+				if out.Cw20AllowanceChanged == nil {
+					out.Cw20AllowanceChanged = new(Cw20AllowanceChangedHook)
+				}
+				(*out.Cw20AllowanceChanged).UnmarshalTinyJSON(in)
+			}
+		case "permissions_changed_hook":
+			if in.IsNull() {
+				in.Skip()
+				out.PermissionsChanged = nil
+			} else {
+				if out.PermissionsChanged == nil {
+					out.PermissionsChanged = new(PermissionsChangedHook)
+				}
+				(*out.PermissionsChanged).UnmarshalTinyJSON(in)
+			}
+		case "ibc_permission_changed_hook":
+			if in.IsNull() {
+				in.Skip()
+				out.IbcPermissionChanged = nil
+			} else {
+				if out.IbcPermissionChanged == nil {
+					out.IbcPermissionChanged = new(IbcPermissionChangedHook)
+				}
+				(*out.IbcPermissionChanged).UnmarshalTinyJSON(in)
+			}
+		case "stargate_changed_hook":
+			if in.IsNull() {
+				in.Skip()
+				out.StargateChanged = nil
+			} else {
+				if out.StargateChanged == nil {
+					out.StargateChanged = new(StargateChangedHook)
+				}
+				(*out.StargateChanged).UnmarshalTinyJSON(in)
+			}
+		case "policy_changed_hook":
+			if in.IsNull() {
+				in.Skip()
+				out.PolicyChanged = nil
+			} else {
+				if out.PolicyChanged == nil {
+					out.PolicyChanged = new(PolicyChangedHook)
+				}
+				(*out.PolicyChanged).UnmarshalTinyJSON(in)
+			}
+		case "subkey_revoked_hook":
+			if in.IsNull() {
+				in.Skip()
+				out.SubkeyRevoked = nil
+			} else {
+				if out.SubkeyRevoked == nil {
+					out.SubkeyRevoked = new(SubkeyRevokedHook)
+				}
+				(*out.SubkeyRevoked).UnmarshalTinyJSON(in)
+			}
+		case "subkey_spent_hook":
+			if in.IsNull() {
+				in.Skip()
+				out.SubkeySpent = nil
+			} else {
+				if out.SubkeySpent == nil {
+					out.SubkeySpent = new(SubkeySpentHook)
+				}
+				(*out.SubkeySpent).UnmarshalTinyJSON(in)
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func tinyjsonCee9c9b2EncodeGithubComJackalLabsBurrowContractsCw1SubkeysSrcTypes6(out *jwriter.Writer, in HookMsg) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	if in.AllowanceChanged != nil {
+		const prefix string = ",\"allowance_changed_hook\":"
+		first = false
+		out.RawString(prefix[1:])
+		(*in.AllowanceChanged).MarshalTinyJSON(out)
+	}
+	if in.Cw20AllowanceChanged != nil {
+		const prefix string = ",\"cw20_allowance_changed_hook\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		(*in.Cw20AllowanceChanged).MarshalTinyJSON(out)
+	}
+	if in.PermissionsChanged != nil {
+		const prefix string = ",\"permissions_changed_hook\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		(*in.PermissionsChanged).MarshalTinyJSON(out)
+	}
+	if in.IbcPermissionChanged != nil {
+		const prefix string = ",\"ibc_permission_changed_hook\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		(*in.IbcPermissionChanged).MarshalTinyJSON(out)
+	}
+	if in.StargateChanged != nil {
+		const prefix string = ",\"stargate_changed_hook\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		(*in.StargateChanged).MarshalTinyJSON(out)
+	}
+	if in.PolicyChanged != nil {
+		const prefix string = ",\"policy_changed_hook\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		(*in.PolicyChanged).MarshalTinyJSON(out)
+	}
+	if in.SubkeyRevoked != nil {
+		const prefix string = ",\"subkey_revoked_hook\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		(*in.SubkeyRevoked).MarshalTinyJSON(out)
+	}
+	if in.SubkeySpent != nil {
+		const prefix string = ",\"subkey_spent_hook\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		(*in.SubkeySpent).MarshalTinyJSON(out)
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v HookMsg) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	tinyjsonCee9c9b2EncodeGithubComJackalLabsBurrowContractsCw1SubkeysSrcTypes6(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalTinyJSON supports tinyjson.Marshaler interface
+func (v HookMsg) MarshalTinyJSON(w *jwriter.Writer) {
+	tinyjsonCee9c9b2EncodeGithubComJackalLabsBurrowContractsCw1SubkeysSrcTypes6(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *HookMsg) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	tinyjsonCee9c9b2DecodeGithubComJackalLabsBurrowContractsCw1SubkeysSrcTypes6(&r, v)
+	return r.Error()
+}
+
+// UnmarshalTinyJSON supports tinyjson.Unmarshaler interface
+func (v *HookMsg) UnmarshalTinyJSON(l *jlexer.Lexer) {
+	tinyjsonCee9c9b2DecodeGithubComJackalLabsBurrowContractsCw1SubkeysSrcTypes6(l, v)
+}
+func tinyjsonCee9c9b2DecodeGithubComJackalLabsBurrowContractsCw1SubkeysSrcTypes7(in *jlexer.Lexer, out *Cw20AllowanceChangedHook) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "spender":
+			out.Spender = string(in.String())
+		case "increase":
+			out.Increase = bool(in.Bool())
+		case "token":
+			out.Token = string(in.String())
+		case "amount":
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.Amount).UnmarshalJSON(data))
+			}
+		case "allowance":
+			(out.Allowance).UnmarshalTinyJSON(in)
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func tinyjsonCee9c9b2EncodeGithubComJackalLabsBurrowContractsCw1SubkeysSrcTypes7(out *jwriter.Writer, in Cw20AllowanceChangedHook) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"spender\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.Spender))
+	}
+	{
+		const prefix string = ",\"increase\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.Increase))
+	}
+	{
+		const prefix string = ",\"token\":"
+		out.RawString(prefix)
+		out.String(string(in.Token))
+	}
+	{
+		const prefix string = ",\"amount\":"
+		out.RawString(prefix)
+		out.Raw((in.Amount).MarshalJSON())
+	}
+	{
+		const prefix string = ",\"allowance\":"
+		out.RawString(prefix)
+		(in.Allowance).MarshalTinyJSON(out)
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v Cw20AllowanceChangedHook) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	tinyjsonCee9c9b2EncodeGithubComJackalLabsBurrowContractsCw1SubkeysSrcTypes7(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalTinyJSON supports tinyjson.Marshaler interface
+func (v Cw20AllowanceChangedHook) MarshalTinyJSON(w *jwriter.Writer) {
+	tinyjsonCee9c9b2EncodeGithubComJackalLabsBurrowContractsCw1SubkeysSrcTypes7(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *Cw20AllowanceChangedHook) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	tinyjsonCee9c9b2DecodeGithubComJackalLabsBurrowContractsCw1SubkeysSrcTypes7(&r, v)
+	return r.Error()
+}
+
+// UnmarshalTinyJSON supports tinyjson.Unmarshaler interface
+func (v *Cw20AllowanceChangedHook) UnmarshalTinyJSON(l *jlexer.Lexer) {
+	tinyjsonCee9c9b2DecodeGithubComJackalLabsBurrowContractsCw1SubkeysSrcTypes7(l, v)
+}
+func tinyjsonCee9c9b2DecodeGithubComJackalLabsBurrowContractsCw1SubkeysSrcTypes8(in *jlexer.Lexer, out *AllowanceChangedHook) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "spender":
+			out.Spender = string(in.String())
+		case "increase":
+			out.Increase = bool(in.Bool())
+		case "amount":
+			(out.Amount).UnmarshalTinyJSON(in)
+		case "allowance":
+			(out.Allowance).UnmarshalTinyJSON(in)
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func tinyjsonCee9c9b2EncodeGithubComJackalLabsBurrowContractsCw1SubkeysSrcTypes8(out *jwriter.Writer, in AllowanceChangedHook) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"spender\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.Spender))
+	}
+	{
+		const prefix string = ",\"increase\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.Increase))
+	}
+	{
+		const prefix string = ",\"amount\":"
+		out.RawString(prefix)
+		(in.Amount).MarshalTinyJSON(out)
+	}
+	{
+		const prefix string = ",\"allowance\":"
+		out.RawString(prefix)
+		(in.Allowance).MarshalTinyJSON(out)
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v AllowanceChangedHook) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	tinyjsonCee9c9b2EncodeGithubComJackalLabsBurrowContractsCw1SubkeysSrcTypes8(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalTinyJSON supports tinyjson.Marshaler interface
+func (v AllowanceChangedHook) MarshalTinyJSON(w *jwriter.Writer) {
+	tinyjsonCee9c9b2EncodeGithubComJackalLabsBurrowContractsCw1SubkeysSrcTypes8(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *AllowanceChangedHook) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	tinyjsonCee9c9b2DecodeGithubComJackalLabsBurrowContractsCw1SubkeysSrcTypes8(&r, v)
+	return r.Error()
+}
+
+// UnmarshalTinyJSON supports tinyjson.Unmarshaler interface
+func (v *AllowanceChangedHook) UnmarshalTinyJSON(l *jlexer.Lexer) {
+	tinyjsonCee9c9b2DecodeGithubComJackalLabsBurrowContractsCw1SubkeysSrcTypes8(l, v)
+}
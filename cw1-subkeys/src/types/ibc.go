@@ -0,0 +1,55 @@
+package types
+
+import (
+	"errors"
+	"slices"
+
+	"github.com/CosmWasm/cosmwasm-go/std/types"
+)
+
+// IbcPermission lets a subkey send tokens over IBC on the listed channels,
+// the amount is debited from its allowance.
+// An empty Receivers list allows any receiving address.
+type IbcPermission struct {
+	Channels  []string `json:"channels"`
+	Receivers []string `json:"receivers,omitempty"`
+}
+
+func (p IbcPermission) Validate() error {
+	if len(p.Channels) == 0 {
+		return errors.New("Channel list cannot be empty")
+	}
+
+	for i, channel := range p.Channels {
+		if channel == "" {
+			return errors.New("Channel id cannot be empty")
+		}
+		if slices.Contains(p.Channels[:i], channel) {
+			return errors.New("Duplicate channel " + channel)
+		}
+	}
+
+	for i, receiver := range p.Receivers {
+		if receiver == "" {
+			return errors.New("Receiver cannot be empty")
+		}
+		if slices.Contains(p.Receivers[:i], receiver) {
+			return errors.New("Duplicate receiver " + receiver)
+		}
+	}
+
+	return nil
+}
+
+// Check returns an error if the transfer uses a channel or receiver that wasn't approved
+func (p IbcPermission) Check(transfer *types.TransferMsg) error {
+	if !slices.Contains(p.Channels, transfer.ChannelID) {
+		return errors.New("Contract Error: Channel Not Approved")
+	}
+
+	if len(p.Receivers) > 0 && !slices.Contains(p.Receivers, transfer.ToAddress) {
+		return errors.New("Contract Error: Receiver Not Approved")
+	}
+
+	return nil
+}
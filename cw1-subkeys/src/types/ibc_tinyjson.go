@@ -0,0 +1,152 @@
+// Code generated by tinyjson for marshaling/unmarshaling. DO NOT EDIT.
+
+package types
+
+import (
+	tinyjson "github.com/CosmWasm/tinyjson"
+	jlexer "github.com/CosmWasm/tinyjson/jlexer"
+	jwriter "github.com/CosmWasm/tinyjson/jwriter"
+)
+
+// suppress unused package warning
+var (
+	_ *jlexer.Lexer
+	_ *jwriter.Writer
+	_ tinyjson.Marshaler
+)
+
+func tinyjson50c1e60aDecodeGithubComJackalLabsBurrowContractsCw1SubkeysSrcTypes(in *jlexer.Lexer, out *IbcPermission) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "channels":
+			if in.IsNull() {
+				in.Skip()
+				out.Channels = nil
+			} else {
+				in.Delim('[')
+				if out.Channels == nil {
+					if !in.IsDelim(']') {
+						out.Channels = make([]string, 0, 4)
+					} else {
+						out.Channels = []string{}
+					}
+				} else {
+					out.Channels = (out.Channels)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v1 string
+					v1 = string(in.String())
+					out.Channels = append(out.Channels, v1)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "receivers":
+			if in.IsNull() {
+				in.Skip()
+				out.Receivers = nil
+			} else {
+				in.Delim('[')
+				if out.Receivers == nil {
+					if !in.IsDelim(']') {
+						out.Receivers = make([]string, 0, 4)
+					} else {
+						out.Receivers = []string{}
+					}
+				} else {
+					out.Receivers = (out.Receivers)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v2 string
+					v2 = string(in.String())
+					out.Receivers = append(out.Receivers, v2)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func tinyjson50c1e60aEncodeGithubComJackalLabsBurrowContractsCw1SubkeysSrcTypes(out *jwriter.Writer, in IbcPermission) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"channels\":"
+		out.RawString(prefix[1:])
+		if in.Channels == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v3, v4 := range in.Channels {
+				if v3 > 0 {
+					out.RawByte(',')
+				}
+				out.String(string(v4))
+			}
+			out.RawByte(']')
+		}
+	}
+	if len(in.Receivers) != 0 {
+		const prefix string = ",\"receivers\":"
+		out.RawString(prefix)
+		{
+			out.RawByte('[')
+			for v5, v6 := range in.Receivers {
+				if v5 > 0 {
+					out.RawByte(',')
+				}
+				out.String(string(v6))
+			}
+			out.RawByte(']')
+		}
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v IbcPermission) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	tinyjson50c1e60aEncodeGithubComJackalLabsBurrowContractsCw1SubkeysSrcTypes(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalTinyJSON supports tinyjson.Marshaler interface
+func (v IbcPermission) MarshalTinyJSON(w *jwriter.Writer) {
+	tinyjson50c1e60aEncodeGithubComJackalLabsBurrowContractsCw1SubkeysSrcTypes(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *IbcPermission) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	tinyjson50c1e60aDecodeGithubComJackalLabsBurrowContractsCw1SubkeysSrcTypes(&r, v)
+	return r.Error()
+}
+
+// UnmarshalTinyJSON supports tinyjson.Unmarshaler interface
+func (v *IbcPermission) UnmarshalTinyJSON(l *jlexer.Lexer) {
+	tinyjson50c1e60aDecodeGithubComJackalLabsBurrowContractsCw1SubkeysSrcTypes(l, v)
+}
@@ -3,6 +3,7 @@ package types
 import (
 	"sort"
 
+	"github.com/CosmWasm/cosmwasm-go/std/math"
 	"github.com/CosmWasm/cosmwasm-go/std/types"
 	cw1WhiteListTypes "github.com/JackalLabs/burrow-contracts/cw1-whitelist/src/types"
 )
@@ -28,6 +29,91 @@ type ExecuteMsg struct {
 
 	// Setups up permissions for a given subkey.
 	SetPermissions *SetPermissions `json:"set_permissions,omitempty"`
+
+	/// Credits the attached coins to a given subkey's existing allowance, callable by anyone allowed to fund
+	FundAllowance *FundAllowance `json:"fund_allowance,omitempty"`
+
+	/// Limits who can fund allowances, must be called by an admin
+	SetTopUpConfig *TopUpConfig `json:"set_top_up_config,omitempty"`
+
+	/// Sets or clears the price oracle used by quote allowances, must be called by an admin
+	SetOracle *SetOracle `json:"set_oracle,omitempty"`
+
+	/// Limits a given subkey's combined native spend to an amount of the quote denom, replacing its
+	/// per-denom allowance for spending. A zero amount removes it
+	SetQuoteAllowance *SetQuoteAllowance `json:"set_quote_allowance,omitempty"`
+
+	/// Adds and removes denied recipients and validators, must be called by an admin.
+	/// Denied destinations are rejected for every subkey regardless of its grants
+	UpdateDenyList *UpdateDenyList `json:"update_deny_list,omitempty"`
+
+	/// Add a cw20 token allowance to a given subkey (subkey must not be admin)
+	IncreaseCw20Allowance *IncreaseCw20Allowance `json:"increase_cw20_allowance,omitempty"`
+
+	/// Decreases a cw20 token allowance for a given subkey (subkey must not be admin)
+	DecreaseCw20Allowance *DecreaseCw20Allowance `json:"decrease_cw20_allowance,omitempty"`
+
+	/// Adds allowances to many subkeys at once, must be called by an admin.
+	/// Either every entry applies or none does
+	BatchIncreaseAllowance *BatchIncreaseAllowance `json:"batch_increase_allowance,omitempty"`
+
+	/// Decreases allowances of many subkeys at once, must be called by an admin
+	BatchDecreaseAllowance *BatchDecreaseAllowance `json:"batch_decrease_allowance,omitempty"`
+
+	/// Sets up permissions for many subkeys at once, must be called by an admin
+	BatchSetPermissions *BatchSetPermissions `json:"batch_set_permissions,omitempty"`
+
+	/// Lets a given subkey send IBC transfers on approved channels, must be called by an admin.
+	/// An empty permission removes it
+	SetIbcPermission *SetIbcPermission `json:"set_ibc_permission,omitempty"`
+
+	/// Lets a given subkey send Stargate messages of the listed type URLs, must be called by an admin.
+	/// An empty allowlist removes it
+	SetStargateAllowlist *SetStargateAllowlist `json:"set_stargate_allowlist,omitempty"`
+
+	/// Attaches a spending policy to a given subkey, which must allow every message it executes.
+	/// Allowed messages need no other grant, but the permissions and allowances it holds are still debited.
+	/// An empty policy removes it
+	SetPolicy *SetPolicy `json:"set_policy,omitempty"`
+
+	/// Removes every grant of a given subkey and of every subkey it granted.
+	/// Must be called by an admin or by one of the subkey's grantors
+	Revoke *Revoke `json:"revoke,omitempty"`
+
+	/// Moves part of the sender's own allowance to a sub-spender, must be called by a subkey
+	GrantAllowance *GrantAllowance `json:"grant_allowance,omitempty"`
+
+	/// Gives a sub-spender some of the sender's own permissions, must be called by a subkey
+	GrantPermissions *GrantPermissions `json:"grant_permissions,omitempty"`
+
+	/// Files the messages for admin approval, used by subkeys to spend more than their allowance
+	RequestSpend *RequestSpend `json:"request_spend,omitempty"`
+
+	/// Dispatches the messages of a pending spend request, optionally topping up the subkey's allowance.
+	/// Must be called by an admin
+	ApproveSpend *ApproveSpend `json:"approve_spend,omitempty"`
+
+	/// Drops a pending spend request, must be called by an admin or by the subkey that filed it
+	RejectSpend *RejectSpend `json:"reject_spend,omitempty"`
+
+	/// Registers a contract to be notified of every subkey change, must be called by an admin
+	AddHook *AddHook `json:"add_hook,omitempty"`
+
+	/// Unregisters a hook contract, must be called by an admin
+	RemoveHook *RemoveHook `json:"remove_hook,omitempty"`
+
+	/// Heartbeat resets the inactivity timer, must be called by an existing admin
+	HeartbeatRequest *cw1WhiteListTypes.HeartbeatRequest `json:"heartbeat,omitempty"`
+
+	/// SetInheritance will change or clear the beneficiary, must be called by an existing admin,
+	/// and only works if the contract is mutable
+	SetInheritanceRequest *cw1WhiteListTypes.SetInheritanceRequest `json:"set_inheritance,omitempty"`
+
+	/// ClaimInheritance makes the beneficiary the only admin once the admins have been inactive long enough
+	ClaimInheritanceRequest *cw1WhiteListTypes.ClaimInheritanceRequest `json:"claim_inheritance,omitempty"`
+
+	/// SweepInheritance sends all the contract's funds to the beneficiary once the admins have been inactive long enough
+	SweepInheritanceRequest *cw1WhiteListTypes.SweepInheritanceRequest `json:"sweep_inheritance,omitempty"`
 }
 
 type QueryMsg struct {
@@ -42,6 +128,21 @@ type QueryMsg struct {
 	/// Get the current allowance for the given subkey (how much it can spend)
 	QueryAllowance *QueryAllowance `json:"allowance,omitempty"`
 
+	/// Shows who can fund allowances
+	QueryTopUpConfig *QueryTopUpConfig `json:"top_up_config,omitempty"`
+
+	/// Shows the price oracle configuration
+	QueryOracle *QueryOracle `json:"oracle,omitempty"`
+
+	/// Get the remaining quote allowance for the given subkey
+	QueryQuoteAllowance *QueryQuoteAllowance `json:"quote_allowance,omitempty"`
+
+	/// Shows the denied recipients and validators
+	QueryDenyList *QueryDenyList `json:"deny_list,omitempty"`
+
+	/// Get the current cw20 token allowances for the given subkey
+	QueryCw20Allowance *QueryCw20Allowance `json:"cw20_allowance,omitempty"`
+
 	/// Get the current permissions for the given subkey (how much it can spend)
 	QueryPermissions *QueryPermissions `json:"permissions,omitempty"`
 
@@ -50,6 +151,36 @@ type QueryMsg struct {
 
 	/// Gets all Permissions for this contract
 	QueryAllPermissions *QueryAllPermissions `json:"all_permissions,omitempty"`
+
+	/// Gets the allowances expiring below a block height, soonest first
+	QueryAllowancesExpiring *QueryAllowancesExpiring `json:"allowances_expiring,omitempty"`
+
+	/// Gets the allowances holding a denom
+	QueryAllowancesByDenom *QueryAllowancesByDenom `json:"allowances_by_denom,omitempty"`
+
+	/// Gets the permissions with a flag set, one of delegate, redelegate, undelegate, withdraw or vote
+	QueryPermissionsWith *QueryPermissionsWith `json:"permissions_with,omitempty"`
+
+	/// Get the spending policy for the given subkey
+	QueryPolicy *QueryPolicy `json:"policy,omitempty"`
+
+	/// Get the IBC transfer permission for the given subkey
+	QueryIbcPermission *QueryIbcPermission `json:"ibc_permission,omitempty"`
+
+	/// Get the Stargate type URL allowlist for the given subkey
+	QueryStargateAllowlist *QueryStargateAllowlist `json:"stargate_allowlist,omitempty"`
+
+	/// Get the grant tree below the given subkey
+	QueryGrants *QueryGrants `json:"grants,omitempty"`
+
+	/// Gets the spend requests waiting for approval, ordered by id
+	QuerySpendRequests *QuerySpendRequests `json:"spend_requests,omitempty"`
+
+	/// Gets all hook contracts notified of subkey changes
+	QueryHooks *QueryHooks `json:"hooks,omitempty"`
+
+	/// Shows the beneficiary, the last admin activity and whether the inheritance can be claimed
+	QueryInheritanceRequest *cw1WhiteListTypes.QueryInheritanceRequest `json:"inheritance,omitempty"`
 }
 
 // Requests
@@ -71,24 +202,163 @@ type DecreaseAllowance struct {
 	Amount  types.Coin
 	Expires Expiration
 }
+type FundAllowance struct {
+	Spender string `json:"spender"`
+}
+
+type SetOracle struct {
+	Config *OracleConfig `json:"config,omitempty"`
+}
+
+type SetQuoteAllowance struct {
+	Spender string       `json:"spender"`
+	Amount  math.Uint128 `json:"amount"`
+	Expires Expiration   `json:"expires"`
+}
+
+type IncreaseCw20Allowance struct {
+	Spender string       `json:"spender"`
+	Token   string       `json:"token"`
+	Amount  math.Uint128 `json:"amount"`
+	Expires Expiration   `json:"expires"`
+}
+
+type DecreaseCw20Allowance struct {
+	Spender string       `json:"spender"`
+	Token   string       `json:"token"`
+	Amount  math.Uint128 `json:"amount"`
+	Expires Expiration   `json:"expires"`
+}
+
 type SetPermissions struct {
 	Spender     string
 	Permissions Permissions
 }
 
+type BatchIncreaseAllowance struct {
+	Allowances []IncreaseAllowance `json:"allowances"`
+}
+
+type BatchDecreaseAllowance struct {
+	Allowances []DecreaseAllowance `json:"allowances"`
+}
+
+type BatchSetPermissions struct {
+	Permissions []SetPermissions `json:"permissions"`
+}
+
+type SetIbcPermission struct {
+	Spender    string         `json:"spender"`
+	Permission *IbcPermission `json:"permission,omitempty"`
+}
+
+type SetStargateAllowlist struct {
+	Spender   string             `json:"spender"`
+	Allowlist *StargateAllowlist `json:"allowlist,omitempty"`
+}
+
+type SetPolicy struct {
+	Spender string  `json:"spender"`
+	Policy  *Policy `json:"policy,omitempty"`
+}
+
+type Revoke struct {
+	Spender string `json:"spender"`
+}
+
+type GrantAllowance struct {
+	Spender string     `json:"spender"`
+	Amount  types.Coin `json:"amount"`
+	Expires Expiration `json:"expires"`
+}
+
+type GrantPermissions struct {
+	Spender     string      `json:"spender"`
+	Permissions Permissions `json:"permissions"`
+}
+
+type RequestSpend struct {
+	Msgs    []types.CosmosMsg `json:"msgs"`
+	Expires Expiration        `json:"expires"`
+}
+
+type ApproveSpend struct {
+	ID    uint64       `json:"id"`
+	TopUp []types.Coin `json:"top_up,omitempty"`
+}
+
+type RejectSpend struct {
+	ID uint64 `json:"id"`
+}
+
+type AddHook struct {
+	Addr string `json:"addr"`
+}
+
+type RemoveHook struct {
+	Addr string `json:"addr"`
+}
+
 type QueryCanExecuteRequest struct {
 	Sender string          `json:"sender,omitempty"`
 	Msg    types.CosmosMsg `json:"msg,omitempty"`
 }
 
+type UpdateDenyList struct {
+	AddRecipients    []string `json:"add_recipients,omitempty"`
+	RemoveRecipients []string `json:"remove_recipients,omitempty"`
+	AddValidators    []string `json:"add_validators,omitempty"`
+	RemoveValidators []string `json:"remove_validators,omitempty"`
+}
+
 type QueryAllowance struct {
 	Spender string `json:"spender,omitempty"`
+	/// Height returns the allowance as it was at the start of that block, before its changes.
+	/// Zero returns the current allowance
+	Height uint64 `json:"height,omitempty"`
+}
+
+type QueryTopUpConfig struct{}
+
+type QueryOracle struct{}
+
+type QueryDenyList struct{}
+
+type QueryQuoteAllowance struct {
+	Spender string `json:"spender,omitempty"`
+}
+
+type QueryCw20Allowance struct {
+	Spender string `json:"spender,omitempty"`
 }
 
 type QueryPermissions struct {
 	Spender string `json:"spender,omitempty"`
 }
 
+type QueryPolicy struct {
+	Spender string `json:"spender,omitempty"`
+}
+
+type QueryIbcPermission struct {
+	Spender string `json:"spender,omitempty"`
+}
+
+type QueryStargateAllowlist struct {
+	Spender string `json:"spender,omitempty"`
+}
+
+type QueryGrants struct {
+	Spender string `json:"spender,omitempty"`
+}
+
+type QueryHooks struct{}
+
+type QuerySpendRequests struct {
+	StartAfter uint64 `json:"start_after,omitempty"`
+	Limit      uint32 `json:"limit,omitempty"`
+}
+
 type QueryAllAllowance struct {
 	StartAfter string `json:"start_after,omitempty"`
 	Limit      uint32 `json:"limit,omitempty"`
@@ -99,6 +369,24 @@ type QueryAllPermissions struct {
 	Limit      uint32 `json:"limit,omitempty"`
 }
 
+type QueryAllowancesExpiring struct {
+	BeforeHeight uint64 `json:"before_height"`
+	StartAfter   string `json:"start_after,omitempty"`
+	Limit        uint32 `json:"limit,omitempty"`
+}
+
+type QueryAllowancesByDenom struct {
+	Denom      string `json:"denom"`
+	StartAfter string `json:"start_after,omitempty"`
+	Limit      uint32 `json:"limit,omitempty"`
+}
+
+type QueryPermissionsWith struct {
+	Permission string `json:"permission"`
+	StartAfter string `json:"start_after,omitempty"`
+	Limit      uint32 `json:"limit,omitempty"`
+}
+
 // Responses
 type AdminListResponse struct {
 	Admins  []string `json:"admins"`
@@ -107,6 +395,45 @@ type AdminListResponse struct {
 
 type CanExecuteResponse struct {
 	CanExecute bool `json:"can_execute"`
+	/// Rule is the policy rule that decided, if the sender has a policy
+	Rule string `json:"rule,omitempty"`
+}
+
+// GrantNode is a subkey with its own grants and the subkeys it granted
+type GrantNode struct {
+	Spender     string       `json:"spender"`
+	Allowance   *Allowances  `json:"allowance,omitempty"`
+	Permissions *Permissions `json:"permissions,omitempty"`
+	Children    []GrantNode  `json:"children"`
+}
+
+type GrantsResponse struct {
+	Parent string    `json:"parent,omitempty"`
+	Tree   GrantNode `json:"tree"`
+}
+
+type SpendRequestsResponse struct {
+	Requests []SpendRequest `json:"requests"`
+}
+
+type HooksResponse struct {
+	Hooks []string `json:"hooks"`
+}
+
+type IbcPermissionResponse struct {
+	Permission *IbcPermission `json:"permission,omitempty"`
+}
+
+type StargateAllowlistResponse struct {
+	Allowlist *StargateAllowlist `json:"allowlist,omitempty"`
+}
+
+type OracleResponse struct {
+	Config *OracleConfig `json:"config,omitempty"`
+}
+
+type PolicyResponse struct {
+	Policy *Policy `json:"policy,omitempty"`
 }
 
 // / -Allowance
@@ -114,16 +441,20 @@ type AllAllowancesResponse struct {
 	Allowances []AllowanceInfo `json:"allowances"`
 }
 
-func (r AllAllowancesResponse) Canonical() AllAllowancesResponse {
+func (r AllAllowancesResponse) Canonical() (AllAllowancesResponse, error) {
 	for i := range r.Allowances {
-		r.Allowances[i] = r.Allowances[i].Canonical()
+		var err error
+		r.Allowances[i], err = r.Allowances[i].Canonical()
+		if err != nil {
+			return AllAllowancesResponse{}, err
+		}
 	}
 
 	sort.Slice(r.Allowances, func(i, j int) bool {
 		return r.Allowances[i].CmpBySpender(r.Allowances[j])
 	})
 
-	return r
+	return r, nil
 }
 
 type AllowanceInfo struct {
@@ -136,9 +467,9 @@ func (i AllowanceInfo) CmpBySpender(other AllowanceInfo) bool {
 	return i.Spender < other.Spender
 }
 
-func (i AllowanceInfo) Canonical() AllowanceInfo {
-	i.Balance.Normalize()
-	return i
+func (i AllowanceInfo) Canonical() (AllowanceInfo, error) {
+	err := i.Balance.Normalize()
+	return i, err
 }
 
 // / -Permission
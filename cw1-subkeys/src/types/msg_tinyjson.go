@@ -3,11 +3,11 @@
 package types
 
 import (
-	types1 "github.com/CosmWasm/cosmwasm-go/std/types"
+	types "github.com/CosmWasm/cosmwasm-go/std/types"
 	tinyjson "github.com/CosmWasm/tinyjson"
 	jlexer "github.com/CosmWasm/tinyjson/jlexer"
 	jwriter "github.com/CosmWasm/tinyjson/jwriter"
-	types "github.com/JackalLabs/burrow-contracts/cw1-whitelist/src/types"
+	types1 "github.com/JackalLabs/burrow-contracts/cw1-whitelist/src/types"
 )
 
 // suppress unused package warning
@@ -17,7 +17,7 @@ var (
 	_ tinyjson.Marshaler
 )
 
-func tinyjsonF5cd6cf9DecodeGithubComJackalLabsBurrowContractsCw1SubkeysSrcTypes(in *jlexer.Lexer, out *UpdateAdminsRequest) {
+func tinyjsonF5cd6cf9DecodeGithubComJackalLabsBurrowContractsCw1SubkeysSrcTypes(in *jlexer.Lexer, out *UpdateDenyList) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -36,25 +36,94 @@ func tinyjsonF5cd6cf9DecodeGithubComJackalLabsBurrowContractsCw1SubkeysSrcTypes(
 			continue
 		}
 		switch key {
-		case "admins":
+		case "add_recipients":
 			if in.IsNull() {
 				in.Skip()
-				out.Admins = nil
+				out.AddRecipients = nil
 			} else {
 				in.Delim('[')
-				if out.Admins == nil {
+				if out.AddRecipients == nil {
 					if !in.IsDelim(']') {
-						out.Admins = make([]string, 0, 4)
+						out.AddRecipients = make([]string, 0, 4)
 					} else {
-						out.Admins = []string{}
+						out.AddRecipients = []string{}
 					}
 				} else {
-					out.Admins = (out.Admins)[:0]
+					out.AddRecipients = (out.AddRecipients)[:0]
 				}
 				for !in.IsDelim(']') {
 					var v1 string
 					v1 = string(in.String())
-					out.Admins = append(out.Admins, v1)
+					out.AddRecipients = append(out.AddRecipients, v1)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "remove_recipients":
+			if in.IsNull() {
+				in.Skip()
+				out.RemoveRecipients = nil
+			} else {
+				in.Delim('[')
+				if out.RemoveRecipients == nil {
+					if !in.IsDelim(']') {
+						out.RemoveRecipients = make([]string, 0, 4)
+					} else {
+						out.RemoveRecipients = []string{}
+					}
+				} else {
+					out.RemoveRecipients = (out.RemoveRecipients)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v2 string
+					v2 = string(in.String())
+					out.RemoveRecipients = append(out.RemoveRecipients, v2)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "add_validators":
+			if in.IsNull() {
+				in.Skip()
+				out.AddValidators = nil
+			} else {
+				in.Delim('[')
+				if out.AddValidators == nil {
+					if !in.IsDelim(']') {
+						out.AddValidators = make([]string, 0, 4)
+					} else {
+						out.AddValidators = []string{}
+					}
+				} else {
+					out.AddValidators = (out.AddValidators)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v3 string
+					v3 = string(in.String())
+					out.AddValidators = append(out.AddValidators, v3)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "remove_validators":
+			if in.IsNull() {
+				in.Skip()
+				out.RemoveValidators = nil
+			} else {
+				in.Delim('[')
+				if out.RemoveValidators == nil {
+					if !in.IsDelim(']') {
+						out.RemoveValidators = make([]string, 0, 4)
+					} else {
+						out.RemoveValidators = []string{}
+					}
+				} else {
+					out.RemoveValidators = (out.RemoveValidators)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v4 string
+					v4 = string(in.String())
+					out.RemoveValidators = append(out.RemoveValidators, v4)
 					in.WantComma()
 				}
 				in.Delim(']')
@@ -69,21 +138,78 @@ func tinyjsonF5cd6cf9DecodeGithubComJackalLabsBurrowContractsCw1SubkeysSrcTypes(
 		in.Consumed()
 	}
 }
-func tinyjsonF5cd6cf9EncodeGithubComJackalLabsBurrowContractsCw1SubkeysSrcTypes(out *jwriter.Writer, in UpdateAdminsRequest) {
+func tinyjsonF5cd6cf9EncodeGithubComJackalLabsBurrowContractsCw1SubkeysSrcTypes(out *jwriter.Writer, in UpdateDenyList) {
 	out.RawByte('{')
 	first := true
 	_ = first
-	if len(in.Admins) != 0 {
-		const prefix string = ",\"admins\":"
+	if len(in.AddRecipients) != 0 {
+		const prefix string = ",\"add_recipients\":"
 		first = false
 		out.RawString(prefix[1:])
 		{
 			out.RawByte('[')
-			for v2, v3 := range in.Admins {
-				if v2 > 0 {
+			for v5, v6 := range in.AddRecipients {
+				if v5 > 0 {
+					out.RawByte(',')
+				}
+				out.String(string(v6))
+			}
+			out.RawByte(']')
+		}
+	}
+	if len(in.RemoveRecipients) != 0 {
+		const prefix string = ",\"remove_recipients\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		{
+			out.RawByte('[')
+			for v7, v8 := range in.RemoveRecipients {
+				if v7 > 0 {
+					out.RawByte(',')
+				}
+				out.String(string(v8))
+			}
+			out.RawByte(']')
+		}
+	}
+	if len(in.AddValidators) != 0 {
+		const prefix string = ",\"add_validators\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		{
+			out.RawByte('[')
+			for v9, v10 := range in.AddValidators {
+				if v9 > 0 {
+					out.RawByte(',')
+				}
+				out.String(string(v10))
+			}
+			out.RawByte(']')
+		}
+	}
+	if len(in.RemoveValidators) != 0 {
+		const prefix string = ",\"remove_validators\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		{
+			out.RawByte('[')
+			for v11, v12 := range in.RemoveValidators {
+				if v11 > 0 {
 					out.RawByte(',')
 				}
-				out.String(string(v3))
+				out.String(string(v12))
 			}
 			out.RawByte(']')
 		}
@@ -92,29 +218,29 @@ func tinyjsonF5cd6cf9EncodeGithubComJackalLabsBurrowContractsCw1SubkeysSrcTypes(
 }
 
 // MarshalJSON supports json.Marshaler interface
-func (v UpdateAdminsRequest) MarshalJSON() ([]byte, error) {
+func (v UpdateDenyList) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
 	tinyjsonF5cd6cf9EncodeGithubComJackalLabsBurrowContractsCw1SubkeysSrcTypes(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalTinyJSON supports tinyjson.Marshaler interface
-func (v UpdateAdminsRequest) MarshalTinyJSON(w *jwriter.Writer) {
+func (v UpdateDenyList) MarshalTinyJSON(w *jwriter.Writer) {
 	tinyjsonF5cd6cf9EncodeGithubComJackalLabsBurrowContractsCw1SubkeysSrcTypes(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
-func (v *UpdateAdminsRequest) UnmarshalJSON(data []byte) error {
+func (v *UpdateDenyList) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
 	tinyjsonF5cd6cf9DecodeGithubComJackalLabsBurrowContractsCw1SubkeysSrcTypes(&r, v)
 	return r.Error()
 }
 
 // UnmarshalTinyJSON supports tinyjson.Unmarshaler interface
-func (v *UpdateAdminsRequest) UnmarshalTinyJSON(l *jlexer.Lexer) {
+func (v *UpdateDenyList) UnmarshalTinyJSON(l *jlexer.Lexer) {
 	tinyjsonF5cd6cf9DecodeGithubComJackalLabsBurrowContractsCw1SubkeysSrcTypes(l, v)
 }
-func tinyjsonF5cd6cf9DecodeGithubComJackalLabsBurrowContractsCw1SubkeysSrcTypes1(in *jlexer.Lexer, out *SetPermissions) {
+func tinyjsonF5cd6cf9DecodeGithubComJackalLabsBurrowContractsCw1SubkeysSrcTypes1(in *jlexer.Lexer, out *UpdateAdminsRequest) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -133,10 +259,29 @@ func tinyjsonF5cd6cf9DecodeGithubComJackalLabsBurrowContractsCw1SubkeysSrcTypes1
 			continue
 		}
 		switch key {
-		case "spender":
-			out.Spender = string(in.String())
-		case "permissions":
-			tinyjsonF5cd6cf9DecodeGithubComJackalLabsBurrowContractsCw1SubkeysSrcTypes2(in, &out.Permissions)
+		case "admins":
+			if in.IsNull() {
+				in.Skip()
+				out.Admins = nil
+			} else {
+				in.Delim('[')
+				if out.Admins == nil {
+					if !in.IsDelim(']') {
+						out.Admins = make([]string, 0, 4)
+					} else {
+						out.Admins = []string{}
+					}
+				} else {
+					out.Admins = (out.Admins)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v13 string
+					v13 = string(in.String())
+					out.Admins = append(out.Admins, v13)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
 		default:
 			in.SkipRecursive()
 		}
@@ -147,47 +292,52 @@ func tinyjsonF5cd6cf9DecodeGithubComJackalLabsBurrowContractsCw1SubkeysSrcTypes1
 		in.Consumed()
 	}
 }
-func tinyjsonF5cd6cf9EncodeGithubComJackalLabsBurrowContractsCw1SubkeysSrcTypes1(out *jwriter.Writer, in SetPermissions) {
+func tinyjsonF5cd6cf9EncodeGithubComJackalLabsBurrowContractsCw1SubkeysSrcTypes1(out *jwriter.Writer, in UpdateAdminsRequest) {
 	out.RawByte('{')
 	first := true
 	_ = first
-	{
-		const prefix string = ",\"spender\":"
+	if len(in.Admins) != 0 {
+		const prefix string = ",\"admins\":"
+		first = false
 		out.RawString(prefix[1:])
-		out.String(string(in.Spender))
-	}
-	{
-		const prefix string = ",\"permissions\":"
-		out.RawString(prefix)
-		tinyjsonF5cd6cf9EncodeGithubComJackalLabsBurrowContractsCw1SubkeysSrcTypes2(out, in.Permissions)
+		{
+			out.RawByte('[')
+			for v14, v15 := range in.Admins {
+				if v14 > 0 {
+					out.RawByte(',')
+				}
+				out.String(string(v15))
+			}
+			out.RawByte(']')
+		}
 	}
 	out.RawByte('}')
 }
 
 // MarshalJSON supports json.Marshaler interface
-func (v SetPermissions) MarshalJSON() ([]byte, error) {
+func (v UpdateAdminsRequest) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
 	tinyjsonF5cd6cf9EncodeGithubComJackalLabsBurrowContractsCw1SubkeysSrcTypes1(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalTinyJSON supports tinyjson.Marshaler interface
-func (v SetPermissions) MarshalTinyJSON(w *jwriter.Writer) {
+func (v UpdateAdminsRequest) MarshalTinyJSON(w *jwriter.Writer) {
 	tinyjsonF5cd6cf9EncodeGithubComJackalLabsBurrowContractsCw1SubkeysSrcTypes1(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
-func (v *SetPermissions) UnmarshalJSON(data []byte) error {
+func (v *UpdateAdminsRequest) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
 	tinyjsonF5cd6cf9DecodeGithubComJackalLabsBurrowContractsCw1SubkeysSrcTypes1(&r, v)
 	return r.Error()
 }
 
 // UnmarshalTinyJSON supports tinyjson.Unmarshaler interface
-func (v *SetPermissions) UnmarshalTinyJSON(l *jlexer.Lexer) {
+func (v *UpdateAdminsRequest) UnmarshalTinyJSON(l *jlexer.Lexer) {
 	tinyjsonF5cd6cf9DecodeGithubComJackalLabsBurrowContractsCw1SubkeysSrcTypes1(l, v)
 }
-func tinyjsonF5cd6cf9DecodeGithubComJackalLabsBurrowContractsCw1SubkeysSrcTypes2(in *jlexer.Lexer, out *Permissions) {
+func tinyjsonF5cd6cf9DecodeGithubComJackalLabsBurrowContractsCw1SubkeysSrcTypes2(in *jlexer.Lexer, out *StargateAllowlistResponse) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -206,14 +356,16 @@ func tinyjsonF5cd6cf9DecodeGithubComJackalLabsBurrowContractsCw1SubkeysSrcTypes2
 			continue
 		}
 		switch key {
-		case "delegate":
-			out.Delegate = bool(in.Bool())
-		case "redelegate":
-			out.Redelegate = bool(in.Bool())
-		case "undelegate":
-			out.Undelegate = bool(in.Bool())
-		case "withdraw":
-			out.Withdraw = bool(in.Bool())
+		case "allowlist":
+			if in.IsNull() {
+				in.Skip()
+				out.Allowlist = nil
+			} else {
+				if out.Allowlist == nil {
+					out.Allowlist = new(StargateAllowlist)
+				}
+				(*out.Allowlist).UnmarshalTinyJSON(in)
+			}
 		default:
 			in.SkipRecursive()
 		}
@@ -224,33 +376,43 @@ func tinyjsonF5cd6cf9DecodeGithubComJackalLabsBurrowContractsCw1SubkeysSrcTypes2
 		in.Consumed()
 	}
 }
-func tinyjsonF5cd6cf9EncodeGithubComJackalLabsBurrowContractsCw1SubkeysSrcTypes2(out *jwriter.Writer, in Permissions) {
+func tinyjsonF5cd6cf9EncodeGithubComJackalLabsBurrowContractsCw1SubkeysSrcTypes2(out *jwriter.Writer, in StargateAllowlistResponse) {
 	out.RawByte('{')
 	first := true
 	_ = first
-	{
-		const prefix string = ",\"delegate\":"
+	if in.Allowlist != nil {
+		const prefix string = ",\"allowlist\":"
+		first = false
 		out.RawString(prefix[1:])
-		out.Bool(bool(in.Delegate))
-	}
-	{
-		const prefix string = ",\"redelegate\":"
-		out.RawString(prefix)
-		out.Bool(bool(in.Redelegate))
-	}
-	{
-		const prefix string = ",\"undelegate\":"
-		out.RawString(prefix)
-		out.Bool(bool(in.Undelegate))
-	}
-	{
-		const prefix string = ",\"withdraw\":"
-		out.RawString(prefix)
-		out.Bool(bool(in.Withdraw))
+		(*in.Allowlist).MarshalTinyJSON(out)
 	}
 	out.RawByte('}')
 }
-func tinyjsonF5cd6cf9DecodeGithubComJackalLabsBurrowContractsCw1SubkeysSrcTypes3(in *jlexer.Lexer, out *QueryPermissions) {
+
+// MarshalJSON supports json.Marshaler interface
+func (v StargateAllowlistResponse) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	tinyjsonF5cd6cf9EncodeGithubComJackalLabsBurrowContractsCw1SubkeysSrcTypes2(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalTinyJSON supports tinyjson.Marshaler interface
+func (v StargateAllowlistResponse) MarshalTinyJSON(w *jwriter.Writer) {
+	tinyjsonF5cd6cf9EncodeGithubComJackalLabsBurrowContractsCw1SubkeysSrcTypes2(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *StargateAllowlistResponse) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	tinyjsonF5cd6cf9DecodeGithubComJackalLabsBurrowContractsCw1SubkeysSrcTypes2(&r, v)
+	return r.Error()
+}
+
+// UnmarshalTinyJSON supports tinyjson.Unmarshaler interface
+func (v *StargateAllowlistResponse) UnmarshalTinyJSON(l *jlexer.Lexer) {
+	tinyjsonF5cd6cf9DecodeGithubComJackalLabsBurrowContractsCw1SubkeysSrcTypes2(l, v)
+}
+func tinyjsonF5cd6cf9DecodeGithubComJackalLabsBurrowContractsCw1SubkeysSrcTypes3(in *jlexer.Lexer, out *SpendRequestsResponse) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -269,8 +431,29 @@ func tinyjsonF5cd6cf9DecodeGithubComJackalLabsBurrowContractsCw1SubkeysSrcTypes3
 			continue
 		}
 		switch key {
-		case "spender":
-			out.Spender = string(in.String())
+		case "requests":
+			if in.IsNull() {
+				in.Skip()
+				out.Requests = nil
+			} else {
+				in.Delim('[')
+				if out.Requests == nil {
+					if !in.IsDelim(']') {
+						out.Requests = make([]SpendRequest, 0, 0)
+					} else {
+						out.Requests = []SpendRequest{}
+					}
+				} else {
+					out.Requests = (out.Requests)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v16 SpendRequest
+					(v16).UnmarshalTinyJSON(in)
+					out.Requests = append(out.Requests, v16)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
 		default:
 			in.SkipRecursive()
 		}
@@ -281,43 +464,53 @@ func tinyjsonF5cd6cf9DecodeGithubComJackalLabsBurrowContractsCw1SubkeysSrcTypes3
 		in.Consumed()
 	}
 }
-func tinyjsonF5cd6cf9EncodeGithubComJackalLabsBurrowContractsCw1SubkeysSrcTypes3(out *jwriter.Writer, in QueryPermissions) {
+func tinyjsonF5cd6cf9EncodeGithubComJackalLabsBurrowContractsCw1SubkeysSrcTypes3(out *jwriter.Writer, in SpendRequestsResponse) {
 	out.RawByte('{')
 	first := true
 	_ = first
-	if in.Spender != "" {
-		const prefix string = ",\"spender\":"
-		first = false
+	{
+		const prefix string = ",\"requests\":"
 		out.RawString(prefix[1:])
-		out.String(string(in.Spender))
+		if in.Requests == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v17, v18 := range in.Requests {
+				if v17 > 0 {
+					out.RawByte(',')
+				}
+				(v18).MarshalTinyJSON(out)
+			}
+			out.RawByte(']')
+		}
 	}
 	out.RawByte('}')
 }
 
 // MarshalJSON supports json.Marshaler interface
-func (v QueryPermissions) MarshalJSON() ([]byte, error) {
+func (v SpendRequestsResponse) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
 	tinyjsonF5cd6cf9EncodeGithubComJackalLabsBurrowContractsCw1SubkeysSrcTypes3(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalTinyJSON supports tinyjson.Marshaler interface
-func (v QueryPermissions) MarshalTinyJSON(w *jwriter.Writer) {
+func (v SpendRequestsResponse) MarshalTinyJSON(w *jwriter.Writer) {
 	tinyjsonF5cd6cf9EncodeGithubComJackalLabsBurrowContractsCw1SubkeysSrcTypes3(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
-func (v *QueryPermissions) UnmarshalJSON(data []byte) error {
+func (v *SpendRequestsResponse) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
 	tinyjsonF5cd6cf9DecodeGithubComJackalLabsBurrowContractsCw1SubkeysSrcTypes3(&r, v)
 	return r.Error()
 }
 
 // UnmarshalTinyJSON supports tinyjson.Unmarshaler interface
-func (v *QueryPermissions) UnmarshalTinyJSON(l *jlexer.Lexer) {
+func (v *SpendRequestsResponse) UnmarshalTinyJSON(l *jlexer.Lexer) {
 	tinyjsonF5cd6cf9DecodeGithubComJackalLabsBurrowContractsCw1SubkeysSrcTypes3(l, v)
 }
-func tinyjsonF5cd6cf9DecodeGithubComJackalLabsBurrowContractsCw1SubkeysSrcTypes4(in *jlexer.Lexer, out *QueryMsg) {
+func tinyjsonF5cd6cf9DecodeGithubComJackalLabsBurrowContractsCw1SubkeysSrcTypes4(in *jlexer.Lexer, out *SetStargateAllowlist) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -336,65 +529,17 @@ func tinyjsonF5cd6cf9DecodeGithubComJackalLabsBurrowContractsCw1SubkeysSrcTypes4
 			continue
 		}
 		switch key {
-		case "admin_list":
-			if in.IsNull() {
-				in.Skip()
-				out.QueryAdminListRequest = nil
-			} else {
-				if out.QueryAdminListRequest == nil {
-					out.QueryAdminListRequest = new(types.QueryAdminListRequest)
-				}
-				(*out.QueryAdminListRequest).UnmarshalTinyJSON(in)
-			}
-		case "can_execute":
-			if in.IsNull() {
-				in.Skip()
-				out.QueryCanExecuteRequest = nil
-			} else {
-				if out.QueryCanExecuteRequest == nil {
-					out.QueryCanExecuteRequest = new(QueryCanExecuteRequest)
-				}
-				(*out.QueryCanExecuteRequest).UnmarshalTinyJSON(in)
-			}
-		case "allowance":
-			if in.IsNull() {
-				in.Skip()
-				out.QueryAllowance = nil
-			} else {
-				if out.QueryAllowance == nil {
-					out.QueryAllowance = new(QueryAllowance)
-				}
-				(*out.QueryAllowance).UnmarshalTinyJSON(in)
-			}
-		case "permissions":
+		case "spender":
+			out.Spender = string(in.String())
+		case "allowlist":
 			if in.IsNull() {
 				in.Skip()
-				out.QueryPermissions = nil
+				out.Allowlist = nil
 			} else {
-				if out.QueryPermissions == nil {
-					out.QueryPermissions = new(QueryPermissions)
+				if out.Allowlist == nil {
+					out.Allowlist = new(StargateAllowlist)
 				}
-				(*out.QueryPermissions).UnmarshalTinyJSON(in)
-			}
-		case "all_allowance":
-			if in.IsNull() {
-				in.Skip()
-				out.QueryAllAllowance = nil
-			} else {
-				if out.QueryAllAllowance == nil {
-					out.QueryAllAllowance = new(QueryAllAllowance)
-				}
-				(*out.QueryAllAllowance).UnmarshalTinyJSON(in)
-			}
-		case "all_permissions":
-			if in.IsNull() {
-				in.Skip()
-				out.QueryAllPermissions = nil
-			} else {
-				if out.QueryAllPermissions == nil {
-					out.QueryAllPermissions = new(QueryAllPermissions)
-				}
-				(*out.QueryAllPermissions).UnmarshalTinyJSON(in)
+				(*out.Allowlist).UnmarshalTinyJSON(in)
 			}
 		default:
 			in.SkipRecursive()
@@ -406,93 +551,47 @@ func tinyjsonF5cd6cf9DecodeGithubComJackalLabsBurrowContractsCw1SubkeysSrcTypes4
 		in.Consumed()
 	}
 }
-func tinyjsonF5cd6cf9EncodeGithubComJackalLabsBurrowContractsCw1SubkeysSrcTypes4(out *jwriter.Writer, in QueryMsg) {
+func tinyjsonF5cd6cf9EncodeGithubComJackalLabsBurrowContractsCw1SubkeysSrcTypes4(out *jwriter.Writer, in SetStargateAllowlist) {
 	out.RawByte('{')
 	first := true
 	_ = first
-	if in.QueryAdminListRequest != nil {
-		const prefix string = ",\"admin_list\":"
-		first = false
+	{
+		const prefix string = ",\"spender\":"
 		out.RawString(prefix[1:])
-		(*in.QueryAdminListRequest).MarshalTinyJSON(out)
-	}
-	if in.QueryCanExecuteRequest != nil {
-		const prefix string = ",\"can_execute\":"
-		if first {
-			first = false
-			out.RawString(prefix[1:])
-		} else {
-			out.RawString(prefix)
-		}
-		(*in.QueryCanExecuteRequest).MarshalTinyJSON(out)
-	}
-	if in.QueryAllowance != nil {
-		const prefix string = ",\"allowance\":"
-		if first {
-			first = false
-			out.RawString(prefix[1:])
-		} else {
-			out.RawString(prefix)
-		}
-		(*in.QueryAllowance).MarshalTinyJSON(out)
-	}
-	if in.QueryPermissions != nil {
-		const prefix string = ",\"permissions\":"
-		if first {
-			first = false
-			out.RawString(prefix[1:])
-		} else {
-			out.RawString(prefix)
-		}
-		(*in.QueryPermissions).MarshalTinyJSON(out)
-	}
-	if in.QueryAllAllowance != nil {
-		const prefix string = ",\"all_allowance\":"
-		if first {
-			first = false
-			out.RawString(prefix[1:])
-		} else {
-			out.RawString(prefix)
-		}
-		(*in.QueryAllAllowance).MarshalTinyJSON(out)
+		out.String(string(in.Spender))
 	}
-	if in.QueryAllPermissions != nil {
-		const prefix string = ",\"all_permissions\":"
-		if first {
-			first = false
-			out.RawString(prefix[1:])
-		} else {
-			out.RawString(prefix)
-		}
-		(*in.QueryAllPermissions).MarshalTinyJSON(out)
+	if in.Allowlist != nil {
+		const prefix string = ",\"allowlist\":"
+		out.RawString(prefix)
+		(*in.Allowlist).MarshalTinyJSON(out)
 	}
 	out.RawByte('}')
 }
 
 // MarshalJSON supports json.Marshaler interface
-func (v QueryMsg) MarshalJSON() ([]byte, error) {
+func (v SetStargateAllowlist) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
 	tinyjsonF5cd6cf9EncodeGithubComJackalLabsBurrowContractsCw1SubkeysSrcTypes4(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalTinyJSON supports tinyjson.Marshaler interface
-func (v QueryMsg) MarshalTinyJSON(w *jwriter.Writer) {
+func (v SetStargateAllowlist) MarshalTinyJSON(w *jwriter.Writer) {
 	tinyjsonF5cd6cf9EncodeGithubComJackalLabsBurrowContractsCw1SubkeysSrcTypes4(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
-func (v *QueryMsg) UnmarshalJSON(data []byte) error {
+func (v *SetStargateAllowlist) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
 	tinyjsonF5cd6cf9DecodeGithubComJackalLabsBurrowContractsCw1SubkeysSrcTypes4(&r, v)
 	return r.Error()
 }
 
 // UnmarshalTinyJSON supports tinyjson.Unmarshaler interface
-func (v *QueryMsg) UnmarshalTinyJSON(l *jlexer.Lexer) {
+func (v *SetStargateAllowlist) UnmarshalTinyJSON(l *jlexer.Lexer) {
 	tinyjsonF5cd6cf9DecodeGithubComJackalLabsBurrowContractsCw1SubkeysSrcTypes4(l, v)
 }
-func tinyjsonF5cd6cf9DecodeGithubComJackalLabsBurrowContractsCw1SubkeysSrcTypes5(in *jlexer.Lexer, out *QueryCanExecuteRequest) {
+func tinyjsonF5cd6cf9DecodeGithubComJackalLabsBurrowContractsCw1SubkeysSrcTypes5(in *jlexer.Lexer, out *SetQuoteAllowance) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -511,10 +610,14 @@ func tinyjsonF5cd6cf9DecodeGithubComJackalLabsBurrowContractsCw1SubkeysSrcTypes5
 			continue
 		}
 		switch key {
-		case "sender":
-			out.Sender = string(in.String())
-		case "msg":
-			(out.Msg).UnmarshalTinyJSON(in)
+		case "spender":
+			out.Spender = string(in.String())
+		case "amount":
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.Amount).UnmarshalJSON(data))
+			}
+		case "expires":
+			(out.Expires).UnmarshalTinyJSON(in)
 		default:
 			in.SkipRecursive()
 		}
@@ -525,53 +628,52 @@ func tinyjsonF5cd6cf9DecodeGithubComJackalLabsBurrowContractsCw1SubkeysSrcTypes5
 		in.Consumed()
 	}
 }
-func tinyjsonF5cd6cf9EncodeGithubComJackalLabsBurrowContractsCw1SubkeysSrcTypes5(out *jwriter.Writer, in QueryCanExecuteRequest) {
+func tinyjsonF5cd6cf9EncodeGithubComJackalLabsBurrowContractsCw1SubkeysSrcTypes5(out *jwriter.Writer, in SetQuoteAllowance) {
 	out.RawByte('{')
 	first := true
 	_ = first
-	if in.Sender != "" {
-		const prefix string = ",\"sender\":"
-		first = false
+	{
+		const prefix string = ",\"spender\":"
 		out.RawString(prefix[1:])
-		out.String(string(in.Sender))
+		out.String(string(in.Spender))
 	}
-	if true {
-		const prefix string = ",\"msg\":"
-		if first {
-			first = false
-			out.RawString(prefix[1:])
-		} else {
-			out.RawString(prefix)
-		}
-		(in.Msg).MarshalTinyJSON(out)
+	{
+		const prefix string = ",\"amount\":"
+		out.RawString(prefix)
+		out.Raw((in.Amount).MarshalJSON())
+	}
+	{
+		const prefix string = ",\"expires\":"
+		out.RawString(prefix)
+		(in.Expires).MarshalTinyJSON(out)
 	}
 	out.RawByte('}')
 }
 
 // MarshalJSON supports json.Marshaler interface
-func (v QueryCanExecuteRequest) MarshalJSON() ([]byte, error) {
+func (v SetQuoteAllowance) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
 	tinyjsonF5cd6cf9EncodeGithubComJackalLabsBurrowContractsCw1SubkeysSrcTypes5(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalTinyJSON supports tinyjson.Marshaler interface
-func (v QueryCanExecuteRequest) MarshalTinyJSON(w *jwriter.Writer) {
+func (v SetQuoteAllowance) MarshalTinyJSON(w *jwriter.Writer) {
 	tinyjsonF5cd6cf9EncodeGithubComJackalLabsBurrowContractsCw1SubkeysSrcTypes5(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
-func (v *QueryCanExecuteRequest) UnmarshalJSON(data []byte) error {
+func (v *SetQuoteAllowance) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
 	tinyjsonF5cd6cf9DecodeGithubComJackalLabsBurrowContractsCw1SubkeysSrcTypes5(&r, v)
 	return r.Error()
 }
 
 // UnmarshalTinyJSON supports tinyjson.Unmarshaler interface
-func (v *QueryCanExecuteRequest) UnmarshalTinyJSON(l *jlexer.Lexer) {
+func (v *SetQuoteAllowance) UnmarshalTinyJSON(l *jlexer.Lexer) {
 	tinyjsonF5cd6cf9DecodeGithubComJackalLabsBurrowContractsCw1SubkeysSrcTypes5(l, v)
 }
-func tinyjsonF5cd6cf9DecodeGithubComJackalLabsBurrowContractsCw1SubkeysSrcTypes6(in *jlexer.Lexer, out *QueryAllowance) {
+func tinyjsonF5cd6cf9DecodeGithubComJackalLabsBurrowContractsCw1SubkeysSrcTypes6(in *jlexer.Lexer, out *SetPolicy) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -592,6 +694,16 @@ func tinyjsonF5cd6cf9DecodeGithubComJackalLabsBurrowContractsCw1SubkeysSrcTypes6
 		switch key {
 		case "spender":
 			out.Spender = string(in.String())
+		case "policy":
+			if in.IsNull() {
+				in.Skip()
+				out.Policy = nil
+			} else {
+				if out.Policy == nil {
+					out.Policy = new(Policy)
+				}
+				(*out.Policy).UnmarshalTinyJSON(in)
+			}
 		default:
 			in.SkipRecursive()
 		}
@@ -602,43 +714,47 @@ func tinyjsonF5cd6cf9DecodeGithubComJackalLabsBurrowContractsCw1SubkeysSrcTypes6
 		in.Consumed()
 	}
 }
-func tinyjsonF5cd6cf9EncodeGithubComJackalLabsBurrowContractsCw1SubkeysSrcTypes6(out *jwriter.Writer, in QueryAllowance) {
+func tinyjsonF5cd6cf9EncodeGithubComJackalLabsBurrowContractsCw1SubkeysSrcTypes6(out *jwriter.Writer, in SetPolicy) {
 	out.RawByte('{')
 	first := true
 	_ = first
-	if in.Spender != "" {
+	{
 		const prefix string = ",\"spender\":"
-		first = false
 		out.RawString(prefix[1:])
 		out.String(string(in.Spender))
 	}
+	if in.Policy != nil {
+		const prefix string = ",\"policy\":"
+		out.RawString(prefix)
+		(*in.Policy).MarshalTinyJSON(out)
+	}
 	out.RawByte('}')
 }
 
 // MarshalJSON supports json.Marshaler interface
-func (v QueryAllowance) MarshalJSON() ([]byte, error) {
+func (v SetPolicy) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
 	tinyjsonF5cd6cf9EncodeGithubComJackalLabsBurrowContractsCw1SubkeysSrcTypes6(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalTinyJSON supports tinyjson.Marshaler interface
-func (v QueryAllowance) MarshalTinyJSON(w *jwriter.Writer) {
+func (v SetPolicy) MarshalTinyJSON(w *jwriter.Writer) {
 	tinyjsonF5cd6cf9EncodeGithubComJackalLabsBurrowContractsCw1SubkeysSrcTypes6(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
-func (v *QueryAllowance) UnmarshalJSON(data []byte) error {
+func (v *SetPolicy) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
 	tinyjsonF5cd6cf9DecodeGithubComJackalLabsBurrowContractsCw1SubkeysSrcTypes6(&r, v)
 	return r.Error()
 }
 
 // UnmarshalTinyJSON supports tinyjson.Unmarshaler interface
-func (v *QueryAllowance) UnmarshalTinyJSON(l *jlexer.Lexer) {
+func (v *SetPolicy) UnmarshalTinyJSON(l *jlexer.Lexer) {
 	tinyjsonF5cd6cf9DecodeGithubComJackalLabsBurrowContractsCw1SubkeysSrcTypes6(l, v)
 }
-func tinyjsonF5cd6cf9DecodeGithubComJackalLabsBurrowContractsCw1SubkeysSrcTypes7(in *jlexer.Lexer, out *QueryAllPermissions) {
+func tinyjsonF5cd6cf9DecodeGithubComJackalLabsBurrowContractsCw1SubkeysSrcTypes7(in *jlexer.Lexer, out *SetPermissions) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -657,10 +773,10 @@ func tinyjsonF5cd6cf9DecodeGithubComJackalLabsBurrowContractsCw1SubkeysSrcTypes7
 			continue
 		}
 		switch key {
-		case "start_after":
-			out.StartAfter = string(in.String())
-		case "limit":
-			out.Limit = uint32(in.Uint32())
+		case "spender":
+			out.Spender = string(in.String())
+		case "permissions":
+			(out.Permissions).UnmarshalTinyJSON(in)
 		default:
 			in.SkipRecursive()
 		}
@@ -671,53 +787,47 @@ func tinyjsonF5cd6cf9DecodeGithubComJackalLabsBurrowContractsCw1SubkeysSrcTypes7
 		in.Consumed()
 	}
 }
-func tinyjsonF5cd6cf9EncodeGithubComJackalLabsBurrowContractsCw1SubkeysSrcTypes7(out *jwriter.Writer, in QueryAllPermissions) {
+func tinyjsonF5cd6cf9EncodeGithubComJackalLabsBurrowContractsCw1SubkeysSrcTypes7(out *jwriter.Writer, in SetPermissions) {
 	out.RawByte('{')
 	first := true
 	_ = first
-	if in.StartAfter != "" {
-		const prefix string = ",\"start_after\":"
-		first = false
+	{
+		const prefix string = ",\"spender\":"
 		out.RawString(prefix[1:])
-		out.String(string(in.StartAfter))
+		out.String(string(in.Spender))
 	}
-	if in.Limit != 0 {
-		const prefix string = ",\"limit\":"
-		if first {
-			first = false
-			out.RawString(prefix[1:])
-		} else {
-			out.RawString(prefix)
-		}
-		out.Uint32(uint32(in.Limit))
+	{
+		const prefix string = ",\"permissions\":"
+		out.RawString(prefix)
+		(in.Permissions).MarshalTinyJSON(out)
 	}
 	out.RawByte('}')
 }
 
 // MarshalJSON supports json.Marshaler interface
-func (v QueryAllPermissions) MarshalJSON() ([]byte, error) {
+func (v SetPermissions) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
 	tinyjsonF5cd6cf9EncodeGithubComJackalLabsBurrowContractsCw1SubkeysSrcTypes7(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalTinyJSON supports tinyjson.Marshaler interface
-func (v QueryAllPermissions) MarshalTinyJSON(w *jwriter.Writer) {
+func (v SetPermissions) MarshalTinyJSON(w *jwriter.Writer) {
 	tinyjsonF5cd6cf9EncodeGithubComJackalLabsBurrowContractsCw1SubkeysSrcTypes7(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
-func (v *QueryAllPermissions) UnmarshalJSON(data []byte) error {
+func (v *SetPermissions) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
 	tinyjsonF5cd6cf9DecodeGithubComJackalLabsBurrowContractsCw1SubkeysSrcTypes7(&r, v)
 	return r.Error()
 }
 
 // UnmarshalTinyJSON supports tinyjson.Unmarshaler interface
-func (v *QueryAllPermissions) UnmarshalTinyJSON(l *jlexer.Lexer) {
+func (v *SetPermissions) UnmarshalTinyJSON(l *jlexer.Lexer) {
 	tinyjsonF5cd6cf9DecodeGithubComJackalLabsBurrowContractsCw1SubkeysSrcTypes7(l, v)
 }
-func tinyjsonF5cd6cf9DecodeGithubComJackalLabsBurrowContractsCw1SubkeysSrcTypes8(in *jlexer.Lexer, out *QueryAllAllowance) {
+func tinyjsonF5cd6cf9DecodeGithubComJackalLabsBurrowContractsCw1SubkeysSrcTypes8(in *jlexer.Lexer, out *SetOracle) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -736,67 +846,4032 @@ func tinyjsonF5cd6cf9DecodeGithubComJackalLabsBurrowContractsCw1SubkeysSrcTypes8
 			continue
 		}
 		switch key {
-		case "start_after":
-			out.StartAfter = string(in.String())
-		case "limit":
-			out.Limit = uint32(in.Uint32())
+		case "config":
+			if in.IsNull() {
+				in.Skip()
+				out.Config = nil
+			} else {
+				if out.Config == nil {
+					out.Config = new(OracleConfig)
+				}
+				(*out.Config).UnmarshalTinyJSON(in)
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func tinyjsonF5cd6cf9EncodeGithubComJackalLabsBurrowContractsCw1SubkeysSrcTypes8(out *jwriter.Writer, in SetOracle) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	if in.Config != nil {
+		const prefix string = ",\"config\":"
+		first = false
+		out.RawString(prefix[1:])
+		(*in.Config).MarshalTinyJSON(out)
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v SetOracle) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	tinyjsonF5cd6cf9EncodeGithubComJackalLabsBurrowContractsCw1SubkeysSrcTypes8(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalTinyJSON supports tinyjson.Marshaler interface
+func (v SetOracle) MarshalTinyJSON(w *jwriter.Writer) {
+	tinyjsonF5cd6cf9EncodeGithubComJackalLabsBurrowContractsCw1SubkeysSrcTypes8(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *SetOracle) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	tinyjsonF5cd6cf9DecodeGithubComJackalLabsBurrowContractsCw1SubkeysSrcTypes8(&r, v)
+	return r.Error()
+}
+
+// UnmarshalTinyJSON supports tinyjson.Unmarshaler interface
+func (v *SetOracle) UnmarshalTinyJSON(l *jlexer.Lexer) {
+	tinyjsonF5cd6cf9DecodeGithubComJackalLabsBurrowContractsCw1SubkeysSrcTypes8(l, v)
+}
+func tinyjsonF5cd6cf9DecodeGithubComJackalLabsBurrowContractsCw1SubkeysSrcTypes9(in *jlexer.Lexer, out *SetIbcPermission) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "spender":
+			out.Spender = string(in.String())
+		case "permission":
+			if in.IsNull() {
+				in.Skip()
+				out.Permission = nil
+			} else {
+				if out.Permission == nil {
+					out.Permission = new(IbcPermission)
+				}
+				(*out.Permission).UnmarshalTinyJSON(in)
+			}
 		default:
 			in.SkipRecursive()
 		}
-		in.WantComma()
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func tinyjsonF5cd6cf9EncodeGithubComJackalLabsBurrowContractsCw1SubkeysSrcTypes9(out *jwriter.Writer, in SetIbcPermission) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"spender\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.Spender))
+	}
+	if in.Permission != nil {
+		const prefix string = ",\"permission\":"
+		out.RawString(prefix)
+		(*in.Permission).MarshalTinyJSON(out)
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v SetIbcPermission) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	tinyjsonF5cd6cf9EncodeGithubComJackalLabsBurrowContractsCw1SubkeysSrcTypes9(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalTinyJSON supports tinyjson.Marshaler interface
+func (v SetIbcPermission) MarshalTinyJSON(w *jwriter.Writer) {
+	tinyjsonF5cd6cf9EncodeGithubComJackalLabsBurrowContractsCw1SubkeysSrcTypes9(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *SetIbcPermission) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	tinyjsonF5cd6cf9DecodeGithubComJackalLabsBurrowContractsCw1SubkeysSrcTypes9(&r, v)
+	return r.Error()
+}
+
+// UnmarshalTinyJSON supports tinyjson.Unmarshaler interface
+func (v *SetIbcPermission) UnmarshalTinyJSON(l *jlexer.Lexer) {
+	tinyjsonF5cd6cf9DecodeGithubComJackalLabsBurrowContractsCw1SubkeysSrcTypes9(l, v)
+}
+func tinyjsonF5cd6cf9DecodeGithubComJackalLabsBurrowContractsCw1SubkeysSrcTypes10(in *jlexer.Lexer, out *Revoke) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "spender":
+			out.Spender = string(in.String())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func tinyjsonF5cd6cf9EncodeGithubComJackalLabsBurrowContractsCw1SubkeysSrcTypes10(out *jwriter.Writer, in Revoke) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"spender\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.Spender))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v Revoke) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	tinyjsonF5cd6cf9EncodeGithubComJackalLabsBurrowContractsCw1SubkeysSrcTypes10(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalTinyJSON supports tinyjson.Marshaler interface
+func (v Revoke) MarshalTinyJSON(w *jwriter.Writer) {
+	tinyjsonF5cd6cf9EncodeGithubComJackalLabsBurrowContractsCw1SubkeysSrcTypes10(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *Revoke) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	tinyjsonF5cd6cf9DecodeGithubComJackalLabsBurrowContractsCw1SubkeysSrcTypes10(&r, v)
+	return r.Error()
+}
+
+// UnmarshalTinyJSON supports tinyjson.Unmarshaler interface
+func (v *Revoke) UnmarshalTinyJSON(l *jlexer.Lexer) {
+	tinyjsonF5cd6cf9DecodeGithubComJackalLabsBurrowContractsCw1SubkeysSrcTypes10(l, v)
+}
+func tinyjsonF5cd6cf9DecodeGithubComJackalLabsBurrowContractsCw1SubkeysSrcTypes11(in *jlexer.Lexer, out *RequestSpend) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "msgs":
+			if in.IsNull() {
+				in.Skip()
+				out.Msgs = nil
+			} else {
+				in.Delim('[')
+				if out.Msgs == nil {
+					if !in.IsDelim(']') {
+						out.Msgs = make([]types.CosmosMsg, 0, 0)
+					} else {
+						out.Msgs = []types.CosmosMsg{}
+					}
+				} else {
+					out.Msgs = (out.Msgs)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v19 types.CosmosMsg
+					(v19).UnmarshalTinyJSON(in)
+					out.Msgs = append(out.Msgs, v19)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "expires":
+			(out.Expires).UnmarshalTinyJSON(in)
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func tinyjsonF5cd6cf9EncodeGithubComJackalLabsBurrowContractsCw1SubkeysSrcTypes11(out *jwriter.Writer, in RequestSpend) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"msgs\":"
+		out.RawString(prefix[1:])
+		if in.Msgs == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v20, v21 := range in.Msgs {
+				if v20 > 0 {
+					out.RawByte(',')
+				}
+				(v21).MarshalTinyJSON(out)
+			}
+			out.RawByte(']')
+		}
+	}
+	{
+		const prefix string = ",\"expires\":"
+		out.RawString(prefix)
+		(in.Expires).MarshalTinyJSON(out)
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v RequestSpend) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	tinyjsonF5cd6cf9EncodeGithubComJackalLabsBurrowContractsCw1SubkeysSrcTypes11(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalTinyJSON supports tinyjson.Marshaler interface
+func (v RequestSpend) MarshalTinyJSON(w *jwriter.Writer) {
+	tinyjsonF5cd6cf9EncodeGithubComJackalLabsBurrowContractsCw1SubkeysSrcTypes11(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *RequestSpend) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	tinyjsonF5cd6cf9DecodeGithubComJackalLabsBurrowContractsCw1SubkeysSrcTypes11(&r, v)
+	return r.Error()
+}
+
+// UnmarshalTinyJSON supports tinyjson.Unmarshaler interface
+func (v *RequestSpend) UnmarshalTinyJSON(l *jlexer.Lexer) {
+	tinyjsonF5cd6cf9DecodeGithubComJackalLabsBurrowContractsCw1SubkeysSrcTypes11(l, v)
+}
+func tinyjsonF5cd6cf9DecodeGithubComJackalLabsBurrowContractsCw1SubkeysSrcTypes12(in *jlexer.Lexer, out *RemoveHook) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "addr":
+			out.Addr = string(in.String())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func tinyjsonF5cd6cf9EncodeGithubComJackalLabsBurrowContractsCw1SubkeysSrcTypes12(out *jwriter.Writer, in RemoveHook) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"addr\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.Addr))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v RemoveHook) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	tinyjsonF5cd6cf9EncodeGithubComJackalLabsBurrowContractsCw1SubkeysSrcTypes12(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalTinyJSON supports tinyjson.Marshaler interface
+func (v RemoveHook) MarshalTinyJSON(w *jwriter.Writer) {
+	tinyjsonF5cd6cf9EncodeGithubComJackalLabsBurrowContractsCw1SubkeysSrcTypes12(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *RemoveHook) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	tinyjsonF5cd6cf9DecodeGithubComJackalLabsBurrowContractsCw1SubkeysSrcTypes12(&r, v)
+	return r.Error()
+}
+
+// UnmarshalTinyJSON supports tinyjson.Unmarshaler interface
+func (v *RemoveHook) UnmarshalTinyJSON(l *jlexer.Lexer) {
+	tinyjsonF5cd6cf9DecodeGithubComJackalLabsBurrowContractsCw1SubkeysSrcTypes12(l, v)
+}
+func tinyjsonF5cd6cf9DecodeGithubComJackalLabsBurrowContractsCw1SubkeysSrcTypes13(in *jlexer.Lexer, out *RejectSpend) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "id":
+			out.ID = uint64(in.Uint64())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func tinyjsonF5cd6cf9EncodeGithubComJackalLabsBurrowContractsCw1SubkeysSrcTypes13(out *jwriter.Writer, in RejectSpend) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"id\":"
+		out.RawString(prefix[1:])
+		out.Uint64(uint64(in.ID))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v RejectSpend) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	tinyjsonF5cd6cf9EncodeGithubComJackalLabsBurrowContractsCw1SubkeysSrcTypes13(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalTinyJSON supports tinyjson.Marshaler interface
+func (v RejectSpend) MarshalTinyJSON(w *jwriter.Writer) {
+	tinyjsonF5cd6cf9EncodeGithubComJackalLabsBurrowContractsCw1SubkeysSrcTypes13(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *RejectSpend) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	tinyjsonF5cd6cf9DecodeGithubComJackalLabsBurrowContractsCw1SubkeysSrcTypes13(&r, v)
+	return r.Error()
+}
+
+// UnmarshalTinyJSON supports tinyjson.Unmarshaler interface
+func (v *RejectSpend) UnmarshalTinyJSON(l *jlexer.Lexer) {
+	tinyjsonF5cd6cf9DecodeGithubComJackalLabsBurrowContractsCw1SubkeysSrcTypes13(l, v)
+}
+func tinyjsonF5cd6cf9DecodeGithubComJackalLabsBurrowContractsCw1SubkeysSrcTypes14(in *jlexer.Lexer, out *QueryTopUpConfig) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func tinyjsonF5cd6cf9EncodeGithubComJackalLabsBurrowContractsCw1SubkeysSrcTypes14(out *jwriter.Writer, in QueryTopUpConfig) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v QueryTopUpConfig) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	tinyjsonF5cd6cf9EncodeGithubComJackalLabsBurrowContractsCw1SubkeysSrcTypes14(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalTinyJSON supports tinyjson.Marshaler interface
+func (v QueryTopUpConfig) MarshalTinyJSON(w *jwriter.Writer) {
+	tinyjsonF5cd6cf9EncodeGithubComJackalLabsBurrowContractsCw1SubkeysSrcTypes14(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *QueryTopUpConfig) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	tinyjsonF5cd6cf9DecodeGithubComJackalLabsBurrowContractsCw1SubkeysSrcTypes14(&r, v)
+	return r.Error()
+}
+
+// UnmarshalTinyJSON supports tinyjson.Unmarshaler interface
+func (v *QueryTopUpConfig) UnmarshalTinyJSON(l *jlexer.Lexer) {
+	tinyjsonF5cd6cf9DecodeGithubComJackalLabsBurrowContractsCw1SubkeysSrcTypes14(l, v)
+}
+func tinyjsonF5cd6cf9DecodeGithubComJackalLabsBurrowContractsCw1SubkeysSrcTypes15(in *jlexer.Lexer, out *QueryStargateAllowlist) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "spender":
+			out.Spender = string(in.String())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func tinyjsonF5cd6cf9EncodeGithubComJackalLabsBurrowContractsCw1SubkeysSrcTypes15(out *jwriter.Writer, in QueryStargateAllowlist) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	if in.Spender != "" {
+		const prefix string = ",\"spender\":"
+		first = false
+		out.RawString(prefix[1:])
+		out.String(string(in.Spender))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v QueryStargateAllowlist) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	tinyjsonF5cd6cf9EncodeGithubComJackalLabsBurrowContractsCw1SubkeysSrcTypes15(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalTinyJSON supports tinyjson.Marshaler interface
+func (v QueryStargateAllowlist) MarshalTinyJSON(w *jwriter.Writer) {
+	tinyjsonF5cd6cf9EncodeGithubComJackalLabsBurrowContractsCw1SubkeysSrcTypes15(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *QueryStargateAllowlist) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	tinyjsonF5cd6cf9DecodeGithubComJackalLabsBurrowContractsCw1SubkeysSrcTypes15(&r, v)
+	return r.Error()
+}
+
+// UnmarshalTinyJSON supports tinyjson.Unmarshaler interface
+func (v *QueryStargateAllowlist) UnmarshalTinyJSON(l *jlexer.Lexer) {
+	tinyjsonF5cd6cf9DecodeGithubComJackalLabsBurrowContractsCw1SubkeysSrcTypes15(l, v)
+}
+func tinyjsonF5cd6cf9DecodeGithubComJackalLabsBurrowContractsCw1SubkeysSrcTypes16(in *jlexer.Lexer, out *QuerySpendRequests) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "start_after":
+			out.StartAfter = uint64(in.Uint64())
+		case "limit":
+			out.Limit = uint32(in.Uint32())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func tinyjsonF5cd6cf9EncodeGithubComJackalLabsBurrowContractsCw1SubkeysSrcTypes16(out *jwriter.Writer, in QuerySpendRequests) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	if in.StartAfter != 0 {
+		const prefix string = ",\"start_after\":"
+		first = false
+		out.RawString(prefix[1:])
+		out.Uint64(uint64(in.StartAfter))
+	}
+	if in.Limit != 0 {
+		const prefix string = ",\"limit\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		out.Uint32(uint32(in.Limit))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v QuerySpendRequests) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	tinyjsonF5cd6cf9EncodeGithubComJackalLabsBurrowContractsCw1SubkeysSrcTypes16(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalTinyJSON supports tinyjson.Marshaler interface
+func (v QuerySpendRequests) MarshalTinyJSON(w *jwriter.Writer) {
+	tinyjsonF5cd6cf9EncodeGithubComJackalLabsBurrowContractsCw1SubkeysSrcTypes16(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *QuerySpendRequests) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	tinyjsonF5cd6cf9DecodeGithubComJackalLabsBurrowContractsCw1SubkeysSrcTypes16(&r, v)
+	return r.Error()
+}
+
+// UnmarshalTinyJSON supports tinyjson.Unmarshaler interface
+func (v *QuerySpendRequests) UnmarshalTinyJSON(l *jlexer.Lexer) {
+	tinyjsonF5cd6cf9DecodeGithubComJackalLabsBurrowContractsCw1SubkeysSrcTypes16(l, v)
+}
+func tinyjsonF5cd6cf9DecodeGithubComJackalLabsBurrowContractsCw1SubkeysSrcTypes17(in *jlexer.Lexer, out *QueryQuoteAllowance) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "spender":
+			out.Spender = string(in.String())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func tinyjsonF5cd6cf9EncodeGithubComJackalLabsBurrowContractsCw1SubkeysSrcTypes17(out *jwriter.Writer, in QueryQuoteAllowance) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	if in.Spender != "" {
+		const prefix string = ",\"spender\":"
+		first = false
+		out.RawString(prefix[1:])
+		out.String(string(in.Spender))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v QueryQuoteAllowance) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	tinyjsonF5cd6cf9EncodeGithubComJackalLabsBurrowContractsCw1SubkeysSrcTypes17(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalTinyJSON supports tinyjson.Marshaler interface
+func (v QueryQuoteAllowance) MarshalTinyJSON(w *jwriter.Writer) {
+	tinyjsonF5cd6cf9EncodeGithubComJackalLabsBurrowContractsCw1SubkeysSrcTypes17(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *QueryQuoteAllowance) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	tinyjsonF5cd6cf9DecodeGithubComJackalLabsBurrowContractsCw1SubkeysSrcTypes17(&r, v)
+	return r.Error()
+}
+
+// UnmarshalTinyJSON supports tinyjson.Unmarshaler interface
+func (v *QueryQuoteAllowance) UnmarshalTinyJSON(l *jlexer.Lexer) {
+	tinyjsonF5cd6cf9DecodeGithubComJackalLabsBurrowContractsCw1SubkeysSrcTypes17(l, v)
+}
+func tinyjsonF5cd6cf9DecodeGithubComJackalLabsBurrowContractsCw1SubkeysSrcTypes18(in *jlexer.Lexer, out *QueryPolicy) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "spender":
+			out.Spender = string(in.String())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func tinyjsonF5cd6cf9EncodeGithubComJackalLabsBurrowContractsCw1SubkeysSrcTypes18(out *jwriter.Writer, in QueryPolicy) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	if in.Spender != "" {
+		const prefix string = ",\"spender\":"
+		first = false
+		out.RawString(prefix[1:])
+		out.String(string(in.Spender))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v QueryPolicy) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	tinyjsonF5cd6cf9EncodeGithubComJackalLabsBurrowContractsCw1SubkeysSrcTypes18(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalTinyJSON supports tinyjson.Marshaler interface
+func (v QueryPolicy) MarshalTinyJSON(w *jwriter.Writer) {
+	tinyjsonF5cd6cf9EncodeGithubComJackalLabsBurrowContractsCw1SubkeysSrcTypes18(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *QueryPolicy) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	tinyjsonF5cd6cf9DecodeGithubComJackalLabsBurrowContractsCw1SubkeysSrcTypes18(&r, v)
+	return r.Error()
+}
+
+// UnmarshalTinyJSON supports tinyjson.Unmarshaler interface
+func (v *QueryPolicy) UnmarshalTinyJSON(l *jlexer.Lexer) {
+	tinyjsonF5cd6cf9DecodeGithubComJackalLabsBurrowContractsCw1SubkeysSrcTypes18(l, v)
+}
+func tinyjsonF5cd6cf9DecodeGithubComJackalLabsBurrowContractsCw1SubkeysSrcTypes19(in *jlexer.Lexer, out *QueryPermissionsWith) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "permission":
+			out.Permission = string(in.String())
+		case "start_after":
+			out.StartAfter = string(in.String())
+		case "limit":
+			out.Limit = uint32(in.Uint32())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func tinyjsonF5cd6cf9EncodeGithubComJackalLabsBurrowContractsCw1SubkeysSrcTypes19(out *jwriter.Writer, in QueryPermissionsWith) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"permission\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.Permission))
+	}
+	if in.StartAfter != "" {
+		const prefix string = ",\"start_after\":"
+		out.RawString(prefix)
+		out.String(string(in.StartAfter))
+	}
+	if in.Limit != 0 {
+		const prefix string = ",\"limit\":"
+		out.RawString(prefix)
+		out.Uint32(uint32(in.Limit))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v QueryPermissionsWith) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	tinyjsonF5cd6cf9EncodeGithubComJackalLabsBurrowContractsCw1SubkeysSrcTypes19(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalTinyJSON supports tinyjson.Marshaler interface
+func (v QueryPermissionsWith) MarshalTinyJSON(w *jwriter.Writer) {
+	tinyjsonF5cd6cf9EncodeGithubComJackalLabsBurrowContractsCw1SubkeysSrcTypes19(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *QueryPermissionsWith) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	tinyjsonF5cd6cf9DecodeGithubComJackalLabsBurrowContractsCw1SubkeysSrcTypes19(&r, v)
+	return r.Error()
+}
+
+// UnmarshalTinyJSON supports tinyjson.Unmarshaler interface
+func (v *QueryPermissionsWith) UnmarshalTinyJSON(l *jlexer.Lexer) {
+	tinyjsonF5cd6cf9DecodeGithubComJackalLabsBurrowContractsCw1SubkeysSrcTypes19(l, v)
+}
+func tinyjsonF5cd6cf9DecodeGithubComJackalLabsBurrowContractsCw1SubkeysSrcTypes20(in *jlexer.Lexer, out *QueryPermissions) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "spender":
+			out.Spender = string(in.String())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func tinyjsonF5cd6cf9EncodeGithubComJackalLabsBurrowContractsCw1SubkeysSrcTypes20(out *jwriter.Writer, in QueryPermissions) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	if in.Spender != "" {
+		const prefix string = ",\"spender\":"
+		first = false
+		out.RawString(prefix[1:])
+		out.String(string(in.Spender))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v QueryPermissions) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	tinyjsonF5cd6cf9EncodeGithubComJackalLabsBurrowContractsCw1SubkeysSrcTypes20(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalTinyJSON supports tinyjson.Marshaler interface
+func (v QueryPermissions) MarshalTinyJSON(w *jwriter.Writer) {
+	tinyjsonF5cd6cf9EncodeGithubComJackalLabsBurrowContractsCw1SubkeysSrcTypes20(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *QueryPermissions) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	tinyjsonF5cd6cf9DecodeGithubComJackalLabsBurrowContractsCw1SubkeysSrcTypes20(&r, v)
+	return r.Error()
+}
+
+// UnmarshalTinyJSON supports tinyjson.Unmarshaler interface
+func (v *QueryPermissions) UnmarshalTinyJSON(l *jlexer.Lexer) {
+	tinyjsonF5cd6cf9DecodeGithubComJackalLabsBurrowContractsCw1SubkeysSrcTypes20(l, v)
+}
+func tinyjsonF5cd6cf9DecodeGithubComJackalLabsBurrowContractsCw1SubkeysSrcTypes21(in *jlexer.Lexer, out *QueryOracle) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func tinyjsonF5cd6cf9EncodeGithubComJackalLabsBurrowContractsCw1SubkeysSrcTypes21(out *jwriter.Writer, in QueryOracle) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v QueryOracle) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	tinyjsonF5cd6cf9EncodeGithubComJackalLabsBurrowContractsCw1SubkeysSrcTypes21(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalTinyJSON supports tinyjson.Marshaler interface
+func (v QueryOracle) MarshalTinyJSON(w *jwriter.Writer) {
+	tinyjsonF5cd6cf9EncodeGithubComJackalLabsBurrowContractsCw1SubkeysSrcTypes21(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *QueryOracle) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	tinyjsonF5cd6cf9DecodeGithubComJackalLabsBurrowContractsCw1SubkeysSrcTypes21(&r, v)
+	return r.Error()
+}
+
+// UnmarshalTinyJSON supports tinyjson.Unmarshaler interface
+func (v *QueryOracle) UnmarshalTinyJSON(l *jlexer.Lexer) {
+	tinyjsonF5cd6cf9DecodeGithubComJackalLabsBurrowContractsCw1SubkeysSrcTypes21(l, v)
+}
+func tinyjsonF5cd6cf9DecodeGithubComJackalLabsBurrowContractsCw1SubkeysSrcTypes22(in *jlexer.Lexer, out *QueryMsg) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "admin_list":
+			if in.IsNull() {
+				in.Skip()
+				out.QueryAdminListRequest = nil
+			} else {
+				if out.QueryAdminListRequest == nil {
+					out.QueryAdminListRequest = new(types1.QueryAdminListRequest)
+				}
+				(*out.QueryAdminListRequest).UnmarshalTinyJSON(in)
+			}
+		case "can_execute":
+			if in.IsNull() {
+				in.Skip()
+				out.QueryCanExecuteRequest = nil
+			} else {
+				if out.QueryCanExecuteRequest == nil {
+					out.QueryCanExecuteRequest = new(QueryCanExecuteRequest)
+				}
+				(*out.QueryCanExecuteRequest).UnmarshalTinyJSON(in)
+			}
+		case "allowance":
+			if in.IsNull() {
+				in.Skip()
+				out.QueryAllowance = nil
+			} else {
+				if out.QueryAllowance == nil {
+					out.QueryAllowance = new(QueryAllowance)
+				}
+				(*out.QueryAllowance).UnmarshalTinyJSON(in)
+			}
+		case "top_up_config":
+			if in.IsNull() {
+				in.Skip()
+				out.QueryTopUpConfig = nil
+			} else {
+				if out.QueryTopUpConfig == nil {
+					out.QueryTopUpConfig = new(QueryTopUpConfig)
+				}
+				(*out.QueryTopUpConfig).UnmarshalTinyJSON(in)
+			}
+		case "oracle":
+			if in.IsNull() {
+				in.Skip()
+				out.QueryOracle = nil
+			} else {
+				if out.QueryOracle == nil {
+					out.QueryOracle = new(QueryOracle)
+				}
+				(*out.QueryOracle).UnmarshalTinyJSON(in)
+			}
+		case "quote_allowance":
+			if in.IsNull() {
+				in.Skip()
+				out.QueryQuoteAllowance = nil
+			} else {
+				if out.QueryQuoteAllowance == nil {
+					out.QueryQuoteAllowance = new(QueryQuoteAllowance)
+				}
+				(*out.QueryQuoteAllowance).UnmarshalTinyJSON(in)
+			}
+		case "deny_list":
+			if in.IsNull() {
+				in.Skip()
+				out.QueryDenyList = nil
+			} else {
+				if out.QueryDenyList == nil {
+					out.QueryDenyList = new(QueryDenyList)
+				}
+				(*out.QueryDenyList).UnmarshalTinyJSON(in)
+			}
+		case "cw20_allowance":
+			if in.IsNull() {
+				in.Skip()
+				out.QueryCw20Allowance = nil
+			} else {
+				if out.QueryCw20Allowance == nil {
+					out.QueryCw20Allowance = new(QueryCw20Allowance)
+				}
+				(*out.QueryCw20Allowance).UnmarshalTinyJSON(in)
+			}
+		case "permissions":
+			if in.IsNull() {
+				in.Skip()
+				out.QueryPermissions = nil
+			} else {
+				if out.QueryPermissions == nil {
+					out.QueryPermissions = new(QueryPermissions)
+				}
+				(*out.QueryPermissions).UnmarshalTinyJSON(in)
+			}
+		case "all_allowance":
+			if in.IsNull() {
+				in.Skip()
+				out.QueryAllAllowance = nil
+			} else {
+				if out.QueryAllAllowance == nil {
+					out.QueryAllAllowance = new(QueryAllAllowance)
+				}
+				(*out.QueryAllAllowance).UnmarshalTinyJSON(in)
+			}
+		case "all_permissions":
+			if in.IsNull() {
+				in.Skip()
+				out.QueryAllPermissions = nil
+			} else {
+				if out.QueryAllPermissions == nil {
+					out.QueryAllPermissions = new(QueryAllPermissions)
+				}
+				(*out.QueryAllPermissions).UnmarshalTinyJSON(in)
+			}
+		case "allowances_expiring":
+			if in.IsNull() {
+				in.Skip()
+				out.QueryAllowancesExpiring = nil
+			} else {
+				if out.QueryAllowancesExpiring == nil {
+					out.QueryAllowancesExpiring = new(QueryAllowancesExpiring)
+				}
+				(*out.QueryAllowancesExpiring).UnmarshalTinyJSON(in)
+			}
+		case "allowances_by_denom":
+			if in.IsNull() {
+				in.Skip()
+				out.QueryAllowancesByDenom = nil
+			} else {
+				if out.QueryAllowancesByDenom == nil {
+					out.QueryAllowancesByDenom = new(QueryAllowancesByDenom)
+				}
+				(*out.QueryAllowancesByDenom).UnmarshalTinyJSON(in)
+			}
+		case "permissions_with":
+			if in.IsNull() {
+				in.Skip()
+				out.QueryPermissionsWith = nil
+			} else {
+				if out.QueryPermissionsWith == nil {
+					out.QueryPermissionsWith = new(QueryPermissionsWith)
+				}
+				(*out.QueryPermissionsWith).UnmarshalTinyJSON(in)
+			}
+		case "policy":
+			if in.IsNull() {
+				in.Skip()
+				out.QueryPolicy = nil
+			} else {
+				if out.QueryPolicy == nil {
+					out.QueryPolicy = new(QueryPolicy)
+				}
+				(*out.QueryPolicy).UnmarshalTinyJSON(in)
+			}
+		case "ibc_permission":
+			if in.IsNull() {
+				in.Skip()
+				out.QueryIbcPermission = nil
+			} else {
+				if out.QueryIbcPermission == nil {
+					out.QueryIbcPermission = new(QueryIbcPermission)
+				}
+				(*out.QueryIbcPermission).UnmarshalTinyJSON(in)
+			}
+		case "stargate_allowlist":
+			if in.IsNull() {
+				in.Skip()
+				out.QueryStargateAllowlist = nil
+			} else {
+				if out.QueryStargateAllowlist == nil {
+					out.QueryStargateAllowlist = new(QueryStargateAllowlist)
+				}
+				(*out.QueryStargateAllowlist).UnmarshalTinyJSON(in)
+			}
+		case "grants":
+			if in.IsNull() {
+				in.Skip()
+				out.QueryGrants = nil
+			} else {
+				if out.QueryGrants == nil {
+					out.QueryGrants = new(QueryGrants)
+				}
+				(*out.QueryGrants).UnmarshalTinyJSON(in)
+			}
+		case "spend_requests":
+			if in.IsNull() {
+				in.Skip()
+				out.QuerySpendRequests = nil
+			} else {
+				if out.QuerySpendRequests == nil {
+					out.QuerySpendRequests = new(QuerySpendRequests)
+				}
+				(*out.QuerySpendRequests).UnmarshalTinyJSON(in)
+			}
+		case "hooks":
+			if in.IsNull() {
+				in.Skip()
+				out.QueryHooks = nil
+			} else {
+				if out.QueryHooks == nil {
+					out.QueryHooks = new(QueryHooks)
+				}
+				(*out.QueryHooks).UnmarshalTinyJSON(in)
+			}
+		case "inheritance":
+			if in.IsNull() {
+				in.Skip()
+				out.QueryInheritanceRequest = nil
+			} else {
+				if out.QueryInheritanceRequest == nil {
+					out.QueryInheritanceRequest = new(types1.QueryInheritanceRequest)
+				}
+				(*out.QueryInheritanceRequest).UnmarshalTinyJSON(in)
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func tinyjsonF5cd6cf9EncodeGithubComJackalLabsBurrowContractsCw1SubkeysSrcTypes22(out *jwriter.Writer, in QueryMsg) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	if in.QueryAdminListRequest != nil {
+		const prefix string = ",\"admin_list\":"
+		first = false
+		out.RawString(prefix[1:])
+		(*in.QueryAdminListRequest).MarshalTinyJSON(out)
+	}
+	if in.QueryCanExecuteRequest != nil {
+		const prefix string = ",\"can_execute\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		(*in.QueryCanExecuteRequest).MarshalTinyJSON(out)
+	}
+	if in.QueryAllowance != nil {
+		const prefix string = ",\"allowance\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		(*in.QueryAllowance).MarshalTinyJSON(out)
+	}
+	if in.QueryTopUpConfig != nil {
+		const prefix string = ",\"top_up_config\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		(*in.QueryTopUpConfig).MarshalTinyJSON(out)
+	}
+	if in.QueryOracle != nil {
+		const prefix string = ",\"oracle\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		(*in.QueryOracle).MarshalTinyJSON(out)
+	}
+	if in.QueryQuoteAllowance != nil {
+		const prefix string = ",\"quote_allowance\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		(*in.QueryQuoteAllowance).MarshalTinyJSON(out)
+	}
+	if in.QueryDenyList != nil {
+		const prefix string = ",\"deny_list\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		(*in.QueryDenyList).MarshalTinyJSON(out)
+	}
+	if in.QueryCw20Allowance != nil {
+		const prefix string = ",\"cw20_allowance\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		(*in.QueryCw20Allowance).MarshalTinyJSON(out)
+	}
+	if in.QueryPermissions != nil {
+		const prefix string = ",\"permissions\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		(*in.QueryPermissions).MarshalTinyJSON(out)
+	}
+	if in.QueryAllAllowance != nil {
+		const prefix string = ",\"all_allowance\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		(*in.QueryAllAllowance).MarshalTinyJSON(out)
+	}
+	if in.QueryAllPermissions != nil {
+		const prefix string = ",\"all_permissions\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		(*in.QueryAllPermissions).MarshalTinyJSON(out)
+	}
+	if in.QueryAllowancesExpiring != nil {
+		const prefix string = ",\"allowances_expiring\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		(*in.QueryAllowancesExpiring).MarshalTinyJSON(out)
+	}
+	if in.QueryAllowancesByDenom != nil {
+		const prefix string = ",\"allowances_by_denom\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		(*in.QueryAllowancesByDenom).MarshalTinyJSON(out)
+	}
+	if in.QueryPermissionsWith != nil {
+		const prefix string = ",\"permissions_with\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		(*in.QueryPermissionsWith).MarshalTinyJSON(out)
+	}
+	if in.QueryPolicy != nil {
+		const prefix string = ",\"policy\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		(*in.QueryPolicy).MarshalTinyJSON(out)
+	}
+	if in.QueryIbcPermission != nil {
+		const prefix string = ",\"ibc_permission\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		(*in.QueryIbcPermission).MarshalTinyJSON(out)
+	}
+	if in.QueryStargateAllowlist != nil {
+		const prefix string = ",\"stargate_allowlist\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		(*in.QueryStargateAllowlist).MarshalTinyJSON(out)
+	}
+	if in.QueryGrants != nil {
+		const prefix string = ",\"grants\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		(*in.QueryGrants).MarshalTinyJSON(out)
+	}
+	if in.QuerySpendRequests != nil {
+		const prefix string = ",\"spend_requests\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		(*in.QuerySpendRequests).MarshalTinyJSON(out)
+	}
+	if in.QueryHooks != nil {
+		const prefix string = ",\"hooks\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		(*in.QueryHooks).MarshalTinyJSON(out)
+	}
+	if in.QueryInheritanceRequest != nil {
+		const prefix string = ",\"inheritance\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		(*in.QueryInheritanceRequest).MarshalTinyJSON(out)
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v QueryMsg) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	tinyjsonF5cd6cf9EncodeGithubComJackalLabsBurrowContractsCw1SubkeysSrcTypes22(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalTinyJSON supports tinyjson.Marshaler interface
+func (v QueryMsg) MarshalTinyJSON(w *jwriter.Writer) {
+	tinyjsonF5cd6cf9EncodeGithubComJackalLabsBurrowContractsCw1SubkeysSrcTypes22(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *QueryMsg) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	tinyjsonF5cd6cf9DecodeGithubComJackalLabsBurrowContractsCw1SubkeysSrcTypes22(&r, v)
+	return r.Error()
+}
+
+// UnmarshalTinyJSON supports tinyjson.Unmarshaler interface
+func (v *QueryMsg) UnmarshalTinyJSON(l *jlexer.Lexer) {
+	tinyjsonF5cd6cf9DecodeGithubComJackalLabsBurrowContractsCw1SubkeysSrcTypes22(l, v)
+}
+func tinyjsonF5cd6cf9DecodeGithubComJackalLabsBurrowContractsCw1SubkeysSrcTypes23(in *jlexer.Lexer, out *QueryIbcPermission) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "spender":
+			out.Spender = string(in.String())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func tinyjsonF5cd6cf9EncodeGithubComJackalLabsBurrowContractsCw1SubkeysSrcTypes23(out *jwriter.Writer, in QueryIbcPermission) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	if in.Spender != "" {
+		const prefix string = ",\"spender\":"
+		first = false
+		out.RawString(prefix[1:])
+		out.String(string(in.Spender))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v QueryIbcPermission) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	tinyjsonF5cd6cf9EncodeGithubComJackalLabsBurrowContractsCw1SubkeysSrcTypes23(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalTinyJSON supports tinyjson.Marshaler interface
+func (v QueryIbcPermission) MarshalTinyJSON(w *jwriter.Writer) {
+	tinyjsonF5cd6cf9EncodeGithubComJackalLabsBurrowContractsCw1SubkeysSrcTypes23(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *QueryIbcPermission) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	tinyjsonF5cd6cf9DecodeGithubComJackalLabsBurrowContractsCw1SubkeysSrcTypes23(&r, v)
+	return r.Error()
+}
+
+// UnmarshalTinyJSON supports tinyjson.Unmarshaler interface
+func (v *QueryIbcPermission) UnmarshalTinyJSON(l *jlexer.Lexer) {
+	tinyjsonF5cd6cf9DecodeGithubComJackalLabsBurrowContractsCw1SubkeysSrcTypes23(l, v)
+}
+func tinyjsonF5cd6cf9DecodeGithubComJackalLabsBurrowContractsCw1SubkeysSrcTypes24(in *jlexer.Lexer, out *QueryHooks) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func tinyjsonF5cd6cf9EncodeGithubComJackalLabsBurrowContractsCw1SubkeysSrcTypes24(out *jwriter.Writer, in QueryHooks) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v QueryHooks) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	tinyjsonF5cd6cf9EncodeGithubComJackalLabsBurrowContractsCw1SubkeysSrcTypes24(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalTinyJSON supports tinyjson.Marshaler interface
+func (v QueryHooks) MarshalTinyJSON(w *jwriter.Writer) {
+	tinyjsonF5cd6cf9EncodeGithubComJackalLabsBurrowContractsCw1SubkeysSrcTypes24(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *QueryHooks) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	tinyjsonF5cd6cf9DecodeGithubComJackalLabsBurrowContractsCw1SubkeysSrcTypes24(&r, v)
+	return r.Error()
+}
+
+// UnmarshalTinyJSON supports tinyjson.Unmarshaler interface
+func (v *QueryHooks) UnmarshalTinyJSON(l *jlexer.Lexer) {
+	tinyjsonF5cd6cf9DecodeGithubComJackalLabsBurrowContractsCw1SubkeysSrcTypes24(l, v)
+}
+func tinyjsonF5cd6cf9DecodeGithubComJackalLabsBurrowContractsCw1SubkeysSrcTypes25(in *jlexer.Lexer, out *QueryGrants) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "spender":
+			out.Spender = string(in.String())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func tinyjsonF5cd6cf9EncodeGithubComJackalLabsBurrowContractsCw1SubkeysSrcTypes25(out *jwriter.Writer, in QueryGrants) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	if in.Spender != "" {
+		const prefix string = ",\"spender\":"
+		first = false
+		out.RawString(prefix[1:])
+		out.String(string(in.Spender))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v QueryGrants) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	tinyjsonF5cd6cf9EncodeGithubComJackalLabsBurrowContractsCw1SubkeysSrcTypes25(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalTinyJSON supports tinyjson.Marshaler interface
+func (v QueryGrants) MarshalTinyJSON(w *jwriter.Writer) {
+	tinyjsonF5cd6cf9EncodeGithubComJackalLabsBurrowContractsCw1SubkeysSrcTypes25(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *QueryGrants) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	tinyjsonF5cd6cf9DecodeGithubComJackalLabsBurrowContractsCw1SubkeysSrcTypes25(&r, v)
+	return r.Error()
+}
+
+// UnmarshalTinyJSON supports tinyjson.Unmarshaler interface
+func (v *QueryGrants) UnmarshalTinyJSON(l *jlexer.Lexer) {
+	tinyjsonF5cd6cf9DecodeGithubComJackalLabsBurrowContractsCw1SubkeysSrcTypes25(l, v)
+}
+func tinyjsonF5cd6cf9DecodeGithubComJackalLabsBurrowContractsCw1SubkeysSrcTypes26(in *jlexer.Lexer, out *QueryDenyList) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func tinyjsonF5cd6cf9EncodeGithubComJackalLabsBurrowContractsCw1SubkeysSrcTypes26(out *jwriter.Writer, in QueryDenyList) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v QueryDenyList) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	tinyjsonF5cd6cf9EncodeGithubComJackalLabsBurrowContractsCw1SubkeysSrcTypes26(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalTinyJSON supports tinyjson.Marshaler interface
+func (v QueryDenyList) MarshalTinyJSON(w *jwriter.Writer) {
+	tinyjsonF5cd6cf9EncodeGithubComJackalLabsBurrowContractsCw1SubkeysSrcTypes26(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *QueryDenyList) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	tinyjsonF5cd6cf9DecodeGithubComJackalLabsBurrowContractsCw1SubkeysSrcTypes26(&r, v)
+	return r.Error()
+}
+
+// UnmarshalTinyJSON supports tinyjson.Unmarshaler interface
+func (v *QueryDenyList) UnmarshalTinyJSON(l *jlexer.Lexer) {
+	tinyjsonF5cd6cf9DecodeGithubComJackalLabsBurrowContractsCw1SubkeysSrcTypes26(l, v)
+}
+func tinyjsonF5cd6cf9DecodeGithubComJackalLabsBurrowContractsCw1SubkeysSrcTypes27(in *jlexer.Lexer, out *QueryCw20Allowance) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "spender":
+			out.Spender = string(in.String())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func tinyjsonF5cd6cf9EncodeGithubComJackalLabsBurrowContractsCw1SubkeysSrcTypes27(out *jwriter.Writer, in QueryCw20Allowance) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	if in.Spender != "" {
+		const prefix string = ",\"spender\":"
+		first = false
+		out.RawString(prefix[1:])
+		out.String(string(in.Spender))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v QueryCw20Allowance) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	tinyjsonF5cd6cf9EncodeGithubComJackalLabsBurrowContractsCw1SubkeysSrcTypes27(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalTinyJSON supports tinyjson.Marshaler interface
+func (v QueryCw20Allowance) MarshalTinyJSON(w *jwriter.Writer) {
+	tinyjsonF5cd6cf9EncodeGithubComJackalLabsBurrowContractsCw1SubkeysSrcTypes27(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *QueryCw20Allowance) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	tinyjsonF5cd6cf9DecodeGithubComJackalLabsBurrowContractsCw1SubkeysSrcTypes27(&r, v)
+	return r.Error()
+}
+
+// UnmarshalTinyJSON supports tinyjson.Unmarshaler interface
+func (v *QueryCw20Allowance) UnmarshalTinyJSON(l *jlexer.Lexer) {
+	tinyjsonF5cd6cf9DecodeGithubComJackalLabsBurrowContractsCw1SubkeysSrcTypes27(l, v)
+}
+func tinyjsonF5cd6cf9DecodeGithubComJackalLabsBurrowContractsCw1SubkeysSrcTypes28(in *jlexer.Lexer, out *QueryCanExecuteRequest) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "sender":
+			out.Sender = string(in.String())
+		case "msg":
+			(out.Msg).UnmarshalTinyJSON(in)
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func tinyjsonF5cd6cf9EncodeGithubComJackalLabsBurrowContractsCw1SubkeysSrcTypes28(out *jwriter.Writer, in QueryCanExecuteRequest) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	if in.Sender != "" {
+		const prefix string = ",\"sender\":"
+		first = false
+		out.RawString(prefix[1:])
+		out.String(string(in.Sender))
+	}
+	if true {
+		const prefix string = ",\"msg\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		(in.Msg).MarshalTinyJSON(out)
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v QueryCanExecuteRequest) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	tinyjsonF5cd6cf9EncodeGithubComJackalLabsBurrowContractsCw1SubkeysSrcTypes28(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalTinyJSON supports tinyjson.Marshaler interface
+func (v QueryCanExecuteRequest) MarshalTinyJSON(w *jwriter.Writer) {
+	tinyjsonF5cd6cf9EncodeGithubComJackalLabsBurrowContractsCw1SubkeysSrcTypes28(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *QueryCanExecuteRequest) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	tinyjsonF5cd6cf9DecodeGithubComJackalLabsBurrowContractsCw1SubkeysSrcTypes28(&r, v)
+	return r.Error()
+}
+
+// UnmarshalTinyJSON supports tinyjson.Unmarshaler interface
+func (v *QueryCanExecuteRequest) UnmarshalTinyJSON(l *jlexer.Lexer) {
+	tinyjsonF5cd6cf9DecodeGithubComJackalLabsBurrowContractsCw1SubkeysSrcTypes28(l, v)
+}
+func tinyjsonF5cd6cf9DecodeGithubComJackalLabsBurrowContractsCw1SubkeysSrcTypes29(in *jlexer.Lexer, out *QueryAllowancesExpiring) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "before_height":
+			out.BeforeHeight = uint64(in.Uint64())
+		case "start_after":
+			out.StartAfter = string(in.String())
+		case "limit":
+			out.Limit = uint32(in.Uint32())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func tinyjsonF5cd6cf9EncodeGithubComJackalLabsBurrowContractsCw1SubkeysSrcTypes29(out *jwriter.Writer, in QueryAllowancesExpiring) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"before_height\":"
+		out.RawString(prefix[1:])
+		out.Uint64(uint64(in.BeforeHeight))
+	}
+	if in.StartAfter != "" {
+		const prefix string = ",\"start_after\":"
+		out.RawString(prefix)
+		out.String(string(in.StartAfter))
+	}
+	if in.Limit != 0 {
+		const prefix string = ",\"limit\":"
+		out.RawString(prefix)
+		out.Uint32(uint32(in.Limit))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v QueryAllowancesExpiring) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	tinyjsonF5cd6cf9EncodeGithubComJackalLabsBurrowContractsCw1SubkeysSrcTypes29(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalTinyJSON supports tinyjson.Marshaler interface
+func (v QueryAllowancesExpiring) MarshalTinyJSON(w *jwriter.Writer) {
+	tinyjsonF5cd6cf9EncodeGithubComJackalLabsBurrowContractsCw1SubkeysSrcTypes29(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *QueryAllowancesExpiring) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	tinyjsonF5cd6cf9DecodeGithubComJackalLabsBurrowContractsCw1SubkeysSrcTypes29(&r, v)
+	return r.Error()
+}
+
+// UnmarshalTinyJSON supports tinyjson.Unmarshaler interface
+func (v *QueryAllowancesExpiring) UnmarshalTinyJSON(l *jlexer.Lexer) {
+	tinyjsonF5cd6cf9DecodeGithubComJackalLabsBurrowContractsCw1SubkeysSrcTypes29(l, v)
+}
+func tinyjsonF5cd6cf9DecodeGithubComJackalLabsBurrowContractsCw1SubkeysSrcTypes30(in *jlexer.Lexer, out *QueryAllowancesByDenom) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "denom":
+			out.Denom = string(in.String())
+		case "start_after":
+			out.StartAfter = string(in.String())
+		case "limit":
+			out.Limit = uint32(in.Uint32())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func tinyjsonF5cd6cf9EncodeGithubComJackalLabsBurrowContractsCw1SubkeysSrcTypes30(out *jwriter.Writer, in QueryAllowancesByDenom) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"denom\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.Denom))
+	}
+	if in.StartAfter != "" {
+		const prefix string = ",\"start_after\":"
+		out.RawString(prefix)
+		out.String(string(in.StartAfter))
+	}
+	if in.Limit != 0 {
+		const prefix string = ",\"limit\":"
+		out.RawString(prefix)
+		out.Uint32(uint32(in.Limit))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v QueryAllowancesByDenom) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	tinyjsonF5cd6cf9EncodeGithubComJackalLabsBurrowContractsCw1SubkeysSrcTypes30(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalTinyJSON supports tinyjson.Marshaler interface
+func (v QueryAllowancesByDenom) MarshalTinyJSON(w *jwriter.Writer) {
+	tinyjsonF5cd6cf9EncodeGithubComJackalLabsBurrowContractsCw1SubkeysSrcTypes30(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *QueryAllowancesByDenom) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	tinyjsonF5cd6cf9DecodeGithubComJackalLabsBurrowContractsCw1SubkeysSrcTypes30(&r, v)
+	return r.Error()
+}
+
+// UnmarshalTinyJSON supports tinyjson.Unmarshaler interface
+func (v *QueryAllowancesByDenom) UnmarshalTinyJSON(l *jlexer.Lexer) {
+	tinyjsonF5cd6cf9DecodeGithubComJackalLabsBurrowContractsCw1SubkeysSrcTypes30(l, v)
+}
+func tinyjsonF5cd6cf9DecodeGithubComJackalLabsBurrowContractsCw1SubkeysSrcTypes31(in *jlexer.Lexer, out *QueryAllowance) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "spender":
+			out.Spender = string(in.String())
+		case "height":
+			out.Height = uint64(in.Uint64())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func tinyjsonF5cd6cf9EncodeGithubComJackalLabsBurrowContractsCw1SubkeysSrcTypes31(out *jwriter.Writer, in QueryAllowance) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	if in.Spender != "" {
+		const prefix string = ",\"spender\":"
+		first = false
+		out.RawString(prefix[1:])
+		out.String(string(in.Spender))
+	}
+	if in.Height != 0 {
+		const prefix string = ",\"height\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		out.Uint64(uint64(in.Height))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v QueryAllowance) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	tinyjsonF5cd6cf9EncodeGithubComJackalLabsBurrowContractsCw1SubkeysSrcTypes31(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalTinyJSON supports tinyjson.Marshaler interface
+func (v QueryAllowance) MarshalTinyJSON(w *jwriter.Writer) {
+	tinyjsonF5cd6cf9EncodeGithubComJackalLabsBurrowContractsCw1SubkeysSrcTypes31(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *QueryAllowance) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	tinyjsonF5cd6cf9DecodeGithubComJackalLabsBurrowContractsCw1SubkeysSrcTypes31(&r, v)
+	return r.Error()
+}
+
+// UnmarshalTinyJSON supports tinyjson.Unmarshaler interface
+func (v *QueryAllowance) UnmarshalTinyJSON(l *jlexer.Lexer) {
+	tinyjsonF5cd6cf9DecodeGithubComJackalLabsBurrowContractsCw1SubkeysSrcTypes31(l, v)
+}
+func tinyjsonF5cd6cf9DecodeGithubComJackalLabsBurrowContractsCw1SubkeysSrcTypes32(in *jlexer.Lexer, out *QueryAllPermissions) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "start_after":
+			out.StartAfter = string(in.String())
+		case "limit":
+			out.Limit = uint32(in.Uint32())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func tinyjsonF5cd6cf9EncodeGithubComJackalLabsBurrowContractsCw1SubkeysSrcTypes32(out *jwriter.Writer, in QueryAllPermissions) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	if in.StartAfter != "" {
+		const prefix string = ",\"start_after\":"
+		first = false
+		out.RawString(prefix[1:])
+		out.String(string(in.StartAfter))
+	}
+	if in.Limit != 0 {
+		const prefix string = ",\"limit\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		out.Uint32(uint32(in.Limit))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v QueryAllPermissions) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	tinyjsonF5cd6cf9EncodeGithubComJackalLabsBurrowContractsCw1SubkeysSrcTypes32(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalTinyJSON supports tinyjson.Marshaler interface
+func (v QueryAllPermissions) MarshalTinyJSON(w *jwriter.Writer) {
+	tinyjsonF5cd6cf9EncodeGithubComJackalLabsBurrowContractsCw1SubkeysSrcTypes32(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *QueryAllPermissions) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	tinyjsonF5cd6cf9DecodeGithubComJackalLabsBurrowContractsCw1SubkeysSrcTypes32(&r, v)
+	return r.Error()
+}
+
+// UnmarshalTinyJSON supports tinyjson.Unmarshaler interface
+func (v *QueryAllPermissions) UnmarshalTinyJSON(l *jlexer.Lexer) {
+	tinyjsonF5cd6cf9DecodeGithubComJackalLabsBurrowContractsCw1SubkeysSrcTypes32(l, v)
+}
+func tinyjsonF5cd6cf9DecodeGithubComJackalLabsBurrowContractsCw1SubkeysSrcTypes33(in *jlexer.Lexer, out *QueryAllAllowance) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "start_after":
+			out.StartAfter = string(in.String())
+		case "limit":
+			out.Limit = uint32(in.Uint32())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func tinyjsonF5cd6cf9EncodeGithubComJackalLabsBurrowContractsCw1SubkeysSrcTypes33(out *jwriter.Writer, in QueryAllAllowance) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	if in.StartAfter != "" {
+		const prefix string = ",\"start_after\":"
+		first = false
+		out.RawString(prefix[1:])
+		out.String(string(in.StartAfter))
+	}
+	if in.Limit != 0 {
+		const prefix string = ",\"limit\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		out.Uint32(uint32(in.Limit))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v QueryAllAllowance) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	tinyjsonF5cd6cf9EncodeGithubComJackalLabsBurrowContractsCw1SubkeysSrcTypes33(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalTinyJSON supports tinyjson.Marshaler interface
+func (v QueryAllAllowance) MarshalTinyJSON(w *jwriter.Writer) {
+	tinyjsonF5cd6cf9EncodeGithubComJackalLabsBurrowContractsCw1SubkeysSrcTypes33(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *QueryAllAllowance) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	tinyjsonF5cd6cf9DecodeGithubComJackalLabsBurrowContractsCw1SubkeysSrcTypes33(&r, v)
+	return r.Error()
+}
+
+// UnmarshalTinyJSON supports tinyjson.Unmarshaler interface
+func (v *QueryAllAllowance) UnmarshalTinyJSON(l *jlexer.Lexer) {
+	tinyjsonF5cd6cf9DecodeGithubComJackalLabsBurrowContractsCw1SubkeysSrcTypes33(l, v)
+}
+func tinyjsonF5cd6cf9DecodeGithubComJackalLabsBurrowContractsCw1SubkeysSrcTypes34(in *jlexer.Lexer, out *PolicyResponse) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "policy":
+			if in.IsNull() {
+				in.Skip()
+				out.Policy = nil
+			} else {
+				if out.Policy == nil {
+					out.Policy = new(Policy)
+				}
+				(*out.Policy).UnmarshalTinyJSON(in)
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func tinyjsonF5cd6cf9EncodeGithubComJackalLabsBurrowContractsCw1SubkeysSrcTypes34(out *jwriter.Writer, in PolicyResponse) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	if in.Policy != nil {
+		const prefix string = ",\"policy\":"
+		first = false
+		out.RawString(prefix[1:])
+		(*in.Policy).MarshalTinyJSON(out)
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v PolicyResponse) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	tinyjsonF5cd6cf9EncodeGithubComJackalLabsBurrowContractsCw1SubkeysSrcTypes34(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalTinyJSON supports tinyjson.Marshaler interface
+func (v PolicyResponse) MarshalTinyJSON(w *jwriter.Writer) {
+	tinyjsonF5cd6cf9EncodeGithubComJackalLabsBurrowContractsCw1SubkeysSrcTypes34(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *PolicyResponse) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	tinyjsonF5cd6cf9DecodeGithubComJackalLabsBurrowContractsCw1SubkeysSrcTypes34(&r, v)
+	return r.Error()
+}
+
+// UnmarshalTinyJSON supports tinyjson.Unmarshaler interface
+func (v *PolicyResponse) UnmarshalTinyJSON(l *jlexer.Lexer) {
+	tinyjsonF5cd6cf9DecodeGithubComJackalLabsBurrowContractsCw1SubkeysSrcTypes34(l, v)
+}
+func tinyjsonF5cd6cf9DecodeGithubComJackalLabsBurrowContractsCw1SubkeysSrcTypes35(in *jlexer.Lexer, out *PermissionInfo) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "spender":
+			out.Spender = string(in.String())
+		case "permissions":
+			(out.Permissions).UnmarshalTinyJSON(in)
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func tinyjsonF5cd6cf9EncodeGithubComJackalLabsBurrowContractsCw1SubkeysSrcTypes35(out *jwriter.Writer, in PermissionInfo) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"spender\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.Spender))
+	}
+	{
+		const prefix string = ",\"permissions\":"
+		out.RawString(prefix)
+		(in.Permissions).MarshalTinyJSON(out)
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v PermissionInfo) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	tinyjsonF5cd6cf9EncodeGithubComJackalLabsBurrowContractsCw1SubkeysSrcTypes35(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalTinyJSON supports tinyjson.Marshaler interface
+func (v PermissionInfo) MarshalTinyJSON(w *jwriter.Writer) {
+	tinyjsonF5cd6cf9EncodeGithubComJackalLabsBurrowContractsCw1SubkeysSrcTypes35(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *PermissionInfo) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	tinyjsonF5cd6cf9DecodeGithubComJackalLabsBurrowContractsCw1SubkeysSrcTypes35(&r, v)
+	return r.Error()
+}
+
+// UnmarshalTinyJSON supports tinyjson.Unmarshaler interface
+func (v *PermissionInfo) UnmarshalTinyJSON(l *jlexer.Lexer) {
+	tinyjsonF5cd6cf9DecodeGithubComJackalLabsBurrowContractsCw1SubkeysSrcTypes35(l, v)
+}
+func tinyjsonF5cd6cf9DecodeGithubComJackalLabsBurrowContractsCw1SubkeysSrcTypes36(in *jlexer.Lexer, out *OracleResponse) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "config":
+			if in.IsNull() {
+				in.Skip()
+				out.Config = nil
+			} else {
+				if out.Config == nil {
+					out.Config = new(OracleConfig)
+				}
+				(*out.Config).UnmarshalTinyJSON(in)
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func tinyjsonF5cd6cf9EncodeGithubComJackalLabsBurrowContractsCw1SubkeysSrcTypes36(out *jwriter.Writer, in OracleResponse) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	if in.Config != nil {
+		const prefix string = ",\"config\":"
+		first = false
+		out.RawString(prefix[1:])
+		(*in.Config).MarshalTinyJSON(out)
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v OracleResponse) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	tinyjsonF5cd6cf9EncodeGithubComJackalLabsBurrowContractsCw1SubkeysSrcTypes36(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalTinyJSON supports tinyjson.Marshaler interface
+func (v OracleResponse) MarshalTinyJSON(w *jwriter.Writer) {
+	tinyjsonF5cd6cf9EncodeGithubComJackalLabsBurrowContractsCw1SubkeysSrcTypes36(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *OracleResponse) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	tinyjsonF5cd6cf9DecodeGithubComJackalLabsBurrowContractsCw1SubkeysSrcTypes36(&r, v)
+	return r.Error()
+}
+
+// UnmarshalTinyJSON supports tinyjson.Unmarshaler interface
+func (v *OracleResponse) UnmarshalTinyJSON(l *jlexer.Lexer) {
+	tinyjsonF5cd6cf9DecodeGithubComJackalLabsBurrowContractsCw1SubkeysSrcTypes36(l, v)
+}
+func tinyjsonF5cd6cf9DecodeGithubComJackalLabsBurrowContractsCw1SubkeysSrcTypes37(in *jlexer.Lexer, out *IncreaseCw20Allowance) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "spender":
+			out.Spender = string(in.String())
+		case "token":
+			out.Token = string(in.String())
+		case "amount":
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.Amount).UnmarshalJSON(data))
+			}
+		case "expires":
+			(out.Expires).UnmarshalTinyJSON(in)
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func tinyjsonF5cd6cf9EncodeGithubComJackalLabsBurrowContractsCw1SubkeysSrcTypes37(out *jwriter.Writer, in IncreaseCw20Allowance) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"spender\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.Spender))
+	}
+	{
+		const prefix string = ",\"token\":"
+		out.RawString(prefix)
+		out.String(string(in.Token))
+	}
+	{
+		const prefix string = ",\"amount\":"
+		out.RawString(prefix)
+		out.Raw((in.Amount).MarshalJSON())
+	}
+	{
+		const prefix string = ",\"expires\":"
+		out.RawString(prefix)
+		(in.Expires).MarshalTinyJSON(out)
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v IncreaseCw20Allowance) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	tinyjsonF5cd6cf9EncodeGithubComJackalLabsBurrowContractsCw1SubkeysSrcTypes37(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalTinyJSON supports tinyjson.Marshaler interface
+func (v IncreaseCw20Allowance) MarshalTinyJSON(w *jwriter.Writer) {
+	tinyjsonF5cd6cf9EncodeGithubComJackalLabsBurrowContractsCw1SubkeysSrcTypes37(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *IncreaseCw20Allowance) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	tinyjsonF5cd6cf9DecodeGithubComJackalLabsBurrowContractsCw1SubkeysSrcTypes37(&r, v)
+	return r.Error()
+}
+
+// UnmarshalTinyJSON supports tinyjson.Unmarshaler interface
+func (v *IncreaseCw20Allowance) UnmarshalTinyJSON(l *jlexer.Lexer) {
+	tinyjsonF5cd6cf9DecodeGithubComJackalLabsBurrowContractsCw1SubkeysSrcTypes37(l, v)
+}
+func tinyjsonF5cd6cf9DecodeGithubComJackalLabsBurrowContractsCw1SubkeysSrcTypes38(in *jlexer.Lexer, out *IncreaseAllowance) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "spender":
+			out.Spender = string(in.String())
+		case "amount":
+			(out.Amount).UnmarshalTinyJSON(in)
+		case "expires":
+			(out.Expires).UnmarshalTinyJSON(in)
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func tinyjsonF5cd6cf9EncodeGithubComJackalLabsBurrowContractsCw1SubkeysSrcTypes38(out *jwriter.Writer, in IncreaseAllowance) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"spender\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.Spender))
+	}
+	{
+		const prefix string = ",\"amount\":"
+		out.RawString(prefix)
+		(in.Amount).MarshalTinyJSON(out)
+	}
+	{
+		const prefix string = ",\"expires\":"
+		out.RawString(prefix)
+		(in.Expires).MarshalTinyJSON(out)
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v IncreaseAllowance) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	tinyjsonF5cd6cf9EncodeGithubComJackalLabsBurrowContractsCw1SubkeysSrcTypes38(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalTinyJSON supports tinyjson.Marshaler interface
+func (v IncreaseAllowance) MarshalTinyJSON(w *jwriter.Writer) {
+	tinyjsonF5cd6cf9EncodeGithubComJackalLabsBurrowContractsCw1SubkeysSrcTypes38(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *IncreaseAllowance) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	tinyjsonF5cd6cf9DecodeGithubComJackalLabsBurrowContractsCw1SubkeysSrcTypes38(&r, v)
+	return r.Error()
+}
+
+// UnmarshalTinyJSON supports tinyjson.Unmarshaler interface
+func (v *IncreaseAllowance) UnmarshalTinyJSON(l *jlexer.Lexer) {
+	tinyjsonF5cd6cf9DecodeGithubComJackalLabsBurrowContractsCw1SubkeysSrcTypes38(l, v)
+}
+func tinyjsonF5cd6cf9DecodeGithubComJackalLabsBurrowContractsCw1SubkeysSrcTypes39(in *jlexer.Lexer, out *IbcPermissionResponse) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "permission":
+			if in.IsNull() {
+				in.Skip()
+				out.Permission = nil
+			} else {
+				if out.Permission == nil {
+					out.Permission = new(IbcPermission)
+				}
+				(*out.Permission).UnmarshalTinyJSON(in)
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func tinyjsonF5cd6cf9EncodeGithubComJackalLabsBurrowContractsCw1SubkeysSrcTypes39(out *jwriter.Writer, in IbcPermissionResponse) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	if in.Permission != nil {
+		const prefix string = ",\"permission\":"
+		first = false
+		out.RawString(prefix[1:])
+		(*in.Permission).MarshalTinyJSON(out)
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v IbcPermissionResponse) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	tinyjsonF5cd6cf9EncodeGithubComJackalLabsBurrowContractsCw1SubkeysSrcTypes39(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalTinyJSON supports tinyjson.Marshaler interface
+func (v IbcPermissionResponse) MarshalTinyJSON(w *jwriter.Writer) {
+	tinyjsonF5cd6cf9EncodeGithubComJackalLabsBurrowContractsCw1SubkeysSrcTypes39(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *IbcPermissionResponse) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	tinyjsonF5cd6cf9DecodeGithubComJackalLabsBurrowContractsCw1SubkeysSrcTypes39(&r, v)
+	return r.Error()
+}
+
+// UnmarshalTinyJSON supports tinyjson.Unmarshaler interface
+func (v *IbcPermissionResponse) UnmarshalTinyJSON(l *jlexer.Lexer) {
+	tinyjsonF5cd6cf9DecodeGithubComJackalLabsBurrowContractsCw1SubkeysSrcTypes39(l, v)
+}
+func tinyjsonF5cd6cf9DecodeGithubComJackalLabsBurrowContractsCw1SubkeysSrcTypes40(in *jlexer.Lexer, out *HooksResponse) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "hooks":
+			if in.IsNull() {
+				in.Skip()
+				out.Hooks = nil
+			} else {
+				in.Delim('[')
+				if out.Hooks == nil {
+					if !in.IsDelim(']') {
+						out.Hooks = make([]string, 0, 4)
+					} else {
+						out.Hooks = []string{}
+					}
+				} else {
+					out.Hooks = (out.Hooks)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v22 string
+					v22 = string(in.String())
+					out.Hooks = append(out.Hooks, v22)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func tinyjsonF5cd6cf9EncodeGithubComJackalLabsBurrowContractsCw1SubkeysSrcTypes40(out *jwriter.Writer, in HooksResponse) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"hooks\":"
+		out.RawString(prefix[1:])
+		if in.Hooks == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v23, v24 := range in.Hooks {
+				if v23 > 0 {
+					out.RawByte(',')
+				}
+				out.String(string(v24))
+			}
+			out.RawByte(']')
+		}
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v HooksResponse) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	tinyjsonF5cd6cf9EncodeGithubComJackalLabsBurrowContractsCw1SubkeysSrcTypes40(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalTinyJSON supports tinyjson.Marshaler interface
+func (v HooksResponse) MarshalTinyJSON(w *jwriter.Writer) {
+	tinyjsonF5cd6cf9EncodeGithubComJackalLabsBurrowContractsCw1SubkeysSrcTypes40(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *HooksResponse) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	tinyjsonF5cd6cf9DecodeGithubComJackalLabsBurrowContractsCw1SubkeysSrcTypes40(&r, v)
+	return r.Error()
+}
+
+// UnmarshalTinyJSON supports tinyjson.Unmarshaler interface
+func (v *HooksResponse) UnmarshalTinyJSON(l *jlexer.Lexer) {
+	tinyjsonF5cd6cf9DecodeGithubComJackalLabsBurrowContractsCw1SubkeysSrcTypes40(l, v)
+}
+func tinyjsonF5cd6cf9DecodeGithubComJackalLabsBurrowContractsCw1SubkeysSrcTypes41(in *jlexer.Lexer, out *GrantsResponse) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "parent":
+			out.Parent = string(in.String())
+		case "tree":
+			(out.Tree).UnmarshalTinyJSON(in)
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func tinyjsonF5cd6cf9EncodeGithubComJackalLabsBurrowContractsCw1SubkeysSrcTypes41(out *jwriter.Writer, in GrantsResponse) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	if in.Parent != "" {
+		const prefix string = ",\"parent\":"
+		first = false
+		out.RawString(prefix[1:])
+		out.String(string(in.Parent))
+	}
+	{
+		const prefix string = ",\"tree\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		(in.Tree).MarshalTinyJSON(out)
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v GrantsResponse) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	tinyjsonF5cd6cf9EncodeGithubComJackalLabsBurrowContractsCw1SubkeysSrcTypes41(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalTinyJSON supports tinyjson.Marshaler interface
+func (v GrantsResponse) MarshalTinyJSON(w *jwriter.Writer) {
+	tinyjsonF5cd6cf9EncodeGithubComJackalLabsBurrowContractsCw1SubkeysSrcTypes41(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *GrantsResponse) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	tinyjsonF5cd6cf9DecodeGithubComJackalLabsBurrowContractsCw1SubkeysSrcTypes41(&r, v)
+	return r.Error()
+}
+
+// UnmarshalTinyJSON supports tinyjson.Unmarshaler interface
+func (v *GrantsResponse) UnmarshalTinyJSON(l *jlexer.Lexer) {
+	tinyjsonF5cd6cf9DecodeGithubComJackalLabsBurrowContractsCw1SubkeysSrcTypes41(l, v)
+}
+func tinyjsonF5cd6cf9DecodeGithubComJackalLabsBurrowContractsCw1SubkeysSrcTypes42(in *jlexer.Lexer, out *GrantPermissions) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "spender":
+			out.Spender = string(in.String())
+		case "permissions":
+			(out.Permissions).UnmarshalTinyJSON(in)
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func tinyjsonF5cd6cf9EncodeGithubComJackalLabsBurrowContractsCw1SubkeysSrcTypes42(out *jwriter.Writer, in GrantPermissions) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"spender\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.Spender))
+	}
+	{
+		const prefix string = ",\"permissions\":"
+		out.RawString(prefix)
+		(in.Permissions).MarshalTinyJSON(out)
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v GrantPermissions) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	tinyjsonF5cd6cf9EncodeGithubComJackalLabsBurrowContractsCw1SubkeysSrcTypes42(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalTinyJSON supports tinyjson.Marshaler interface
+func (v GrantPermissions) MarshalTinyJSON(w *jwriter.Writer) {
+	tinyjsonF5cd6cf9EncodeGithubComJackalLabsBurrowContractsCw1SubkeysSrcTypes42(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *GrantPermissions) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	tinyjsonF5cd6cf9DecodeGithubComJackalLabsBurrowContractsCw1SubkeysSrcTypes42(&r, v)
+	return r.Error()
+}
+
+// UnmarshalTinyJSON supports tinyjson.Unmarshaler interface
+func (v *GrantPermissions) UnmarshalTinyJSON(l *jlexer.Lexer) {
+	tinyjsonF5cd6cf9DecodeGithubComJackalLabsBurrowContractsCw1SubkeysSrcTypes42(l, v)
+}
+func tinyjsonF5cd6cf9DecodeGithubComJackalLabsBurrowContractsCw1SubkeysSrcTypes43(in *jlexer.Lexer, out *GrantNode) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "spender":
+			out.Spender = string(in.String())
+		case "allowance":
+			if in.IsNull() {
+				in.Skip()
+				out.Allowance = nil
+			} else {
+				if out.Allowance == nil {
+					out.Allowance = new(Allowances)
+				}
+				(*out.Allowance).UnmarshalTinyJSON(in)
+			}
+		case "permissions":
+			if in.IsNull() {
+				in.Skip()
+				out.Permissions = nil
+			} else {
+				if out.Permissions == nil {
+					out.Permissions = new(Permissions)
+				}
+				(*out.Permissions).UnmarshalTinyJSON(in)
+			}
+		case "children":
+			if in.IsNull() {
+				in.Skip()
+				out.Children = nil
+			} else {
+				in.Delim('[')
+				if out.Children == nil {
+					if !in.IsDelim(']') {
+						out.Children = make([]GrantNode, 0, 1)
+					} else {
+						out.Children = []GrantNode{}
+					}
+				} else {
+					out.Children = (out.Children)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v25 GrantNode
+					(v25).UnmarshalTinyJSON(in)
+					out.Children = append(out.Children, v25)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func tinyjsonF5cd6cf9EncodeGithubComJackalLabsBurrowContractsCw1SubkeysSrcTypes43(out *jwriter.Writer, in GrantNode) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"spender\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.Spender))
+	}
+	if in.Allowance != nil {
+		const prefix string = ",\"allowance\":"
+		out.RawString(prefix)
+		(*in.Allowance).MarshalTinyJSON(out)
+	}
+	if in.Permissions != nil {
+		const prefix string = ",\"permissions\":"
+		out.RawString(prefix)
+		(*in.Permissions).MarshalTinyJSON(out)
+	}
+	{
+		const prefix string = ",\"children\":"
+		out.RawString(prefix)
+		if in.Children == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v26, v27 := range in.Children {
+				if v26 > 0 {
+					out.RawByte(',')
+				}
+				(v27).MarshalTinyJSON(out)
+			}
+			out.RawByte(']')
+		}
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v GrantNode) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	tinyjsonF5cd6cf9EncodeGithubComJackalLabsBurrowContractsCw1SubkeysSrcTypes43(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalTinyJSON supports tinyjson.Marshaler interface
+func (v GrantNode) MarshalTinyJSON(w *jwriter.Writer) {
+	tinyjsonF5cd6cf9EncodeGithubComJackalLabsBurrowContractsCw1SubkeysSrcTypes43(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *GrantNode) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	tinyjsonF5cd6cf9DecodeGithubComJackalLabsBurrowContractsCw1SubkeysSrcTypes43(&r, v)
+	return r.Error()
+}
+
+// UnmarshalTinyJSON supports tinyjson.Unmarshaler interface
+func (v *GrantNode) UnmarshalTinyJSON(l *jlexer.Lexer) {
+	tinyjsonF5cd6cf9DecodeGithubComJackalLabsBurrowContractsCw1SubkeysSrcTypes43(l, v)
+}
+func tinyjsonF5cd6cf9DecodeGithubComJackalLabsBurrowContractsCw1SubkeysSrcTypes44(in *jlexer.Lexer, out *GrantAllowance) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "spender":
+			out.Spender = string(in.String())
+		case "amount":
+			(out.Amount).UnmarshalTinyJSON(in)
+		case "expires":
+			(out.Expires).UnmarshalTinyJSON(in)
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func tinyjsonF5cd6cf9EncodeGithubComJackalLabsBurrowContractsCw1SubkeysSrcTypes44(out *jwriter.Writer, in GrantAllowance) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"spender\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.Spender))
+	}
+	{
+		const prefix string = ",\"amount\":"
+		out.RawString(prefix)
+		(in.Amount).MarshalTinyJSON(out)
+	}
+	{
+		const prefix string = ",\"expires\":"
+		out.RawString(prefix)
+		(in.Expires).MarshalTinyJSON(out)
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v GrantAllowance) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	tinyjsonF5cd6cf9EncodeGithubComJackalLabsBurrowContractsCw1SubkeysSrcTypes44(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalTinyJSON supports tinyjson.Marshaler interface
+func (v GrantAllowance) MarshalTinyJSON(w *jwriter.Writer) {
+	tinyjsonF5cd6cf9EncodeGithubComJackalLabsBurrowContractsCw1SubkeysSrcTypes44(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *GrantAllowance) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	tinyjsonF5cd6cf9DecodeGithubComJackalLabsBurrowContractsCw1SubkeysSrcTypes44(&r, v)
+	return r.Error()
+}
+
+// UnmarshalTinyJSON supports tinyjson.Unmarshaler interface
+func (v *GrantAllowance) UnmarshalTinyJSON(l *jlexer.Lexer) {
+	tinyjsonF5cd6cf9DecodeGithubComJackalLabsBurrowContractsCw1SubkeysSrcTypes44(l, v)
+}
+func tinyjsonF5cd6cf9DecodeGithubComJackalLabsBurrowContractsCw1SubkeysSrcTypes45(in *jlexer.Lexer, out *FundAllowance) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "spender":
+			out.Spender = string(in.String())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func tinyjsonF5cd6cf9EncodeGithubComJackalLabsBurrowContractsCw1SubkeysSrcTypes45(out *jwriter.Writer, in FundAllowance) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"spender\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.Spender))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v FundAllowance) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	tinyjsonF5cd6cf9EncodeGithubComJackalLabsBurrowContractsCw1SubkeysSrcTypes45(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalTinyJSON supports tinyjson.Marshaler interface
+func (v FundAllowance) MarshalTinyJSON(w *jwriter.Writer) {
+	tinyjsonF5cd6cf9EncodeGithubComJackalLabsBurrowContractsCw1SubkeysSrcTypes45(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *FundAllowance) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	tinyjsonF5cd6cf9DecodeGithubComJackalLabsBurrowContractsCw1SubkeysSrcTypes45(&r, v)
+	return r.Error()
+}
+
+// UnmarshalTinyJSON supports tinyjson.Unmarshaler interface
+func (v *FundAllowance) UnmarshalTinyJSON(l *jlexer.Lexer) {
+	tinyjsonF5cd6cf9DecodeGithubComJackalLabsBurrowContractsCw1SubkeysSrcTypes45(l, v)
+}
+func tinyjsonF5cd6cf9DecodeGithubComJackalLabsBurrowContractsCw1SubkeysSrcTypes46(in *jlexer.Lexer, out *ExecuteRequest) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "msgs":
+			if in.IsNull() {
+				in.Skip()
+				out.Msgs = nil
+			} else {
+				in.Delim('[')
+				if out.Msgs == nil {
+					if !in.IsDelim(']') {
+						out.Msgs = make([]types.CosmosMsg, 0, 0)
+					} else {
+						out.Msgs = []types.CosmosMsg{}
+					}
+				} else {
+					out.Msgs = (out.Msgs)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v28 types.CosmosMsg
+					(v28).UnmarshalTinyJSON(in)
+					out.Msgs = append(out.Msgs, v28)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func tinyjsonF5cd6cf9EncodeGithubComJackalLabsBurrowContractsCw1SubkeysSrcTypes46(out *jwriter.Writer, in ExecuteRequest) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	if len(in.Msgs) != 0 {
+		const prefix string = ",\"msgs\":"
+		first = false
+		out.RawString(prefix[1:])
+		{
+			out.RawByte('[')
+			for v29, v30 := range in.Msgs {
+				if v29 > 0 {
+					out.RawByte(',')
+				}
+				(v30).MarshalTinyJSON(out)
+			}
+			out.RawByte(']')
+		}
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v ExecuteRequest) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	tinyjsonF5cd6cf9EncodeGithubComJackalLabsBurrowContractsCw1SubkeysSrcTypes46(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalTinyJSON supports tinyjson.Marshaler interface
+func (v ExecuteRequest) MarshalTinyJSON(w *jwriter.Writer) {
+	tinyjsonF5cd6cf9EncodeGithubComJackalLabsBurrowContractsCw1SubkeysSrcTypes46(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *ExecuteRequest) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	tinyjsonF5cd6cf9DecodeGithubComJackalLabsBurrowContractsCw1SubkeysSrcTypes46(&r, v)
+	return r.Error()
+}
+
+// UnmarshalTinyJSON supports tinyjson.Unmarshaler interface
+func (v *ExecuteRequest) UnmarshalTinyJSON(l *jlexer.Lexer) {
+	tinyjsonF5cd6cf9DecodeGithubComJackalLabsBurrowContractsCw1SubkeysSrcTypes46(l, v)
+}
+func tinyjsonF5cd6cf9DecodeGithubComJackalLabsBurrowContractsCw1SubkeysSrcTypes47(in *jlexer.Lexer, out *ExecuteMsg) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "execute":
+			if in.IsNull() {
+				in.Skip()
+				out.ExecuteRequest = nil
+			} else {
+				if out.ExecuteRequest == nil {
+					out.ExecuteRequest = new(ExecuteRequest)
+				}
+				(*out.ExecuteRequest).UnmarshalTinyJSON(in)
+			}
+		case "freeze":
+			if in.IsNull() {
+				in.Skip()
+				out.FreezeRequest = nil
+			} else {
+				if out.FreezeRequest == nil {
+					out.FreezeRequest = new(types1.FreezeRequest)
+				}
+				(*out.FreezeRequest).UnmarshalTinyJSON(in)
+			}
+		case "update_admins":
+			if in.IsNull() {
+				in.Skip()
+				out.UpdateAdminsRequest = nil
+			} else {
+				if out.UpdateAdminsRequest == nil {
+					out.UpdateAdminsRequest = new(types1.UpdateAdminsRequest)
+				}
+				(*out.UpdateAdminsRequest).UnmarshalTinyJSON(in)
+			}
+		case "increase_allowance":
+			if in.IsNull() {
+				in.Skip()
+				out.IncreaseAllowance = nil
+			} else {
+				if out.IncreaseAllowance == nil {
+					out.IncreaseAllowance = new(IncreaseAllowance)
+				}
+				(*out.IncreaseAllowance).UnmarshalTinyJSON(in)
+			}
+		case "decrease_allowance":
+			if in.IsNull() {
+				in.Skip()
+				out.DecreaseAllowance = nil
+			} else {
+				if out.DecreaseAllowance == nil {
+					out.DecreaseAllowance = new(DecreaseAllowance)
+				}
+				(*out.DecreaseAllowance).UnmarshalTinyJSON(in)
+			}
+		case "set_permissions":
+			if in.IsNull() {
+				in.Skip()
+				out.SetPermissions = nil
+			} else {
+				if out.SetPermissions == nil {
+					out.SetPermissions = new(SetPermissions)
+				}
+				(*out.SetPermissions).UnmarshalTinyJSON(in)
+			}
+		case "fund_allowance":
+			if in.IsNull() {
+				in.Skip()
+				out.FundAllowance = nil
+			} else {
+				if out.FundAllowance == nil {
+					out.FundAllowance = new(FundAllowance)
+				}
+				(*out.FundAllowance).UnmarshalTinyJSON(in)
+			}
+		case "set_top_up_config":
+			if in.IsNull() {
+				in.Skip()
+				out.SetTopUpConfig = nil
+			} else {
+				if out.SetTopUpConfig == nil {
+					out.SetTopUpConfig = new(TopUpConfig)
+				}
+				(*out.SetTopUpConfig).UnmarshalTinyJSON(in)
+			}
+		case "set_oracle":
+			if in.IsNull() {
+				in.Skip()
+				out.SetOracle = nil
+			} else {
+				if out.SetOracle == nil {
+					out.SetOracle = new(SetOracle)
+				}
+				(*out.SetOracle).UnmarshalTinyJSON(in)
+			}
+		case "set_quote_allowance":
+			if in.IsNull() {
+				in.Skip()
+				out.SetQuoteAllowance = nil
+			} else {
+				if out.SetQuoteAllowance == nil {
+					out.SetQuoteAllowance = new(SetQuoteAllowance)
+				}
+				(*out.SetQuoteAllowance).UnmarshalTinyJSON(in)
+			}
+		case "update_deny_list":
+			if in.IsNull() {
+				in.Skip()
+				out.UpdateDenyList = nil
+			} else {
+				if out.UpdateDenyList == nil {
+					out.UpdateDenyList = new(UpdateDenyList)
+				}
+				(*out.UpdateDenyList).UnmarshalTinyJSON(in)
+			}
+		case "increase_cw20_allowance":
+			if in.IsNull() {
+				in.Skip()
+				out.IncreaseCw20Allowance = nil
+			} else {
+				if out.IncreaseCw20Allowance == nil {
+					out.IncreaseCw20Allowance = new(IncreaseCw20Allowance)
+				}
+				(*out.IncreaseCw20Allowance).UnmarshalTinyJSON(in)
+			}
+		case "decrease_cw20_allowance":
+			if in.IsNull() {
+				in.Skip()
+				out.DecreaseCw20Allowance = nil
+			} else {
+				if out.DecreaseCw20Allowance == nil {
+					out.DecreaseCw20Allowance = new(DecreaseCw20Allowance)
+				}
+				(*out.DecreaseCw20Allowance).UnmarshalTinyJSON(in)
+			}
+		case "batch_increase_allowance":
+			if in.IsNull() {
+				in.Skip()
+				out.BatchIncreaseAllowance = nil
+			} else {
+				if out.BatchIncreaseAllowance == nil {
+					out.BatchIncreaseAllowance = new(BatchIncreaseAllowance)
+				}
+				(*out.BatchIncreaseAllowance).UnmarshalTinyJSON(in)
+			}
+		case "batch_decrease_allowance":
+			if in.IsNull() {
+				in.Skip()
+				out.BatchDecreaseAllowance = nil
+			} else {
+				if out.BatchDecreaseAllowance == nil {
+					out.BatchDecreaseAllowance = new(BatchDecreaseAllowance)
+				}
+				(*out.BatchDecreaseAllowance).UnmarshalTinyJSON(in)
+			}
+		case "batch_set_permissions":
+			if in.IsNull() {
+				in.Skip()
+				out.BatchSetPermissions = nil
+			} else {
+				if out.BatchSetPermissions == nil {
+					out.BatchSetPermissions = new(BatchSetPermissions)
+				}
+				(*out.BatchSetPermissions).UnmarshalTinyJSON(in)
+			}
+		case "set_ibc_permission":
+			if in.IsNull() {
+				in.Skip()
+				out.SetIbcPermission = nil
+			} else {
+				if out.SetIbcPermission == nil {
+					out.SetIbcPermission = new(SetIbcPermission)
+				}
+				(*out.SetIbcPermission).UnmarshalTinyJSON(in)
+			}
+		case "set_stargate_allowlist":
+			if in.IsNull() {
+				in.Skip()
+				out.SetStargateAllowlist = nil
+			} else {
+				if out.SetStargateAllowlist == nil {
+					out.SetStargateAllowlist = new(SetStargateAllowlist)
+				}
+				(*out.SetStargateAllowlist).UnmarshalTinyJSON(in)
+			}
+		case "set_policy":
+			if in.IsNull() {
+				in.Skip()
+				out.SetPolicy = nil
+			} else {
+				if out.SetPolicy == nil {
+					out.SetPolicy = new(SetPolicy)
+				}
+				(*out.SetPolicy).UnmarshalTinyJSON(in)
+			}
+		case "revoke":
+			if in.IsNull() {
+				in.Skip()
+				out.Revoke = nil
+			} else {
+				if out.Revoke == nil {
+					out.Revoke = new(Revoke)
+				}
+				(*out.Revoke).UnmarshalTinyJSON(in)
+			}
+		case "grant_allowance":
+			if in.IsNull() {
+				in.Skip()
+				out.GrantAllowance = nil
+			} else {
+				if out.GrantAllowance == nil {
+					out.GrantAllowance = new(GrantAllowance)
+				}
+				(*out.GrantAllowance).UnmarshalTinyJSON(in)
+			}
+		case "grant_permissions":
+			if in.IsNull() {
+				in.Skip()
+				out.GrantPermissions = nil
+			} else {
+				if out.GrantPermissions == nil {
+					out.GrantPermissions = new(GrantPermissions)
+				}
+				(*out.GrantPermissions).UnmarshalTinyJSON(in)
+			}
+		case "request_spend":
+			if in.IsNull() {
+				in.Skip()
+				out.RequestSpend = nil
+			} else {
+				if out.RequestSpend == nil {
+					out.RequestSpend = new(RequestSpend)
+				}
+				(*out.RequestSpend).UnmarshalTinyJSON(in)
+			}
+		case "approve_spend":
+			if in.IsNull() {
+				in.Skip()
+				out.ApproveSpend = nil
+			} else {
+				if out.ApproveSpend == nil {
+					out.ApproveSpend = new(ApproveSpend)
+				}
+				(*out.ApproveSpend).UnmarshalTinyJSON(in)
+			}
+		case "reject_spend":
+			if in.IsNull() {
+				in.Skip()
+				out.RejectSpend = nil
+			} else {
+				if out.RejectSpend == nil {
+					out.RejectSpend = new(RejectSpend)
+				}
+				(*out.RejectSpend).UnmarshalTinyJSON(in)
+			}
+		case "add_hook":
+			if in.IsNull() {
+				in.Skip()
+				out.AddHook = nil
+			} else {
+				if out.AddHook == nil {
+					out.AddHook = new(AddHook)
+				}
+				(*out.AddHook).UnmarshalTinyJSON(in)
+			}
+		case "remove_hook":
+			if in.IsNull() {
+				in.Skip()
+				out.RemoveHook = nil
+			} else {
+				if out.RemoveHook == nil {
+					out.RemoveHook = new(RemoveHook)
+				}
+				(*out.RemoveHook).UnmarshalTinyJSON(in)
+			}
+		case "heartbeat":
+			if in.IsNull() {
+				in.Skip()
+				out.HeartbeatRequest = nil
+			} else {
+				if out.HeartbeatRequest == nil {
+					out.HeartbeatRequest = new(types1.HeartbeatRequest)
+				}
+				(*out.HeartbeatRequest).UnmarshalTinyJSON(in)
+			}
+		case "set_inheritance":
+			if in.IsNull() {
+				in.Skip()
+				out.SetInheritanceRequest = nil
+			} else {
+				if out.SetInheritanceRequest == nil {
+					out.SetInheritanceRequest = new(types1.SetInheritanceRequest)
+				}
+				(*out.SetInheritanceRequest).UnmarshalTinyJSON(in)
+			}
+		case "claim_inheritance":
+			if in.IsNull() {
+				in.Skip()
+				out.ClaimInheritanceRequest = nil
+			} else {
+				if out.ClaimInheritanceRequest == nil {
+					out.ClaimInheritanceRequest = new(types1.ClaimInheritanceRequest)
+				}
+				(*out.ClaimInheritanceRequest).UnmarshalTinyJSON(in)
+			}
+		case "sweep_inheritance":
+			if in.IsNull() {
+				in.Skip()
+				out.SweepInheritanceRequest = nil
+			} else {
+				if out.SweepInheritanceRequest == nil {
+					out.SweepInheritanceRequest = new(types1.SweepInheritanceRequest)
+				}
+				(*out.SweepInheritanceRequest).UnmarshalTinyJSON(in)
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func tinyjsonF5cd6cf9EncodeGithubComJackalLabsBurrowContractsCw1SubkeysSrcTypes47(out *jwriter.Writer, in ExecuteMsg) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	if in.ExecuteRequest != nil {
+		const prefix string = ",\"execute\":"
+		first = false
+		out.RawString(prefix[1:])
+		(*in.ExecuteRequest).MarshalTinyJSON(out)
+	}
+	if in.FreezeRequest != nil {
+		const prefix string = ",\"freeze\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		(*in.FreezeRequest).MarshalTinyJSON(out)
+	}
+	if in.UpdateAdminsRequest != nil {
+		const prefix string = ",\"update_admins\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		(*in.UpdateAdminsRequest).MarshalTinyJSON(out)
+	}
+	if in.IncreaseAllowance != nil {
+		const prefix string = ",\"increase_allowance\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		(*in.IncreaseAllowance).MarshalTinyJSON(out)
+	}
+	if in.DecreaseAllowance != nil {
+		const prefix string = ",\"decrease_allowance\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		(*in.DecreaseAllowance).MarshalTinyJSON(out)
+	}
+	if in.SetPermissions != nil {
+		const prefix string = ",\"set_permissions\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		(*in.SetPermissions).MarshalTinyJSON(out)
+	}
+	if in.FundAllowance != nil {
+		const prefix string = ",\"fund_allowance\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		(*in.FundAllowance).MarshalTinyJSON(out)
+	}
+	if in.SetTopUpConfig != nil {
+		const prefix string = ",\"set_top_up_config\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		(*in.SetTopUpConfig).MarshalTinyJSON(out)
+	}
+	if in.SetOracle != nil {
+		const prefix string = ",\"set_oracle\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		(*in.SetOracle).MarshalTinyJSON(out)
+	}
+	if in.SetQuoteAllowance != nil {
+		const prefix string = ",\"set_quote_allowance\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		(*in.SetQuoteAllowance).MarshalTinyJSON(out)
+	}
+	if in.UpdateDenyList != nil {
+		const prefix string = ",\"update_deny_list\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		(*in.UpdateDenyList).MarshalTinyJSON(out)
+	}
+	if in.IncreaseCw20Allowance != nil {
+		const prefix string = ",\"increase_cw20_allowance\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		(*in.IncreaseCw20Allowance).MarshalTinyJSON(out)
+	}
+	if in.DecreaseCw20Allowance != nil {
+		const prefix string = ",\"decrease_cw20_allowance\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		(*in.DecreaseCw20Allowance).MarshalTinyJSON(out)
+	}
+	if in.BatchIncreaseAllowance != nil {
+		const prefix string = ",\"batch_increase_allowance\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		(*in.BatchIncreaseAllowance).MarshalTinyJSON(out)
+	}
+	if in.BatchDecreaseAllowance != nil {
+		const prefix string = ",\"batch_decrease_allowance\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		(*in.BatchDecreaseAllowance).MarshalTinyJSON(out)
+	}
+	if in.BatchSetPermissions != nil {
+		const prefix string = ",\"batch_set_permissions\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		(*in.BatchSetPermissions).MarshalTinyJSON(out)
+	}
+	if in.SetIbcPermission != nil {
+		const prefix string = ",\"set_ibc_permission\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		(*in.SetIbcPermission).MarshalTinyJSON(out)
+	}
+	if in.SetStargateAllowlist != nil {
+		const prefix string = ",\"set_stargate_allowlist\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		(*in.SetStargateAllowlist).MarshalTinyJSON(out)
+	}
+	if in.SetPolicy != nil {
+		const prefix string = ",\"set_policy\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		(*in.SetPolicy).MarshalTinyJSON(out)
+	}
+	if in.Revoke != nil {
+		const prefix string = ",\"revoke\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		(*in.Revoke).MarshalTinyJSON(out)
+	}
+	if in.GrantAllowance != nil {
+		const prefix string = ",\"grant_allowance\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		(*in.GrantAllowance).MarshalTinyJSON(out)
+	}
+	if in.GrantPermissions != nil {
+		const prefix string = ",\"grant_permissions\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		(*in.GrantPermissions).MarshalTinyJSON(out)
+	}
+	if in.RequestSpend != nil {
+		const prefix string = ",\"request_spend\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		(*in.RequestSpend).MarshalTinyJSON(out)
+	}
+	if in.ApproveSpend != nil {
+		const prefix string = ",\"approve_spend\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		(*in.ApproveSpend).MarshalTinyJSON(out)
+	}
+	if in.RejectSpend != nil {
+		const prefix string = ",\"reject_spend\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		(*in.RejectSpend).MarshalTinyJSON(out)
+	}
+	if in.AddHook != nil {
+		const prefix string = ",\"add_hook\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		(*in.AddHook).MarshalTinyJSON(out)
+	}
+	if in.RemoveHook != nil {
+		const prefix string = ",\"remove_hook\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		(*in.RemoveHook).MarshalTinyJSON(out)
+	}
+	if in.HeartbeatRequest != nil {
+		const prefix string = ",\"heartbeat\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		(*in.HeartbeatRequest).MarshalTinyJSON(out)
 	}
-	in.Delim('}')
-	if isTopLevel {
-		in.Consumed()
+	if in.SetInheritanceRequest != nil {
+		const prefix string = ",\"set_inheritance\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		(*in.SetInheritanceRequest).MarshalTinyJSON(out)
 	}
-}
-func tinyjsonF5cd6cf9EncodeGithubComJackalLabsBurrowContractsCw1SubkeysSrcTypes8(out *jwriter.Writer, in QueryAllAllowance) {
-	out.RawByte('{')
-	first := true
-	_ = first
-	if in.StartAfter != "" {
-		const prefix string = ",\"start_after\":"
-		first = false
-		out.RawString(prefix[1:])
-		out.String(string(in.StartAfter))
+	if in.ClaimInheritanceRequest != nil {
+		const prefix string = ",\"claim_inheritance\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		(*in.ClaimInheritanceRequest).MarshalTinyJSON(out)
 	}
-	if in.Limit != 0 {
-		const prefix string = ",\"limit\":"
+	if in.SweepInheritanceRequest != nil {
+		const prefix string = ",\"sweep_inheritance\":"
 		if first {
 			first = false
 			out.RawString(prefix[1:])
 		} else {
 			out.RawString(prefix)
 		}
-		out.Uint32(uint32(in.Limit))
+		(*in.SweepInheritanceRequest).MarshalTinyJSON(out)
 	}
 	out.RawByte('}')
 }
 
 // MarshalJSON supports json.Marshaler interface
-func (v QueryAllAllowance) MarshalJSON() ([]byte, error) {
+func (v ExecuteMsg) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	tinyjsonF5cd6cf9EncodeGithubComJackalLabsBurrowContractsCw1SubkeysSrcTypes8(&w, v)
+	tinyjsonF5cd6cf9EncodeGithubComJackalLabsBurrowContractsCw1SubkeysSrcTypes47(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalTinyJSON supports tinyjson.Marshaler interface
-func (v QueryAllAllowance) MarshalTinyJSON(w *jwriter.Writer) {
-	tinyjsonF5cd6cf9EncodeGithubComJackalLabsBurrowContractsCw1SubkeysSrcTypes8(w, v)
+func (v ExecuteMsg) MarshalTinyJSON(w *jwriter.Writer) {
+	tinyjsonF5cd6cf9EncodeGithubComJackalLabsBurrowContractsCw1SubkeysSrcTypes47(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
-func (v *QueryAllAllowance) UnmarshalJSON(data []byte) error {
+func (v *ExecuteMsg) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	tinyjsonF5cd6cf9DecodeGithubComJackalLabsBurrowContractsCw1SubkeysSrcTypes8(&r, v)
+	tinyjsonF5cd6cf9DecodeGithubComJackalLabsBurrowContractsCw1SubkeysSrcTypes47(&r, v)
 	return r.Error()
 }
 
 // UnmarshalTinyJSON supports tinyjson.Unmarshaler interface
-func (v *QueryAllAllowance) UnmarshalTinyJSON(l *jlexer.Lexer) {
-	tinyjsonF5cd6cf9DecodeGithubComJackalLabsBurrowContractsCw1SubkeysSrcTypes8(l, v)
+func (v *ExecuteMsg) UnmarshalTinyJSON(l *jlexer.Lexer) {
+	tinyjsonF5cd6cf9DecodeGithubComJackalLabsBurrowContractsCw1SubkeysSrcTypes47(l, v)
 }
-func tinyjsonF5cd6cf9DecodeGithubComJackalLabsBurrowContractsCw1SubkeysSrcTypes9(in *jlexer.Lexer, out *PermissionInfo) {
+func tinyjsonF5cd6cf9DecodeGithubComJackalLabsBurrowContractsCw1SubkeysSrcTypes48(in *jlexer.Lexer, out *DecreaseCw20Allowance) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -817,8 +4892,14 @@ func tinyjsonF5cd6cf9DecodeGithubComJackalLabsBurrowContractsCw1SubkeysSrcTypes9
 		switch key {
 		case "spender":
 			out.Spender = string(in.String())
-		case "permissions":
-			tinyjsonF5cd6cf9DecodeGithubComJackalLabsBurrowContractsCw1SubkeysSrcTypes2(in, &out.Permissions)
+		case "token":
+			out.Token = string(in.String())
+		case "amount":
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.Amount).UnmarshalJSON(data))
+			}
+		case "expires":
+			(out.Expires).UnmarshalTinyJSON(in)
 		default:
 			in.SkipRecursive()
 		}
@@ -829,7 +4910,7 @@ func tinyjsonF5cd6cf9DecodeGithubComJackalLabsBurrowContractsCw1SubkeysSrcTypes9
 		in.Consumed()
 	}
 }
-func tinyjsonF5cd6cf9EncodeGithubComJackalLabsBurrowContractsCw1SubkeysSrcTypes9(out *jwriter.Writer, in PermissionInfo) {
+func tinyjsonF5cd6cf9EncodeGithubComJackalLabsBurrowContractsCw1SubkeysSrcTypes48(out *jwriter.Writer, in DecreaseCw20Allowance) {
 	out.RawByte('{')
 	first := true
 	_ = first
@@ -839,37 +4920,47 @@ func tinyjsonF5cd6cf9EncodeGithubComJackalLabsBurrowContractsCw1SubkeysSrcTypes9
 		out.String(string(in.Spender))
 	}
 	{
-		const prefix string = ",\"permissions\":"
+		const prefix string = ",\"token\":"
+		out.RawString(prefix)
+		out.String(string(in.Token))
+	}
+	{
+		const prefix string = ",\"amount\":"
+		out.RawString(prefix)
+		out.Raw((in.Amount).MarshalJSON())
+	}
+	{
+		const prefix string = ",\"expires\":"
 		out.RawString(prefix)
-		tinyjsonF5cd6cf9EncodeGithubComJackalLabsBurrowContractsCw1SubkeysSrcTypes2(out, in.Permissions)
+		(in.Expires).MarshalTinyJSON(out)
 	}
 	out.RawByte('}')
 }
 
 // MarshalJSON supports json.Marshaler interface
-func (v PermissionInfo) MarshalJSON() ([]byte, error) {
+func (v DecreaseCw20Allowance) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	tinyjsonF5cd6cf9EncodeGithubComJackalLabsBurrowContractsCw1SubkeysSrcTypes9(&w, v)
+	tinyjsonF5cd6cf9EncodeGithubComJackalLabsBurrowContractsCw1SubkeysSrcTypes48(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalTinyJSON supports tinyjson.Marshaler interface
-func (v PermissionInfo) MarshalTinyJSON(w *jwriter.Writer) {
-	tinyjsonF5cd6cf9EncodeGithubComJackalLabsBurrowContractsCw1SubkeysSrcTypes9(w, v)
+func (v DecreaseCw20Allowance) MarshalTinyJSON(w *jwriter.Writer) {
+	tinyjsonF5cd6cf9EncodeGithubComJackalLabsBurrowContractsCw1SubkeysSrcTypes48(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
-func (v *PermissionInfo) UnmarshalJSON(data []byte) error {
+func (v *DecreaseCw20Allowance) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	tinyjsonF5cd6cf9DecodeGithubComJackalLabsBurrowContractsCw1SubkeysSrcTypes9(&r, v)
+	tinyjsonF5cd6cf9DecodeGithubComJackalLabsBurrowContractsCw1SubkeysSrcTypes48(&r, v)
 	return r.Error()
 }
 
 // UnmarshalTinyJSON supports tinyjson.Unmarshaler interface
-func (v *PermissionInfo) UnmarshalTinyJSON(l *jlexer.Lexer) {
-	tinyjsonF5cd6cf9DecodeGithubComJackalLabsBurrowContractsCw1SubkeysSrcTypes9(l, v)
+func (v *DecreaseCw20Allowance) UnmarshalTinyJSON(l *jlexer.Lexer) {
+	tinyjsonF5cd6cf9DecodeGithubComJackalLabsBurrowContractsCw1SubkeysSrcTypes48(l, v)
 }
-func tinyjsonF5cd6cf9DecodeGithubComJackalLabsBurrowContractsCw1SubkeysSrcTypes10(in *jlexer.Lexer, out *IncreaseAllowance) {
+func tinyjsonF5cd6cf9DecodeGithubComJackalLabsBurrowContractsCw1SubkeysSrcTypes49(in *jlexer.Lexer, out *DecreaseAllowance) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -893,7 +4984,7 @@ func tinyjsonF5cd6cf9DecodeGithubComJackalLabsBurrowContractsCw1SubkeysSrcTypes1
 		case "amount":
 			(out.Amount).UnmarshalTinyJSON(in)
 		case "expires":
-			tinyjsonF5cd6cf9DecodeGithubComJackalLabsBurrowContractsCw1SubkeysSrcTypes11(in, &out.Expires)
+			(out.Expires).UnmarshalTinyJSON(in)
 		default:
 			in.SkipRecursive()
 		}
@@ -904,7 +4995,7 @@ func tinyjsonF5cd6cf9DecodeGithubComJackalLabsBurrowContractsCw1SubkeysSrcTypes1
 		in.Consumed()
 	}
 }
-func tinyjsonF5cd6cf9EncodeGithubComJackalLabsBurrowContractsCw1SubkeysSrcTypes10(out *jwriter.Writer, in IncreaseAllowance) {
+func tinyjsonF5cd6cf9EncodeGithubComJackalLabsBurrowContractsCw1SubkeysSrcTypes49(out *jwriter.Writer, in DecreaseAllowance) {
 	out.RawByte('{')
 	first := true
 	_ = first
@@ -921,35 +5012,35 @@ func tinyjsonF5cd6cf9EncodeGithubComJackalLabsBurrowContractsCw1SubkeysSrcTypes1
 	{
 		const prefix string = ",\"expires\":"
 		out.RawString(prefix)
-		tinyjsonF5cd6cf9EncodeGithubComJackalLabsBurrowContractsCw1SubkeysSrcTypes11(out, in.Expires)
+		(in.Expires).MarshalTinyJSON(out)
 	}
 	out.RawByte('}')
 }
 
 // MarshalJSON supports json.Marshaler interface
-func (v IncreaseAllowance) MarshalJSON() ([]byte, error) {
+func (v DecreaseAllowance) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	tinyjsonF5cd6cf9EncodeGithubComJackalLabsBurrowContractsCw1SubkeysSrcTypes10(&w, v)
+	tinyjsonF5cd6cf9EncodeGithubComJackalLabsBurrowContractsCw1SubkeysSrcTypes49(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalTinyJSON supports tinyjson.Marshaler interface
-func (v IncreaseAllowance) MarshalTinyJSON(w *jwriter.Writer) {
-	tinyjsonF5cd6cf9EncodeGithubComJackalLabsBurrowContractsCw1SubkeysSrcTypes10(w, v)
+func (v DecreaseAllowance) MarshalTinyJSON(w *jwriter.Writer) {
+	tinyjsonF5cd6cf9EncodeGithubComJackalLabsBurrowContractsCw1SubkeysSrcTypes49(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
-func (v *IncreaseAllowance) UnmarshalJSON(data []byte) error {
+func (v *DecreaseAllowance) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	tinyjsonF5cd6cf9DecodeGithubComJackalLabsBurrowContractsCw1SubkeysSrcTypes10(&r, v)
+	tinyjsonF5cd6cf9DecodeGithubComJackalLabsBurrowContractsCw1SubkeysSrcTypes49(&r, v)
 	return r.Error()
 }
 
 // UnmarshalTinyJSON supports tinyjson.Unmarshaler interface
-func (v *IncreaseAllowance) UnmarshalTinyJSON(l *jlexer.Lexer) {
-	tinyjsonF5cd6cf9DecodeGithubComJackalLabsBurrowContractsCw1SubkeysSrcTypes10(l, v)
+func (v *DecreaseAllowance) UnmarshalTinyJSON(l *jlexer.Lexer) {
+	tinyjsonF5cd6cf9DecodeGithubComJackalLabsBurrowContractsCw1SubkeysSrcTypes49(l, v)
 }
-func tinyjsonF5cd6cf9DecodeGithubComJackalLabsBurrowContractsCw1SubkeysSrcTypes11(in *jlexer.Lexer, out *Expiration) {
+func tinyjsonF5cd6cf9DecodeGithubComJackalLabsBurrowContractsCw1SubkeysSrcTypes50(in *jlexer.Lexer, out *CanExecuteResponse) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -968,14 +5059,10 @@ func tinyjsonF5cd6cf9DecodeGithubComJackalLabsBurrowContractsCw1SubkeysSrcTypes1
 			continue
 		}
 		switch key {
-		case "at_height":
-			out.AtHeight = uint64(in.Uint64())
-		case "at_time":
-			if data := in.Raw(); in.Ok() {
-				in.AddError((out.AtTime).UnmarshalJSON(data))
-			}
-		case "never":
-			out.Never = bool(in.Bool())
+		case "can_execute":
+			out.CanExecute = bool(in.Bool())
+		case "rule":
+			out.Rule = string(in.String())
 		default:
 			in.SkipRecursive()
 		}
@@ -986,28 +5073,47 @@ func tinyjsonF5cd6cf9DecodeGithubComJackalLabsBurrowContractsCw1SubkeysSrcTypes1
 		in.Consumed()
 	}
 }
-func tinyjsonF5cd6cf9EncodeGithubComJackalLabsBurrowContractsCw1SubkeysSrcTypes11(out *jwriter.Writer, in Expiration) {
+func tinyjsonF5cd6cf9EncodeGithubComJackalLabsBurrowContractsCw1SubkeysSrcTypes50(out *jwriter.Writer, in CanExecuteResponse) {
 	out.RawByte('{')
 	first := true
 	_ = first
 	{
-		const prefix string = ",\"at_height\":"
+		const prefix string = ",\"can_execute\":"
 		out.RawString(prefix[1:])
-		out.Uint64(uint64(in.AtHeight))
-	}
-	{
-		const prefix string = ",\"at_time\":"
-		out.RawString(prefix)
-		out.Raw((in.AtTime).MarshalJSON())
+		out.Bool(bool(in.CanExecute))
 	}
-	{
-		const prefix string = ",\"never\":"
+	if in.Rule != "" {
+		const prefix string = ",\"rule\":"
 		out.RawString(prefix)
-		out.Bool(bool(in.Never))
+		out.String(string(in.Rule))
 	}
 	out.RawByte('}')
 }
-func tinyjsonF5cd6cf9DecodeGithubComJackalLabsBurrowContractsCw1SubkeysSrcTypes12(in *jlexer.Lexer, out *ExecuteRequest) {
+
+// MarshalJSON supports json.Marshaler interface
+func (v CanExecuteResponse) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	tinyjsonF5cd6cf9EncodeGithubComJackalLabsBurrowContractsCw1SubkeysSrcTypes50(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalTinyJSON supports tinyjson.Marshaler interface
+func (v CanExecuteResponse) MarshalTinyJSON(w *jwriter.Writer) {
+	tinyjsonF5cd6cf9EncodeGithubComJackalLabsBurrowContractsCw1SubkeysSrcTypes50(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *CanExecuteResponse) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	tinyjsonF5cd6cf9DecodeGithubComJackalLabsBurrowContractsCw1SubkeysSrcTypes50(&r, v)
+	return r.Error()
+}
+
+// UnmarshalTinyJSON supports tinyjson.Unmarshaler interface
+func (v *CanExecuteResponse) UnmarshalTinyJSON(l *jlexer.Lexer) {
+	tinyjsonF5cd6cf9DecodeGithubComJackalLabsBurrowContractsCw1SubkeysSrcTypes50(l, v)
+}
+func tinyjsonF5cd6cf9DecodeGithubComJackalLabsBurrowContractsCw1SubkeysSrcTypes51(in *jlexer.Lexer, out *BatchSetPermissions) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -1026,25 +5132,25 @@ func tinyjsonF5cd6cf9DecodeGithubComJackalLabsBurrowContractsCw1SubkeysSrcTypes1
 			continue
 		}
 		switch key {
-		case "msgs":
+		case "permissions":
 			if in.IsNull() {
 				in.Skip()
-				out.Msgs = nil
+				out.Permissions = nil
 			} else {
 				in.Delim('[')
-				if out.Msgs == nil {
+				if out.Permissions == nil {
 					if !in.IsDelim(']') {
-						out.Msgs = make([]types1.CosmosMsg, 0, 0)
+						out.Permissions = make([]SetPermissions, 0, 0)
 					} else {
-						out.Msgs = []types1.CosmosMsg{}
+						out.Permissions = []SetPermissions{}
 					}
 				} else {
-					out.Msgs = (out.Msgs)[:0]
+					out.Permissions = (out.Permissions)[:0]
 				}
 				for !in.IsDelim(']') {
-					var v4 types1.CosmosMsg
-					(v4).UnmarshalTinyJSON(in)
-					out.Msgs = append(out.Msgs, v4)
+					var v31 SetPermissions
+					(v31).UnmarshalTinyJSON(in)
+					out.Permissions = append(out.Permissions, v31)
 					in.WantComma()
 				}
 				in.Delim(']')
@@ -1059,21 +5165,22 @@ func tinyjsonF5cd6cf9DecodeGithubComJackalLabsBurrowContractsCw1SubkeysSrcTypes1
 		in.Consumed()
 	}
 }
-func tinyjsonF5cd6cf9EncodeGithubComJackalLabsBurrowContractsCw1SubkeysSrcTypes12(out *jwriter.Writer, in ExecuteRequest) {
+func tinyjsonF5cd6cf9EncodeGithubComJackalLabsBurrowContractsCw1SubkeysSrcTypes51(out *jwriter.Writer, in BatchSetPermissions) {
 	out.RawByte('{')
 	first := true
 	_ = first
-	if len(in.Msgs) != 0 {
-		const prefix string = ",\"msgs\":"
-		first = false
+	{
+		const prefix string = ",\"permissions\":"
 		out.RawString(prefix[1:])
-		{
+		if in.Permissions == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
 			out.RawByte('[')
-			for v5, v6 := range in.Msgs {
-				if v5 > 0 {
+			for v32, v33 := range in.Permissions {
+				if v32 > 0 {
 					out.RawByte(',')
 				}
-				(v6).MarshalTinyJSON(out)
+				(v33).MarshalTinyJSON(out)
 			}
 			out.RawByte(']')
 		}
@@ -1082,29 +5189,29 @@ func tinyjsonF5cd6cf9EncodeGithubComJackalLabsBurrowContractsCw1SubkeysSrcTypes1
 }
 
 // MarshalJSON supports json.Marshaler interface
-func (v ExecuteRequest) MarshalJSON() ([]byte, error) {
+func (v BatchSetPermissions) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	tinyjsonF5cd6cf9EncodeGithubComJackalLabsBurrowContractsCw1SubkeysSrcTypes12(&w, v)
+	tinyjsonF5cd6cf9EncodeGithubComJackalLabsBurrowContractsCw1SubkeysSrcTypes51(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalTinyJSON supports tinyjson.Marshaler interface
-func (v ExecuteRequest) MarshalTinyJSON(w *jwriter.Writer) {
-	tinyjsonF5cd6cf9EncodeGithubComJackalLabsBurrowContractsCw1SubkeysSrcTypes12(w, v)
+func (v BatchSetPermissions) MarshalTinyJSON(w *jwriter.Writer) {
+	tinyjsonF5cd6cf9EncodeGithubComJackalLabsBurrowContractsCw1SubkeysSrcTypes51(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
-func (v *ExecuteRequest) UnmarshalJSON(data []byte) error {
+func (v *BatchSetPermissions) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	tinyjsonF5cd6cf9DecodeGithubComJackalLabsBurrowContractsCw1SubkeysSrcTypes12(&r, v)
+	tinyjsonF5cd6cf9DecodeGithubComJackalLabsBurrowContractsCw1SubkeysSrcTypes51(&r, v)
 	return r.Error()
 }
 
 // UnmarshalTinyJSON supports tinyjson.Unmarshaler interface
-func (v *ExecuteRequest) UnmarshalTinyJSON(l *jlexer.Lexer) {
-	tinyjsonF5cd6cf9DecodeGithubComJackalLabsBurrowContractsCw1SubkeysSrcTypes12(l, v)
+func (v *BatchSetPermissions) UnmarshalTinyJSON(l *jlexer.Lexer) {
+	tinyjsonF5cd6cf9DecodeGithubComJackalLabsBurrowContractsCw1SubkeysSrcTypes51(l, v)
 }
-func tinyjsonF5cd6cf9DecodeGithubComJackalLabsBurrowContractsCw1SubkeysSrcTypes13(in *jlexer.Lexer, out *ExecuteMsg) {
+func tinyjsonF5cd6cf9DecodeGithubComJackalLabsBurrowContractsCw1SubkeysSrcTypes52(in *jlexer.Lexer, out *BatchIncreaseAllowance) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -1123,163 +5230,86 @@ func tinyjsonF5cd6cf9DecodeGithubComJackalLabsBurrowContractsCw1SubkeysSrcTypes1
 			continue
 		}
 		switch key {
-		case "execute":
-			if in.IsNull() {
-				in.Skip()
-				out.ExecuteRequest = nil
-			} else {
-				if out.ExecuteRequest == nil {
-					out.ExecuteRequest = new(ExecuteRequest)
-				}
-				(*out.ExecuteRequest).UnmarshalTinyJSON(in)
-			}
-		case "freeze":
-			if in.IsNull() {
-				in.Skip()
-				out.FreezeRequest = nil
-			} else {
-				if out.FreezeRequest == nil {
-					out.FreezeRequest = new(types.FreezeRequest)
-				}
-				(*out.FreezeRequest).UnmarshalTinyJSON(in)
-			}
-		case "update_admins":
-			if in.IsNull() {
-				in.Skip()
-				out.UpdateAdminsRequest = nil
-			} else {
-				if out.UpdateAdminsRequest == nil {
-					out.UpdateAdminsRequest = new(types.UpdateAdminsRequest)
-				}
-				(*out.UpdateAdminsRequest).UnmarshalTinyJSON(in)
-			}
-		case "increase_allowance":
-			if in.IsNull() {
-				in.Skip()
-				out.IncreaseAllowance = nil
-			} else {
-				if out.IncreaseAllowance == nil {
-					out.IncreaseAllowance = new(IncreaseAllowance)
-				}
-				(*out.IncreaseAllowance).UnmarshalTinyJSON(in)
-			}
-		case "decrease_allowance":
-			if in.IsNull() {
-				in.Skip()
-				out.DecreaseAllowance = nil
-			} else {
-				if out.DecreaseAllowance == nil {
-					out.DecreaseAllowance = new(DecreaseAllowance)
-				}
-				(*out.DecreaseAllowance).UnmarshalTinyJSON(in)
-			}
-		case "set_permissions":
+		case "allowances":
 			if in.IsNull() {
 				in.Skip()
-				out.SetPermissions = nil
+				out.Allowances = nil
 			} else {
-				if out.SetPermissions == nil {
-					out.SetPermissions = new(SetPermissions)
+				in.Delim('[')
+				if out.Allowances == nil {
+					if !in.IsDelim(']') {
+						out.Allowances = make([]IncreaseAllowance, 0, 0)
+					} else {
+						out.Allowances = []IncreaseAllowance{}
+					}
+				} else {
+					out.Allowances = (out.Allowances)[:0]
 				}
-				(*out.SetPermissions).UnmarshalTinyJSON(in)
-			}
-		default:
-			in.SkipRecursive()
-		}
-		in.WantComma()
-	}
-	in.Delim('}')
-	if isTopLevel {
-		in.Consumed()
-	}
-}
-func tinyjsonF5cd6cf9EncodeGithubComJackalLabsBurrowContractsCw1SubkeysSrcTypes13(out *jwriter.Writer, in ExecuteMsg) {
-	out.RawByte('{')
-	first := true
-	_ = first
-	if in.ExecuteRequest != nil {
-		const prefix string = ",\"execute\":"
-		first = false
-		out.RawString(prefix[1:])
-		(*in.ExecuteRequest).MarshalTinyJSON(out)
-	}
-	if in.FreezeRequest != nil {
-		const prefix string = ",\"freeze\":"
-		if first {
-			first = false
-			out.RawString(prefix[1:])
-		} else {
-			out.RawString(prefix)
-		}
-		(*in.FreezeRequest).MarshalTinyJSON(out)
-	}
-	if in.UpdateAdminsRequest != nil {
-		const prefix string = ",\"update_admins\":"
-		if first {
-			first = false
-			out.RawString(prefix[1:])
-		} else {
-			out.RawString(prefix)
-		}
-		(*in.UpdateAdminsRequest).MarshalTinyJSON(out)
-	}
-	if in.IncreaseAllowance != nil {
-		const prefix string = ",\"increase_allowance\":"
-		if first {
-			first = false
-			out.RawString(prefix[1:])
-		} else {
-			out.RawString(prefix)
-		}
-		(*in.IncreaseAllowance).MarshalTinyJSON(out)
-	}
-	if in.DecreaseAllowance != nil {
-		const prefix string = ",\"decrease_allowance\":"
-		if first {
-			first = false
-			out.RawString(prefix[1:])
-		} else {
-			out.RawString(prefix)
+				for !in.IsDelim(']') {
+					var v34 IncreaseAllowance
+					(v34).UnmarshalTinyJSON(in)
+					out.Allowances = append(out.Allowances, v34)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		default:
+			in.SkipRecursive()
 		}
-		(*in.DecreaseAllowance).MarshalTinyJSON(out)
+		in.WantComma()
 	}
-	if in.SetPermissions != nil {
-		const prefix string = ",\"set_permissions\":"
-		if first {
-			first = false
-			out.RawString(prefix[1:])
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func tinyjsonF5cd6cf9EncodeGithubComJackalLabsBurrowContractsCw1SubkeysSrcTypes52(out *jwriter.Writer, in BatchIncreaseAllowance) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"allowances\":"
+		out.RawString(prefix[1:])
+		if in.Allowances == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
 		} else {
-			out.RawString(prefix)
+			out.RawByte('[')
+			for v35, v36 := range in.Allowances {
+				if v35 > 0 {
+					out.RawByte(',')
+				}
+				(v36).MarshalTinyJSON(out)
+			}
+			out.RawByte(']')
 		}
-		(*in.SetPermissions).MarshalTinyJSON(out)
 	}
 	out.RawByte('}')
 }
 
 // MarshalJSON supports json.Marshaler interface
-func (v ExecuteMsg) MarshalJSON() ([]byte, error) {
+func (v BatchIncreaseAllowance) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	tinyjsonF5cd6cf9EncodeGithubComJackalLabsBurrowContractsCw1SubkeysSrcTypes13(&w, v)
+	tinyjsonF5cd6cf9EncodeGithubComJackalLabsBurrowContractsCw1SubkeysSrcTypes52(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalTinyJSON supports tinyjson.Marshaler interface
-func (v ExecuteMsg) MarshalTinyJSON(w *jwriter.Writer) {
-	tinyjsonF5cd6cf9EncodeGithubComJackalLabsBurrowContractsCw1SubkeysSrcTypes13(w, v)
+func (v BatchIncreaseAllowance) MarshalTinyJSON(w *jwriter.Writer) {
+	tinyjsonF5cd6cf9EncodeGithubComJackalLabsBurrowContractsCw1SubkeysSrcTypes52(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
-func (v *ExecuteMsg) UnmarshalJSON(data []byte) error {
+func (v *BatchIncreaseAllowance) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	tinyjsonF5cd6cf9DecodeGithubComJackalLabsBurrowContractsCw1SubkeysSrcTypes13(&r, v)
+	tinyjsonF5cd6cf9DecodeGithubComJackalLabsBurrowContractsCw1SubkeysSrcTypes52(&r, v)
 	return r.Error()
 }
 
 // UnmarshalTinyJSON supports tinyjson.Unmarshaler interface
-func (v *ExecuteMsg) UnmarshalTinyJSON(l *jlexer.Lexer) {
-	tinyjsonF5cd6cf9DecodeGithubComJackalLabsBurrowContractsCw1SubkeysSrcTypes13(l, v)
+func (v *BatchIncreaseAllowance) UnmarshalTinyJSON(l *jlexer.Lexer) {
+	tinyjsonF5cd6cf9DecodeGithubComJackalLabsBurrowContractsCw1SubkeysSrcTypes52(l, v)
 }
-func tinyjsonF5cd6cf9DecodeGithubComJackalLabsBurrowContractsCw1SubkeysSrcTypes14(in *jlexer.Lexer, out *DecreaseAllowance) {
+func tinyjsonF5cd6cf9DecodeGithubComJackalLabsBurrowContractsCw1SubkeysSrcTypes53(in *jlexer.Lexer, out *BatchDecreaseAllowance) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -1298,12 +5328,29 @@ func tinyjsonF5cd6cf9DecodeGithubComJackalLabsBurrowContractsCw1SubkeysSrcTypes1
 			continue
 		}
 		switch key {
-		case "spender":
-			out.Spender = string(in.String())
-		case "amount":
-			(out.Amount).UnmarshalTinyJSON(in)
-		case "expires":
-			tinyjsonF5cd6cf9DecodeGithubComJackalLabsBurrowContractsCw1SubkeysSrcTypes11(in, &out.Expires)
+		case "allowances":
+			if in.IsNull() {
+				in.Skip()
+				out.Allowances = nil
+			} else {
+				in.Delim('[')
+				if out.Allowances == nil {
+					if !in.IsDelim(']') {
+						out.Allowances = make([]DecreaseAllowance, 0, 0)
+					} else {
+						out.Allowances = []DecreaseAllowance{}
+					}
+				} else {
+					out.Allowances = (out.Allowances)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v37 DecreaseAllowance
+					(v37).UnmarshalTinyJSON(in)
+					out.Allowances = append(out.Allowances, v37)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
 		default:
 			in.SkipRecursive()
 		}
@@ -1314,52 +5361,53 @@ func tinyjsonF5cd6cf9DecodeGithubComJackalLabsBurrowContractsCw1SubkeysSrcTypes1
 		in.Consumed()
 	}
 }
-func tinyjsonF5cd6cf9EncodeGithubComJackalLabsBurrowContractsCw1SubkeysSrcTypes14(out *jwriter.Writer, in DecreaseAllowance) {
+func tinyjsonF5cd6cf9EncodeGithubComJackalLabsBurrowContractsCw1SubkeysSrcTypes53(out *jwriter.Writer, in BatchDecreaseAllowance) {
 	out.RawByte('{')
 	first := true
 	_ = first
 	{
-		const prefix string = ",\"spender\":"
+		const prefix string = ",\"allowances\":"
 		out.RawString(prefix[1:])
-		out.String(string(in.Spender))
-	}
-	{
-		const prefix string = ",\"amount\":"
-		out.RawString(prefix)
-		(in.Amount).MarshalTinyJSON(out)
-	}
-	{
-		const prefix string = ",\"expires\":"
-		out.RawString(prefix)
-		tinyjsonF5cd6cf9EncodeGithubComJackalLabsBurrowContractsCw1SubkeysSrcTypes11(out, in.Expires)
+		if in.Allowances == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v38, v39 := range in.Allowances {
+				if v38 > 0 {
+					out.RawByte(',')
+				}
+				(v39).MarshalTinyJSON(out)
+			}
+			out.RawByte(']')
+		}
 	}
 	out.RawByte('}')
 }
 
 // MarshalJSON supports json.Marshaler interface
-func (v DecreaseAllowance) MarshalJSON() ([]byte, error) {
+func (v BatchDecreaseAllowance) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	tinyjsonF5cd6cf9EncodeGithubComJackalLabsBurrowContractsCw1SubkeysSrcTypes14(&w, v)
+	tinyjsonF5cd6cf9EncodeGithubComJackalLabsBurrowContractsCw1SubkeysSrcTypes53(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalTinyJSON supports tinyjson.Marshaler interface
-func (v DecreaseAllowance) MarshalTinyJSON(w *jwriter.Writer) {
-	tinyjsonF5cd6cf9EncodeGithubComJackalLabsBurrowContractsCw1SubkeysSrcTypes14(w, v)
+func (v BatchDecreaseAllowance) MarshalTinyJSON(w *jwriter.Writer) {
+	tinyjsonF5cd6cf9EncodeGithubComJackalLabsBurrowContractsCw1SubkeysSrcTypes53(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
-func (v *DecreaseAllowance) UnmarshalJSON(data []byte) error {
+func (v *BatchDecreaseAllowance) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	tinyjsonF5cd6cf9DecodeGithubComJackalLabsBurrowContractsCw1SubkeysSrcTypes14(&r, v)
+	tinyjsonF5cd6cf9DecodeGithubComJackalLabsBurrowContractsCw1SubkeysSrcTypes53(&r, v)
 	return r.Error()
 }
 
 // UnmarshalTinyJSON supports tinyjson.Unmarshaler interface
-func (v *DecreaseAllowance) UnmarshalTinyJSON(l *jlexer.Lexer) {
-	tinyjsonF5cd6cf9DecodeGithubComJackalLabsBurrowContractsCw1SubkeysSrcTypes14(l, v)
+func (v *BatchDecreaseAllowance) UnmarshalTinyJSON(l *jlexer.Lexer) {
+	tinyjsonF5cd6cf9DecodeGithubComJackalLabsBurrowContractsCw1SubkeysSrcTypes53(l, v)
 }
-func tinyjsonF5cd6cf9DecodeGithubComJackalLabsBurrowContractsCw1SubkeysSrcTypes15(in *jlexer.Lexer, out *CanExecuteResponse) {
+func tinyjsonF5cd6cf9DecodeGithubComJackalLabsBurrowContractsCw1SubkeysSrcTypes54(in *jlexer.Lexer, out *ApproveSpend) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -1378,8 +5426,31 @@ func tinyjsonF5cd6cf9DecodeGithubComJackalLabsBurrowContractsCw1SubkeysSrcTypes1
 			continue
 		}
 		switch key {
-		case "can_execute":
-			out.CanExecute = bool(in.Bool())
+		case "id":
+			out.ID = uint64(in.Uint64())
+		case "top_up":
+			if in.IsNull() {
+				in.Skip()
+				out.TopUp = nil
+			} else {
+				in.Delim('[')
+				if out.TopUp == nil {
+					if !in.IsDelim(']') {
+						out.TopUp = make([]types.Coin, 0, 2)
+					} else {
+						out.TopUp = []types.Coin{}
+					}
+				} else {
+					out.TopUp = (out.TopUp)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v40 types.Coin
+					(v40).UnmarshalTinyJSON(in)
+					out.TopUp = append(out.TopUp, v40)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
 		default:
 			in.SkipRecursive()
 		}
@@ -1390,42 +5461,56 @@ func tinyjsonF5cd6cf9DecodeGithubComJackalLabsBurrowContractsCw1SubkeysSrcTypes1
 		in.Consumed()
 	}
 }
-func tinyjsonF5cd6cf9EncodeGithubComJackalLabsBurrowContractsCw1SubkeysSrcTypes15(out *jwriter.Writer, in CanExecuteResponse) {
+func tinyjsonF5cd6cf9EncodeGithubComJackalLabsBurrowContractsCw1SubkeysSrcTypes54(out *jwriter.Writer, in ApproveSpend) {
 	out.RawByte('{')
 	first := true
 	_ = first
 	{
-		const prefix string = ",\"can_execute\":"
+		const prefix string = ",\"id\":"
 		out.RawString(prefix[1:])
-		out.Bool(bool(in.CanExecute))
+		out.Uint64(uint64(in.ID))
+	}
+	if len(in.TopUp) != 0 {
+		const prefix string = ",\"top_up\":"
+		out.RawString(prefix)
+		{
+			out.RawByte('[')
+			for v41, v42 := range in.TopUp {
+				if v41 > 0 {
+					out.RawByte(',')
+				}
+				(v42).MarshalTinyJSON(out)
+			}
+			out.RawByte(']')
+		}
 	}
 	out.RawByte('}')
 }
 
 // MarshalJSON supports json.Marshaler interface
-func (v CanExecuteResponse) MarshalJSON() ([]byte, error) {
+func (v ApproveSpend) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	tinyjsonF5cd6cf9EncodeGithubComJackalLabsBurrowContractsCw1SubkeysSrcTypes15(&w, v)
+	tinyjsonF5cd6cf9EncodeGithubComJackalLabsBurrowContractsCw1SubkeysSrcTypes54(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalTinyJSON supports tinyjson.Marshaler interface
-func (v CanExecuteResponse) MarshalTinyJSON(w *jwriter.Writer) {
-	tinyjsonF5cd6cf9EncodeGithubComJackalLabsBurrowContractsCw1SubkeysSrcTypes15(w, v)
+func (v ApproveSpend) MarshalTinyJSON(w *jwriter.Writer) {
+	tinyjsonF5cd6cf9EncodeGithubComJackalLabsBurrowContractsCw1SubkeysSrcTypes54(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
-func (v *CanExecuteResponse) UnmarshalJSON(data []byte) error {
+func (v *ApproveSpend) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	tinyjsonF5cd6cf9DecodeGithubComJackalLabsBurrowContractsCw1SubkeysSrcTypes15(&r, v)
+	tinyjsonF5cd6cf9DecodeGithubComJackalLabsBurrowContractsCw1SubkeysSrcTypes54(&r, v)
 	return r.Error()
 }
 
 // UnmarshalTinyJSON supports tinyjson.Unmarshaler interface
-func (v *CanExecuteResponse) UnmarshalTinyJSON(l *jlexer.Lexer) {
-	tinyjsonF5cd6cf9DecodeGithubComJackalLabsBurrowContractsCw1SubkeysSrcTypes15(l, v)
+func (v *ApproveSpend) UnmarshalTinyJSON(l *jlexer.Lexer) {
+	tinyjsonF5cd6cf9DecodeGithubComJackalLabsBurrowContractsCw1SubkeysSrcTypes54(l, v)
 }
-func tinyjsonF5cd6cf9DecodeGithubComJackalLabsBurrowContractsCw1SubkeysSrcTypes16(in *jlexer.Lexer, out *AllowanceInfo) {
+func tinyjsonF5cd6cf9DecodeGithubComJackalLabsBurrowContractsCw1SubkeysSrcTypes55(in *jlexer.Lexer, out *AllowanceInfo) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -1447,9 +5532,9 @@ func tinyjsonF5cd6cf9DecodeGithubComJackalLabsBurrowContractsCw1SubkeysSrcTypes1
 		case "spender":
 			out.Spender = string(in.String())
 		case "balance":
-			tinyjsonF5cd6cf9DecodeGithubComJackalLabsBurrowContractsCw1SubkeysSrcTypes17(in, &out.Balance)
+			(out.Balance).UnmarshalTinyJSON(in)
 		case "expires":
-			tinyjsonF5cd6cf9DecodeGithubComJackalLabsBurrowContractsCw1SubkeysSrcTypes11(in, &out.Expires)
+			(out.Expires).UnmarshalTinyJSON(in)
 		default:
 			in.SkipRecursive()
 		}
@@ -1460,7 +5545,7 @@ func tinyjsonF5cd6cf9DecodeGithubComJackalLabsBurrowContractsCw1SubkeysSrcTypes1
 		in.Consumed()
 	}
 }
-func tinyjsonF5cd6cf9EncodeGithubComJackalLabsBurrowContractsCw1SubkeysSrcTypes16(out *jwriter.Writer, in AllowanceInfo) {
+func tinyjsonF5cd6cf9EncodeGithubComJackalLabsBurrowContractsCw1SubkeysSrcTypes55(out *jwriter.Writer, in AllowanceInfo) {
 	out.RawByte('{')
 	first := true
 	_ = first
@@ -1472,12 +5557,12 @@ func tinyjsonF5cd6cf9EncodeGithubComJackalLabsBurrowContractsCw1SubkeysSrcTypes1
 	{
 		const prefix string = ",\"balance\":"
 		out.RawString(prefix)
-		tinyjsonF5cd6cf9EncodeGithubComJackalLabsBurrowContractsCw1SubkeysSrcTypes17(out, in.Balance)
+		(in.Balance).MarshalTinyJSON(out)
 	}
 	{
 		const prefix string = ",\"expires\":"
 		out.RawString(prefix)
-		tinyjsonF5cd6cf9EncodeGithubComJackalLabsBurrowContractsCw1SubkeysSrcTypes11(out, in.Expires)
+		(in.Expires).MarshalTinyJSON(out)
 	}
 	out.RawByte('}')
 }
@@ -1485,101 +5570,27 @@ func tinyjsonF5cd6cf9EncodeGithubComJackalLabsBurrowContractsCw1SubkeysSrcTypes1
 // MarshalJSON supports json.Marshaler interface
 func (v AllowanceInfo) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	tinyjsonF5cd6cf9EncodeGithubComJackalLabsBurrowContractsCw1SubkeysSrcTypes16(&w, v)
+	tinyjsonF5cd6cf9EncodeGithubComJackalLabsBurrowContractsCw1SubkeysSrcTypes55(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalTinyJSON supports tinyjson.Marshaler interface
 func (v AllowanceInfo) MarshalTinyJSON(w *jwriter.Writer) {
-	tinyjsonF5cd6cf9EncodeGithubComJackalLabsBurrowContractsCw1SubkeysSrcTypes16(w, v)
+	tinyjsonF5cd6cf9EncodeGithubComJackalLabsBurrowContractsCw1SubkeysSrcTypes55(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
 func (v *AllowanceInfo) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	tinyjsonF5cd6cf9DecodeGithubComJackalLabsBurrowContractsCw1SubkeysSrcTypes16(&r, v)
+	tinyjsonF5cd6cf9DecodeGithubComJackalLabsBurrowContractsCw1SubkeysSrcTypes55(&r, v)
 	return r.Error()
 }
 
 // UnmarshalTinyJSON supports tinyjson.Unmarshaler interface
 func (v *AllowanceInfo) UnmarshalTinyJSON(l *jlexer.Lexer) {
-	tinyjsonF5cd6cf9DecodeGithubComJackalLabsBurrowContractsCw1SubkeysSrcTypes16(l, v)
-}
-func tinyjsonF5cd6cf9DecodeGithubComJackalLabsBurrowContractsCw1SubkeysSrcTypes17(in *jlexer.Lexer, out *NativeBalance) {
-	isTopLevel := in.IsStart()
-	if in.IsNull() {
-		if isTopLevel {
-			in.Consumed()
-		}
-		in.Skip()
-		return
-	}
-	in.Delim('{')
-	for !in.IsDelim('}') {
-		key := in.UnsafeFieldName(false)
-		in.WantColon()
-		if in.IsNull() {
-			in.Skip()
-			in.WantComma()
-			continue
-		}
-		switch key {
-		case "coins":
-			if in.IsNull() {
-				in.Skip()
-				out.Coins = nil
-			} else {
-				in.Delim('[')
-				if out.Coins == nil {
-					if !in.IsDelim(']') {
-						out.Coins = make([]types1.Coin, 0, 2)
-					} else {
-						out.Coins = []types1.Coin{}
-					}
-				} else {
-					out.Coins = (out.Coins)[:0]
-				}
-				for !in.IsDelim(']') {
-					var v7 types1.Coin
-					(v7).UnmarshalTinyJSON(in)
-					out.Coins = append(out.Coins, v7)
-					in.WantComma()
-				}
-				in.Delim(']')
-			}
-		default:
-			in.SkipRecursive()
-		}
-		in.WantComma()
-	}
-	in.Delim('}')
-	if isTopLevel {
-		in.Consumed()
-	}
-}
-func tinyjsonF5cd6cf9EncodeGithubComJackalLabsBurrowContractsCw1SubkeysSrcTypes17(out *jwriter.Writer, in NativeBalance) {
-	out.RawByte('{')
-	first := true
-	_ = first
-	{
-		const prefix string = ",\"coins\":"
-		out.RawString(prefix[1:])
-		if in.Coins == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
-			out.RawString("null")
-		} else {
-			out.RawByte('[')
-			for v8, v9 := range in.Coins {
-				if v8 > 0 {
-					out.RawByte(',')
-				}
-				(v9).MarshalTinyJSON(out)
-			}
-			out.RawByte(']')
-		}
-	}
-	out.RawByte('}')
+	tinyjsonF5cd6cf9DecodeGithubComJackalLabsBurrowContractsCw1SubkeysSrcTypes55(l, v)
 }
-func tinyjsonF5cd6cf9DecodeGithubComJackalLabsBurrowContractsCw1SubkeysSrcTypes18(in *jlexer.Lexer, out *AllPermissionsResponse) {
+func tinyjsonF5cd6cf9DecodeGithubComJackalLabsBurrowContractsCw1SubkeysSrcTypes56(in *jlexer.Lexer, out *AllPermissionsResponse) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -1606,7 +5617,7 @@ func tinyjsonF5cd6cf9DecodeGithubComJackalLabsBurrowContractsCw1SubkeysSrcTypes1
 				in.Delim('[')
 				if out.Permissions == nil {
 					if !in.IsDelim(']') {
-						out.Permissions = make([]PermissionInfo, 0, 2)
+						out.Permissions = make([]PermissionInfo, 0, 0)
 					} else {
 						out.Permissions = []PermissionInfo{}
 					}
@@ -1614,9 +5625,9 @@ func tinyjsonF5cd6cf9DecodeGithubComJackalLabsBurrowContractsCw1SubkeysSrcTypes1
 					out.Permissions = (out.Permissions)[:0]
 				}
 				for !in.IsDelim(']') {
-					var v10 PermissionInfo
-					(v10).UnmarshalTinyJSON(in)
-					out.Permissions = append(out.Permissions, v10)
+					var v43 PermissionInfo
+					(v43).UnmarshalTinyJSON(in)
+					out.Permissions = append(out.Permissions, v43)
 					in.WantComma()
 				}
 				in.Delim(']')
@@ -1631,7 +5642,7 @@ func tinyjsonF5cd6cf9DecodeGithubComJackalLabsBurrowContractsCw1SubkeysSrcTypes1
 		in.Consumed()
 	}
 }
-func tinyjsonF5cd6cf9EncodeGithubComJackalLabsBurrowContractsCw1SubkeysSrcTypes18(out *jwriter.Writer, in AllPermissionsResponse) {
+func tinyjsonF5cd6cf9EncodeGithubComJackalLabsBurrowContractsCw1SubkeysSrcTypes56(out *jwriter.Writer, in AllPermissionsResponse) {
 	out.RawByte('{')
 	first := true
 	_ = first
@@ -1642,11 +5653,11 @@ func tinyjsonF5cd6cf9EncodeGithubComJackalLabsBurrowContractsCw1SubkeysSrcTypes1
 			out.RawString("null")
 		} else {
 			out.RawByte('[')
-			for v11, v12 := range in.Permissions {
-				if v11 > 0 {
+			for v44, v45 := range in.Permissions {
+				if v44 > 0 {
 					out.RawByte(',')
 				}
-				(v12).MarshalTinyJSON(out)
+				(v45).MarshalTinyJSON(out)
 			}
 			out.RawByte(']')
 		}
@@ -1657,27 +5668,27 @@ func tinyjsonF5cd6cf9EncodeGithubComJackalLabsBurrowContractsCw1SubkeysSrcTypes1
 // MarshalJSON supports json.Marshaler interface
 func (v AllPermissionsResponse) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	tinyjsonF5cd6cf9EncodeGithubComJackalLabsBurrowContractsCw1SubkeysSrcTypes18(&w, v)
+	tinyjsonF5cd6cf9EncodeGithubComJackalLabsBurrowContractsCw1SubkeysSrcTypes56(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalTinyJSON supports tinyjson.Marshaler interface
 func (v AllPermissionsResponse) MarshalTinyJSON(w *jwriter.Writer) {
-	tinyjsonF5cd6cf9EncodeGithubComJackalLabsBurrowContractsCw1SubkeysSrcTypes18(w, v)
+	tinyjsonF5cd6cf9EncodeGithubComJackalLabsBurrowContractsCw1SubkeysSrcTypes56(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
 func (v *AllPermissionsResponse) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	tinyjsonF5cd6cf9DecodeGithubComJackalLabsBurrowContractsCw1SubkeysSrcTypes18(&r, v)
+	tinyjsonF5cd6cf9DecodeGithubComJackalLabsBurrowContractsCw1SubkeysSrcTypes56(&r, v)
 	return r.Error()
 }
 
 // UnmarshalTinyJSON supports tinyjson.Unmarshaler interface
 func (v *AllPermissionsResponse) UnmarshalTinyJSON(l *jlexer.Lexer) {
-	tinyjsonF5cd6cf9DecodeGithubComJackalLabsBurrowContractsCw1SubkeysSrcTypes18(l, v)
+	tinyjsonF5cd6cf9DecodeGithubComJackalLabsBurrowContractsCw1SubkeysSrcTypes56(l, v)
 }
-func tinyjsonF5cd6cf9DecodeGithubComJackalLabsBurrowContractsCw1SubkeysSrcTypes19(in *jlexer.Lexer, out *AllAllowancesResponse) {
+func tinyjsonF5cd6cf9DecodeGithubComJackalLabsBurrowContractsCw1SubkeysSrcTypes57(in *jlexer.Lexer, out *AllAllowancesResponse) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -1712,9 +5723,9 @@ func tinyjsonF5cd6cf9DecodeGithubComJackalLabsBurrowContractsCw1SubkeysSrcTypes1
 					out.Allowances = (out.Allowances)[:0]
 				}
 				for !in.IsDelim(']') {
-					var v13 AllowanceInfo
-					(v13).UnmarshalTinyJSON(in)
-					out.Allowances = append(out.Allowances, v13)
+					var v46 AllowanceInfo
+					(v46).UnmarshalTinyJSON(in)
+					out.Allowances = append(out.Allowances, v46)
 					in.WantComma()
 				}
 				in.Delim(']')
@@ -1729,7 +5740,7 @@ func tinyjsonF5cd6cf9DecodeGithubComJackalLabsBurrowContractsCw1SubkeysSrcTypes1
 		in.Consumed()
 	}
 }
-func tinyjsonF5cd6cf9EncodeGithubComJackalLabsBurrowContractsCw1SubkeysSrcTypes19(out *jwriter.Writer, in AllAllowancesResponse) {
+func tinyjsonF5cd6cf9EncodeGithubComJackalLabsBurrowContractsCw1SubkeysSrcTypes57(out *jwriter.Writer, in AllAllowancesResponse) {
 	out.RawByte('{')
 	first := true
 	_ = first
@@ -1740,11 +5751,11 @@ func tinyjsonF5cd6cf9EncodeGithubComJackalLabsBurrowContractsCw1SubkeysSrcTypes1
 			out.RawString("null")
 		} else {
 			out.RawByte('[')
-			for v14, v15 := range in.Allowances {
-				if v14 > 0 {
+			for v47, v48 := range in.Allowances {
+				if v47 > 0 {
 					out.RawByte(',')
 				}
-				(v15).MarshalTinyJSON(out)
+				(v48).MarshalTinyJSON(out)
 			}
 			out.RawByte(']')
 		}
@@ -1755,27 +5766,27 @@ func tinyjsonF5cd6cf9EncodeGithubComJackalLabsBurrowContractsCw1SubkeysSrcTypes1
 // MarshalJSON supports json.Marshaler interface
 func (v AllAllowancesResponse) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	tinyjsonF5cd6cf9EncodeGithubComJackalLabsBurrowContractsCw1SubkeysSrcTypes19(&w, v)
+	tinyjsonF5cd6cf9EncodeGithubComJackalLabsBurrowContractsCw1SubkeysSrcTypes57(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalTinyJSON supports tinyjson.Marshaler interface
 func (v AllAllowancesResponse) MarshalTinyJSON(w *jwriter.Writer) {
-	tinyjsonF5cd6cf9EncodeGithubComJackalLabsBurrowContractsCw1SubkeysSrcTypes19(w, v)
+	tinyjsonF5cd6cf9EncodeGithubComJackalLabsBurrowContractsCw1SubkeysSrcTypes57(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
 func (v *AllAllowancesResponse) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	tinyjsonF5cd6cf9DecodeGithubComJackalLabsBurrowContractsCw1SubkeysSrcTypes19(&r, v)
+	tinyjsonF5cd6cf9DecodeGithubComJackalLabsBurrowContractsCw1SubkeysSrcTypes57(&r, v)
 	return r.Error()
 }
 
 // UnmarshalTinyJSON supports tinyjson.Unmarshaler interface
 func (v *AllAllowancesResponse) UnmarshalTinyJSON(l *jlexer.Lexer) {
-	tinyjsonF5cd6cf9DecodeGithubComJackalLabsBurrowContractsCw1SubkeysSrcTypes19(l, v)
+	tinyjsonF5cd6cf9DecodeGithubComJackalLabsBurrowContractsCw1SubkeysSrcTypes57(l, v)
 }
-func tinyjsonF5cd6cf9DecodeGithubComJackalLabsBurrowContractsCw1SubkeysSrcTypes20(in *jlexer.Lexer, out *AdminListResponse) {
+func tinyjsonF5cd6cf9DecodeGithubComJackalLabsBurrowContractsCw1SubkeysSrcTypes58(in *jlexer.Lexer, out *AdminListResponse) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -1810,9 +5821,9 @@ func tinyjsonF5cd6cf9DecodeGithubComJackalLabsBurrowContractsCw1SubkeysSrcTypes2
 					out.Admins = (out.Admins)[:0]
 				}
 				for !in.IsDelim(']') {
-					var v16 string
-					v16 = string(in.String())
-					out.Admins = append(out.Admins, v16)
+					var v49 string
+					v49 = string(in.String())
+					out.Admins = append(out.Admins, v49)
 					in.WantComma()
 				}
 				in.Delim(']')
@@ -1829,7 +5840,7 @@ func tinyjsonF5cd6cf9DecodeGithubComJackalLabsBurrowContractsCw1SubkeysSrcTypes2
 		in.Consumed()
 	}
 }
-func tinyjsonF5cd6cf9EncodeGithubComJackalLabsBurrowContractsCw1SubkeysSrcTypes20(out *jwriter.Writer, in AdminListResponse) {
+func tinyjsonF5cd6cf9EncodeGithubComJackalLabsBurrowContractsCw1SubkeysSrcTypes58(out *jwriter.Writer, in AdminListResponse) {
 	out.RawByte('{')
 	first := true
 	_ = first
@@ -1840,11 +5851,11 @@ func tinyjsonF5cd6cf9EncodeGithubComJackalLabsBurrowContractsCw1SubkeysSrcTypes2
 			out.RawString("null")
 		} else {
 			out.RawByte('[')
-			for v17, v18 := range in.Admins {
-				if v17 > 0 {
+			for v50, v51 := range in.Admins {
+				if v50 > 0 {
 					out.RawByte(',')
 				}
-				out.String(string(v18))
+				out.String(string(v51))
 			}
 			out.RawByte(']')
 		}
@@ -1860,23 +5871,89 @@ func tinyjsonF5cd6cf9EncodeGithubComJackalLabsBurrowContractsCw1SubkeysSrcTypes2
 // MarshalJSON supports json.Marshaler interface
 func (v AdminListResponse) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	tinyjsonF5cd6cf9EncodeGithubComJackalLabsBurrowContractsCw1SubkeysSrcTypes20(&w, v)
+	tinyjsonF5cd6cf9EncodeGithubComJackalLabsBurrowContractsCw1SubkeysSrcTypes58(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalTinyJSON supports tinyjson.Marshaler interface
 func (v AdminListResponse) MarshalTinyJSON(w *jwriter.Writer) {
-	tinyjsonF5cd6cf9EncodeGithubComJackalLabsBurrowContractsCw1SubkeysSrcTypes20(w, v)
+	tinyjsonF5cd6cf9EncodeGithubComJackalLabsBurrowContractsCw1SubkeysSrcTypes58(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
 func (v *AdminListResponse) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	tinyjsonF5cd6cf9DecodeGithubComJackalLabsBurrowContractsCw1SubkeysSrcTypes20(&r, v)
+	tinyjsonF5cd6cf9DecodeGithubComJackalLabsBurrowContractsCw1SubkeysSrcTypes58(&r, v)
 	return r.Error()
 }
 
 // UnmarshalTinyJSON supports tinyjson.Unmarshaler interface
 func (v *AdminListResponse) UnmarshalTinyJSON(l *jlexer.Lexer) {
-	tinyjsonF5cd6cf9DecodeGithubComJackalLabsBurrowContractsCw1SubkeysSrcTypes20(l, v)
+	tinyjsonF5cd6cf9DecodeGithubComJackalLabsBurrowContractsCw1SubkeysSrcTypes58(l, v)
+}
+func tinyjsonF5cd6cf9DecodeGithubComJackalLabsBurrowContractsCw1SubkeysSrcTypes59(in *jlexer.Lexer, out *AddHook) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "addr":
+			out.Addr = string(in.String())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func tinyjsonF5cd6cf9EncodeGithubComJackalLabsBurrowContractsCw1SubkeysSrcTypes59(out *jwriter.Writer, in AddHook) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"addr\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.Addr))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v AddHook) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	tinyjsonF5cd6cf9EncodeGithubComJackalLabsBurrowContractsCw1SubkeysSrcTypes59(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalTinyJSON supports tinyjson.Marshaler interface
+func (v AddHook) MarshalTinyJSON(w *jwriter.Writer) {
+	tinyjsonF5cd6cf9EncodeGithubComJackalLabsBurrowContractsCw1SubkeysSrcTypes59(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *AddHook) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	tinyjsonF5cd6cf9DecodeGithubComJackalLabsBurrowContractsCw1SubkeysSrcTypes59(&r, v)
+	return r.Error()
+}
+
+// UnmarshalTinyJSON supports tinyjson.Unmarshaler interface
+func (v *AddHook) UnmarshalTinyJSON(l *jlexer.Lexer) {
+	tinyjsonF5cd6cf9DecodeGithubComJackalLabsBurrowContractsCw1SubkeysSrcTypes59(l, v)
 }
@@ -0,0 +1,95 @@
+package types
+
+import (
+	"errors"
+
+	"github.com/CosmWasm/cosmwasm-go/std/math"
+)
+
+// PRICE_PRECISION scales prices, a price is the quote units paid for one base unit times 10^18
+var PRICE_PRECISION = math.NewUint128FromUint64(1_000_000_000_000_000_000)
+
+// OracleConfig points subkey quote allowances at a price oracle
+type OracleConfig struct {
+	Oracle string `json:"oracle"`
+	/// Quote is the denom quote allowances are counted in
+	Quote string `json:"quote"`
+	/// MaxAge is how old a price can be in seconds before it's stale, 0 accepts any age
+	MaxAge uint64 `json:"max_age,omitempty"`
+	/// Fallback prices are used when the oracle fails or its price is stale.
+	/// Denoms without a fallback price can't be spent then
+	Fallback []Price `json:"fallback,omitempty"`
+}
+
+type Price struct {
+	Denom string       `json:"denom"`
+	Price math.Uint128 `json:"price"`
+}
+
+func (c OracleConfig) Validate() error {
+	if c.Oracle == "" {
+		return errors.New("Oracle cannot be empty")
+	}
+	if c.Quote == "" {
+		return errors.New("Quote denom cannot be empty")
+	}
+
+	seen := make(map[string]bool, len(c.Fallback))
+	for _, p := range c.Fallback {
+		if seen[p.Denom] {
+			return errors.New("Duplicate fallback price " + p.Denom)
+		}
+		seen[p.Denom] = true
+		if p.Price.IsZero() {
+			return errors.New("Fallback price for " + p.Denom + " cannot be zero")
+		}
+	}
+
+	return nil
+}
+
+// FallbackPrice returns the fallback price for the denom, if there is a non-zero one
+func (c OracleConfig) FallbackPrice(denom string) (math.Uint128, bool) {
+	for _, p := range c.Fallback {
+		if p.Denom == denom && !p.Price.IsZero() {
+			return p.Price, true
+		}
+	}
+	return math.ZeroUint128(), false
+}
+
+// QuoteValue converts an amount to quote units at the given price, rounding up
+func QuoteValue(amount math.Uint128, price math.Uint128) (math.Uint128, error) {
+	product, err := amount.SafeMul(price)
+	if err != nil {
+		return math.ZeroUint128(), errors.New("overflow error")
+	}
+
+	value, rem := product.QuoRem(PRICE_PRECISION)
+	if !rem.IsZero() {
+		value = value.Add64(1)
+	}
+	return value, nil
+}
+
+// OracleQueryMsg is the smart query sent to the oracle contract
+type OracleQueryMsg struct {
+	Price *OraclePriceQuery `json:"price,omitempty"`
+}
+
+type OraclePriceQuery struct {
+	Denom string `json:"denom"`
+	Quote string `json:"quote"`
+}
+
+type OraclePriceResponse struct {
+	Price math.Uint128 `json:"price"`
+	/// UpdatedAt is the block time of the price in seconds
+	UpdatedAt uint64 `json:"updated_at"`
+}
+
+// QuoteAllowance limits a subkey's combined spend over all denoms, valued in the oracle's quote denom
+type QuoteAllowance struct {
+	Remaining math.Uint128 `json:"remaining"`
+	Expires   Expiration   `json:"expires"`
+}
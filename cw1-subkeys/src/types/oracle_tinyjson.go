@@ -0,0 +1,507 @@
+// Code generated by tinyjson for marshaling/unmarshaling. DO NOT EDIT.
+
+package types
+
+import (
+	tinyjson "github.com/CosmWasm/tinyjson"
+	jlexer "github.com/CosmWasm/tinyjson/jlexer"
+	jwriter "github.com/CosmWasm/tinyjson/jwriter"
+)
+
+// suppress unused package warning
+var (
+	_ *jlexer.Lexer
+	_ *jwriter.Writer
+	_ tinyjson.Marshaler
+)
+
+func tinyjson8e9f4df6DecodeGithubComJackalLabsBurrowContractsCw1SubkeysSrcTypes(in *jlexer.Lexer, out *QuoteAllowance) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "remaining":
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.Remaining).UnmarshalJSON(data))
+			}
+		case "expires":
+			(out.Expires).UnmarshalTinyJSON(in)
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func tinyjson8e9f4df6EncodeGithubComJackalLabsBurrowContractsCw1SubkeysSrcTypes(out *jwriter.Writer, in QuoteAllowance) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"remaining\":"
+		out.RawString(prefix[1:])
+		out.Raw((in.Remaining).MarshalJSON())
+	}
+	{
+		const prefix string = ",\"expires\":"
+		out.RawString(prefix)
+		(in.Expires).MarshalTinyJSON(out)
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v QuoteAllowance) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	tinyjson8e9f4df6EncodeGithubComJackalLabsBurrowContractsCw1SubkeysSrcTypes(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalTinyJSON supports tinyjson.Marshaler interface
+func (v QuoteAllowance) MarshalTinyJSON(w *jwriter.Writer) {
+	tinyjson8e9f4df6EncodeGithubComJackalLabsBurrowContractsCw1SubkeysSrcTypes(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *QuoteAllowance) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	tinyjson8e9f4df6DecodeGithubComJackalLabsBurrowContractsCw1SubkeysSrcTypes(&r, v)
+	return r.Error()
+}
+
+// UnmarshalTinyJSON supports tinyjson.Unmarshaler interface
+func (v *QuoteAllowance) UnmarshalTinyJSON(l *jlexer.Lexer) {
+	tinyjson8e9f4df6DecodeGithubComJackalLabsBurrowContractsCw1SubkeysSrcTypes(l, v)
+}
+func tinyjson8e9f4df6DecodeGithubComJackalLabsBurrowContractsCw1SubkeysSrcTypes1(in *jlexer.Lexer, out *Price) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "denom":
+			out.Denom = string(in.String())
+		case "price":
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.Price).UnmarshalJSON(data))
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func tinyjson8e9f4df6EncodeGithubComJackalLabsBurrowContractsCw1SubkeysSrcTypes1(out *jwriter.Writer, in Price) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"denom\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.Denom))
+	}
+	{
+		const prefix string = ",\"price\":"
+		out.RawString(prefix)
+		out.Raw((in.Price).MarshalJSON())
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v Price) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	tinyjson8e9f4df6EncodeGithubComJackalLabsBurrowContractsCw1SubkeysSrcTypes1(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalTinyJSON supports tinyjson.Marshaler interface
+func (v Price) MarshalTinyJSON(w *jwriter.Writer) {
+	tinyjson8e9f4df6EncodeGithubComJackalLabsBurrowContractsCw1SubkeysSrcTypes1(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *Price) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	tinyjson8e9f4df6DecodeGithubComJackalLabsBurrowContractsCw1SubkeysSrcTypes1(&r, v)
+	return r.Error()
+}
+
+// UnmarshalTinyJSON supports tinyjson.Unmarshaler interface
+func (v *Price) UnmarshalTinyJSON(l *jlexer.Lexer) {
+	tinyjson8e9f4df6DecodeGithubComJackalLabsBurrowContractsCw1SubkeysSrcTypes1(l, v)
+}
+func tinyjson8e9f4df6DecodeGithubComJackalLabsBurrowContractsCw1SubkeysSrcTypes2(in *jlexer.Lexer, out *OracleQueryMsg) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "price":
+			if in.IsNull() {
+				in.Skip()
+				out.Price = nil
+			} else {
+				if out.Price == nil {
+					out.Price = new(OraclePriceQuery)
+				}
+				(*out.Price).UnmarshalTinyJSON(in)
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func tinyjson8e9f4df6EncodeGithubComJackalLabsBurrowContractsCw1SubkeysSrcTypes2(out *jwriter.Writer, in OracleQueryMsg) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	if in.Price != nil {
+		const prefix string = ",\"price\":"
+		first = false
+		out.RawString(prefix[1:])
+		(*in.Price).MarshalTinyJSON(out)
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v OracleQueryMsg) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	tinyjson8e9f4df6EncodeGithubComJackalLabsBurrowContractsCw1SubkeysSrcTypes2(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalTinyJSON supports tinyjson.Marshaler interface
+func (v OracleQueryMsg) MarshalTinyJSON(w *jwriter.Writer) {
+	tinyjson8e9f4df6EncodeGithubComJackalLabsBurrowContractsCw1SubkeysSrcTypes2(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *OracleQueryMsg) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	tinyjson8e9f4df6DecodeGithubComJackalLabsBurrowContractsCw1SubkeysSrcTypes2(&r, v)
+	return r.Error()
+}
+
+// UnmarshalTinyJSON supports tinyjson.Unmarshaler interface
+func (v *OracleQueryMsg) UnmarshalTinyJSON(l *jlexer.Lexer) {
+	tinyjson8e9f4df6DecodeGithubComJackalLabsBurrowContractsCw1SubkeysSrcTypes2(l, v)
+}
+func tinyjson8e9f4df6DecodeGithubComJackalLabsBurrowContractsCw1SubkeysSrcTypes3(in *jlexer.Lexer, out *OraclePriceResponse) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "price":
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.Price).UnmarshalJSON(data))
+			}
+		case "updated_at":
+			out.UpdatedAt = uint64(in.Uint64())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func tinyjson8e9f4df6EncodeGithubComJackalLabsBurrowContractsCw1SubkeysSrcTypes3(out *jwriter.Writer, in OraclePriceResponse) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"price\":"
+		out.RawString(prefix[1:])
+		out.Raw((in.Price).MarshalJSON())
+	}
+	{
+		const prefix string = ",\"updated_at\":"
+		out.RawString(prefix)
+		out.Uint64(uint64(in.UpdatedAt))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v OraclePriceResponse) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	tinyjson8e9f4df6EncodeGithubComJackalLabsBurrowContractsCw1SubkeysSrcTypes3(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalTinyJSON supports tinyjson.Marshaler interface
+func (v OraclePriceResponse) MarshalTinyJSON(w *jwriter.Writer) {
+	tinyjson8e9f4df6EncodeGithubComJackalLabsBurrowContractsCw1SubkeysSrcTypes3(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *OraclePriceResponse) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	tinyjson8e9f4df6DecodeGithubComJackalLabsBurrowContractsCw1SubkeysSrcTypes3(&r, v)
+	return r.Error()
+}
+
+// UnmarshalTinyJSON supports tinyjson.Unmarshaler interface
+func (v *OraclePriceResponse) UnmarshalTinyJSON(l *jlexer.Lexer) {
+	tinyjson8e9f4df6DecodeGithubComJackalLabsBurrowContractsCw1SubkeysSrcTypes3(l, v)
+}
+func tinyjson8e9f4df6DecodeGithubComJackalLabsBurrowContractsCw1SubkeysSrcTypes4(in *jlexer.Lexer, out *OraclePriceQuery) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "denom":
+			out.Denom = string(in.String())
+		case "quote":
+			out.Quote = string(in.String())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func tinyjson8e9f4df6EncodeGithubComJackalLabsBurrowContractsCw1SubkeysSrcTypes4(out *jwriter.Writer, in OraclePriceQuery) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"denom\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.Denom))
+	}
+	{
+		const prefix string = ",\"quote\":"
+		out.RawString(prefix)
+		out.String(string(in.Quote))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v OraclePriceQuery) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	tinyjson8e9f4df6EncodeGithubComJackalLabsBurrowContractsCw1SubkeysSrcTypes4(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalTinyJSON supports tinyjson.Marshaler interface
+func (v OraclePriceQuery) MarshalTinyJSON(w *jwriter.Writer) {
+	tinyjson8e9f4df6EncodeGithubComJackalLabsBurrowContractsCw1SubkeysSrcTypes4(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *OraclePriceQuery) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	tinyjson8e9f4df6DecodeGithubComJackalLabsBurrowContractsCw1SubkeysSrcTypes4(&r, v)
+	return r.Error()
+}
+
+// UnmarshalTinyJSON supports tinyjson.Unmarshaler interface
+func (v *OraclePriceQuery) UnmarshalTinyJSON(l *jlexer.Lexer) {
+	tinyjson8e9f4df6DecodeGithubComJackalLabsBurrowContractsCw1SubkeysSrcTypes4(l, v)
+}
+func tinyjson8e9f4df6DecodeGithubComJackalLabsBurrowContractsCw1SubkeysSrcTypes5(in *jlexer.Lexer, out *OracleConfig) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "oracle":
+			out.Oracle = string(in.String())
+		case "quote":
+			out.Quote = string(in.String())
+		case "max_age":
+			out.MaxAge = uint64(in.Uint64())
+		case "fallback":
+			if in.IsNull() {
+				in.Skip()
+				out.Fallback = nil
+			} else {
+				in.Delim('[')
+				if out.Fallback == nil {
+					if !in.IsDelim(']') {
+						out.Fallback = make([]Price, 0, 2)
+					} else {
+						out.Fallback = []Price{}
+					}
+				} else {
+					out.Fallback = (out.Fallback)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v1 Price
+					(v1).UnmarshalTinyJSON(in)
+					out.Fallback = append(out.Fallback, v1)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func tinyjson8e9f4df6EncodeGithubComJackalLabsBurrowContractsCw1SubkeysSrcTypes5(out *jwriter.Writer, in OracleConfig) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"oracle\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.Oracle))
+	}
+	{
+		const prefix string = ",\"quote\":"
+		out.RawString(prefix)
+		out.String(string(in.Quote))
+	}
+	if in.MaxAge != 0 {
+		const prefix string = ",\"max_age\":"
+		out.RawString(prefix)
+		out.Uint64(uint64(in.MaxAge))
+	}
+	if len(in.Fallback) != 0 {
+		const prefix string = ",\"fallback\":"
+		out.RawString(prefix)
+		{
+			out.RawByte('[')
+			for v2, v3 := range in.Fallback {
+				if v2 > 0 {
+					out.RawByte(',')
+				}
+				(v3).MarshalTinyJSON(out)
+			}
+			out.RawByte(']')
+		}
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v OracleConfig) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	tinyjson8e9f4df6EncodeGithubComJackalLabsBurrowContractsCw1SubkeysSrcTypes5(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalTinyJSON supports tinyjson.Marshaler interface
+func (v OracleConfig) MarshalTinyJSON(w *jwriter.Writer) {
+	tinyjson8e9f4df6EncodeGithubComJackalLabsBurrowContractsCw1SubkeysSrcTypes5(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *OracleConfig) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	tinyjson8e9f4df6DecodeGithubComJackalLabsBurrowContractsCw1SubkeysSrcTypes5(&r, v)
+	return r.Error()
+}
+
+// UnmarshalTinyJSON supports tinyjson.Unmarshaler interface
+func (v *OracleConfig) UnmarshalTinyJSON(l *jlexer.Lexer) {
+	tinyjson8e9f4df6DecodeGithubComJackalLabsBurrowContractsCw1SubkeysSrcTypes5(l, v)
+}
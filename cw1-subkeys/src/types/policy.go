@@ -0,0 +1,285 @@
+package types
+
+import (
+	"errors"
+	"slices"
+
+	"github.com/CosmWasm/cosmwasm-go/std/math"
+	"github.com/CosmWasm/cosmwasm-go/std/types"
+)
+
+const (
+	PolicyAllow = "allow"
+	PolicyDeny  = "deny"
+)
+
+// Message kinds a policy rule can match on
+const (
+	KindBankSend           = "bank_send"
+	KindBankBurn           = "bank_burn"
+	KindDelegate           = "delegate"
+	KindUndelegate         = "undelegate"
+	KindRedelegate         = "redelegate"
+	KindSetWithdrawAddress = "set_withdraw_address"
+	KindWithdrawReward     = "withdraw_delegator_reward"
+	KindVote               = "vote"
+	KindIbcTransfer        = "ibc_transfer"
+	KindIbcSendPacket      = "ibc_send_packet"
+	KindIbcCloseChannel    = "ibc_close_channel"
+	KindWasmExecute        = "wasm_execute"
+	KindWasmInstantiate    = "wasm_instantiate"
+	KindWasmMigrate        = "wasm_migrate"
+	KindWasmUpdateAdmin    = "wasm_update_admin"
+	KindWasmClearAdmin     = "wasm_clear_admin"
+	KindStargate           = "stargate"
+	KindCustom             = "custom"
+)
+
+var msgKinds = []string{
+	KindBankSend, KindBankBurn,
+	KindDelegate, KindUndelegate, KindRedelegate,
+	KindSetWithdrawAddress, KindWithdrawReward,
+	KindVote,
+	KindIbcTransfer, KindIbcSendPacket, KindIbcCloseChannel,
+	KindWasmExecute, KindWasmInstantiate, KindWasmMigrate, KindWasmUpdateAdmin, KindWasmClearAdmin,
+	KindStargate, KindCustom,
+}
+
+// MsgKind names the variant set in a CosmosMsg, or returns "" if none is
+func MsgKind(msg types.CosmosMsg) string {
+	switch {
+	case msg.Bank != nil && msg.Bank.Send != nil:
+		return KindBankSend
+	case msg.Bank != nil && msg.Bank.Burn != nil:
+		return KindBankBurn
+	case msg.Staking != nil && msg.Staking.Delegate != nil:
+		return KindDelegate
+	case msg.Staking != nil && msg.Staking.Undelegate != nil:
+		return KindUndelegate
+	case msg.Staking != nil && msg.Staking.Redelegate != nil:
+		return KindRedelegate
+	case msg.Distribution != nil && msg.Distribution.SetWithdrawAddress != nil:
+		return KindSetWithdrawAddress
+	case msg.Distribution != nil && msg.Distribution.WithdrawDelegatorReward != nil:
+		return KindWithdrawReward
+	case msg.Gov != nil && msg.Gov.Vote != nil:
+		return KindVote
+	case msg.IBC != nil && msg.IBC.Transfer != nil:
+		return KindIbcTransfer
+	case msg.IBC != nil && msg.IBC.SendPacket != nil:
+		return KindIbcSendPacket
+	case msg.IBC != nil && msg.IBC.CloseChannel != nil:
+		return KindIbcCloseChannel
+	case msg.Wasm != nil && msg.Wasm.Execute != nil:
+		return KindWasmExecute
+	case msg.Wasm != nil && msg.Wasm.Instantiate != nil:
+		return KindWasmInstantiate
+	case msg.Wasm != nil && msg.Wasm.Migrate != nil:
+		return KindWasmMigrate
+	case msg.Wasm != nil && msg.Wasm.UpdateAdmin != nil:
+		return KindWasmUpdateAdmin
+	case msg.Wasm != nil && msg.Wasm.ClearAdmin != nil:
+		return KindWasmClearAdmin
+	case msg.Stargate != nil:
+		return KindStargate
+	case len(msg.Custom) != 0:
+		return KindCustom
+	default:
+		return ""
+	}
+}
+
+// MsgFunds returns the coins a message moves out of the proxy
+func MsgFunds(msg types.CosmosMsg) []types.Coin {
+	switch MsgKind(msg) {
+	case KindBankSend:
+		return msg.Bank.Send.Amount
+	case KindBankBurn:
+		return msg.Bank.Burn.Amount
+	case KindDelegate:
+		return []types.Coin{msg.Staking.Delegate.Amount}
+	case KindUndelegate:
+		return []types.Coin{msg.Staking.Undelegate.Amount}
+	case KindRedelegate:
+		return []types.Coin{msg.Staking.Redelegate.Amount}
+	case KindIbcTransfer:
+		return []types.Coin{msg.IBC.Transfer.Amount}
+	case KindWasmExecute:
+		return msg.Wasm.Execute.Funds
+	case KindWasmInstantiate:
+		return msg.Wasm.Instantiate.Funds
+	default:
+		return nil
+	}
+}
+
+// MsgRecipient returns the address a message sends to or acts on, or "" if there is none
+func MsgRecipient(msg types.CosmosMsg) string {
+	switch MsgKind(msg) {
+	case KindBankSend:
+		return msg.Bank.Send.ToAddress
+	case KindDelegate:
+		return msg.Staking.Delegate.Validator
+	case KindUndelegate:
+		return msg.Staking.Undelegate.Validator
+	case KindRedelegate:
+		return msg.Staking.Redelegate.DstValidator
+	case KindSetWithdrawAddress:
+		return msg.Distribution.SetWithdrawAddress.Address
+	case KindWithdrawReward:
+		return msg.Distribution.WithdrawDelegatorReward.Validator
+	case KindIbcTransfer:
+		return msg.IBC.Transfer.ToAddress
+	case KindWasmExecute:
+		return msg.Wasm.Execute.ContractAddr
+	case KindWasmMigrate:
+		return msg.Wasm.Migrate.ContractAddr
+	case KindWasmUpdateAdmin:
+		return msg.Wasm.UpdateAdmin.ContractAddr
+	case KindWasmClearAdmin:
+		return msg.Wasm.ClearAdmin.ContractAddr
+	default:
+		return ""
+	}
+}
+
+// Policy is a list of rules deciding which messages a spender can execute.
+// A matching deny rule always wins over a matching allow rule,
+// and a message no rule matches is denied.
+type Policy struct {
+	Rules []PolicyRule `json:"rules"`
+}
+
+// PolicyRule matches a message when every condition that is set holds.
+// Unset conditions match anything.
+type PolicyRule struct {
+	ID     string `json:"id"`
+	Effect string `json:"effect"`
+
+	/// Kinds of message matched, see MsgKind
+	Kinds []string `json:"kinds,omitempty"`
+	/// Denoms every coin the message moves must be in
+	Denoms []string `json:"denoms,omitempty"`
+	/// Recipients the message must send to or act on
+	Recipients []string `json:"recipients,omitempty"`
+	/// MinAmount and MaxAmount bound the amount of every coin the message moves
+	MinAmount *math.Uint128 `json:"min_amount,omitempty"`
+	MaxAmount *math.Uint128 `json:"max_amount,omitempty"`
+	/// NotBefore and NotAfter bound when the rule applies
+	NotBefore *Expiration `json:"not_before,omitempty"`
+	NotAfter  *Expiration `json:"not_after,omitempty"`
+}
+
+// PolicyDecision is the outcome of evaluating a policy, Rule is empty if nothing matched
+type PolicyDecision struct {
+	Allowed bool   `json:"allowed"`
+	Rule    string `json:"rule,omitempty"`
+}
+
+func (p Policy) Validate() error {
+	var ids []string
+
+	for _, rule := range p.Rules {
+		if rule.ID == "" {
+			return errors.New("Policy rule id cannot be empty")
+		}
+		if slices.Contains(ids, rule.ID) {
+			return errors.New("Duplicate policy rule " + rule.ID)
+		}
+		ids = append(ids, rule.ID)
+
+		if rule.Effect != PolicyAllow && rule.Effect != PolicyDeny {
+			return errors.New("Invalid effect for policy rule " + rule.ID)
+		}
+
+		for _, kind := range rule.Kinds {
+			if !slices.Contains(msgKinds, kind) {
+				return errors.New("Unknown message kind " + kind)
+			}
+		}
+
+		if rule.MinAmount != nil && rule.MaxAmount != nil && rule.MinAmount.GT(*rule.MaxAmount) {
+			return errors.New("Invalid amount range for policy rule " + rule.ID)
+		}
+	}
+
+	return nil
+}
+
+// Evaluate decides if the message is allowed at the given block
+func (p Policy) Evaluate(msg types.CosmosMsg, block types.BlockInfo) PolicyDecision {
+	var allowedBy string
+
+	for _, rule := range p.Rules {
+		if !rule.Matches(msg, block) {
+			continue
+		}
+
+		if rule.Effect == PolicyDeny {
+			return PolicyDecision{Allowed: false, Rule: rule.ID}
+		}
+
+		if allowedBy == "" {
+			allowedBy = rule.ID
+		}
+	}
+
+	return PolicyDecision{Allowed: allowedBy != "", Rule: allowedBy}
+}
+
+// Matches checks if the rule applies to the message at the given block
+func (r PolicyRule) Matches(msg types.CosmosMsg, block types.BlockInfo) bool {
+	if r.NotBefore != nil && !r.NotBefore.IsExpired(block) {
+		return false
+	}
+	if r.NotAfter != nil && r.NotAfter.IsExpired(block) {
+		return false
+	}
+
+	if len(r.Kinds) != 0 && !slices.Contains(r.Kinds, MsgKind(msg)) {
+		return false
+	}
+
+	if len(r.Recipients) != 0 && !slices.Contains(r.Recipients, MsgRecipient(msg)) {
+		return false
+	}
+
+	return r.matchesFunds(MsgFunds(msg))
+}
+
+// matchesFunds checks the coin conditions. Allow rules need every coin to match,
+// while deny rules only need one, so mixing denoms can't sneak past either.
+func (r PolicyRule) matchesFunds(coins []types.Coin) bool {
+	if len(r.Denoms) == 0 && r.MinAmount == nil && r.MaxAmount == nil {
+		return true
+	}
+
+	if len(coins) == 0 {
+		return false
+	}
+
+	for _, coin := range coins {
+		match := r.matchesCoin(coin)
+		if r.Effect == PolicyDeny && match {
+			return true
+		}
+		if r.Effect != PolicyDeny && !match {
+			return false
+		}
+	}
+
+	return r.Effect != PolicyDeny
+}
+
+func (r PolicyRule) matchesCoin(coin types.Coin) bool {
+	if len(r.Denoms) != 0 && !slices.Contains(r.Denoms, coin.Denom) {
+		return false
+	}
+	if r.MinAmount != nil && coin.Amount.LT(*r.MinAmount) {
+		return false
+	}
+	if r.MaxAmount != nil && coin.Amount.GT(*r.MaxAmount) {
+		return false
+	}
+	return true
+}
@@ -0,0 +1,77 @@
+package types
+
+import (
+	"testing"
+
+	"github.com/CosmWasm/cosmwasm-go/std/math"
+	"github.com/CosmWasm/cosmwasm-go/std/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func sendMsg(to string, coins ...types.Coin) types.CosmosMsg {
+	return types.SendMsg{ToAddress: to, Amount: coins}.ToMsg()
+}
+
+func coin(amount uint64, denom string) types.Coin {
+	return types.NewCoin(math.NewUint128FromUint64(amount), denom)
+}
+
+func uint128(v uint64) *math.Uint128 {
+	u := math.NewUint128FromUint64(v)
+	return &u
+}
+
+func TestPolicyEvaluate(t *testing.T) {
+	block := types.BlockInfo{Height: 100}
+	later := ExpiresAtHeight(200)
+	policy := Policy{Rules: []PolicyRule{
+		{ID: "small-ujkl", Effect: PolicyAllow, Kinds: []string{KindBankSend}, Denoms: []string{"ujkl"}, MaxAmount: uint128(100)},
+		{ID: "no-atom", Effect: PolicyDeny, Denoms: []string{"uatom"}},
+		{ID: "stake", Effect: PolicyAllow, Kinds: []string{KindDelegate}, Recipients: []string{"val1"}},
+		{ID: "later", Effect: PolicyAllow, Kinds: []string{KindVote}, NotBefore: &later},
+	}}
+	assert.NoError(t, policy.Validate())
+
+	tt := []struct {
+		name   string
+		msg    types.CosmosMsg
+		expect PolicyDecision
+	}{
+		{"allowed send", sendMsg("bob", coin(50, "ujkl")), PolicyDecision{Allowed: true, Rule: "small-ujkl"}},
+		{"send too large", sendMsg("bob", coin(500, "ujkl")), PolicyDecision{}},
+		{"mixed denoms denied", sendMsg("bob", coin(50, "ujkl"), coin(1, "uatom")), PolicyDecision{Rule: "no-atom"}},
+		{"unknown denom", sendMsg("bob", coin(50, "uosmo")), PolicyDecision{}},
+		{"allowed validator", types.DelegateMsg{Validator: "val1", Amount: coin(5, "ujkl")}.ToMsg(), PolicyDecision{Allowed: true, Rule: "stake"}},
+		{"other validator", types.DelegateMsg{Validator: "val2", Amount: coin(5, "ujkl")}.ToMsg(), PolicyDecision{}},
+		{"rule not active yet", types.VoteMsg{ProposalId: 1, Vote: types.VoteYes}.ToMsg(), PolicyDecision{}},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.expect, policy.Evaluate(tc.msg, block))
+		})
+	}
+
+	block.Height = 200
+	assert.True(t, policy.Evaluate(types.VoteMsg{ProposalId: 1, Vote: types.VoteYes}.ToMsg(), block).Allowed)
+}
+
+func TestPolicyValidate(t *testing.T) {
+	tt := []struct {
+		name   string
+		policy Policy
+		err    string
+	}{
+		{"missing id", Policy{Rules: []PolicyRule{{Effect: PolicyAllow}}}, "Policy rule id cannot be empty"},
+		{"duplicate id", Policy{Rules: []PolicyRule{{ID: "a", Effect: PolicyAllow}, {ID: "a", Effect: PolicyDeny}}}, "Duplicate policy rule a"},
+		{"bad effect", Policy{Rules: []PolicyRule{{ID: "a", Effect: "maybe"}}}, "Invalid effect for policy rule a"},
+		{"bad kind", Policy{Rules: []PolicyRule{{ID: "a", Effect: PolicyAllow, Kinds: []string{"teleport"}}}}, "Unknown message kind teleport"},
+		{"bad range", Policy{Rules: []PolicyRule{{ID: "a", Effect: PolicyAllow, MinAmount: uint128(5), MaxAmount: uint128(1)}}}, "Invalid amount range for policy rule a"},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.EqualError(t, tc.policy.Validate(), tc.err)
+		})
+	}
+}
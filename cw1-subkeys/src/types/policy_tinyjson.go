@@ -0,0 +1,437 @@
+// Code generated by tinyjson for marshaling/unmarshaling. DO NOT EDIT.
+
+package types
+
+import (
+	math "github.com/CosmWasm/cosmwasm-go/std/math"
+	tinyjson "github.com/CosmWasm/tinyjson"
+	jlexer "github.com/CosmWasm/tinyjson/jlexer"
+	jwriter "github.com/CosmWasm/tinyjson/jwriter"
+)
+
+// suppress unused package warning
+var (
+	_ *jlexer.Lexer
+	_ *jwriter.Writer
+	_ tinyjson.Marshaler
+)
+
+func tinyjson2809e9c2DecodeGithubComJackalLabsBurrowContractsCw1SubkeysSrcTypes(in *jlexer.Lexer, out *PolicyRule) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "id":
+			out.ID = string(in.String())
+		case "effect":
+			out.Effect = string(in.String())
+		case "kinds":
+			if in.IsNull() {
+				in.Skip()
+				out.Kinds = nil
+			} else {
+				in.Delim('[')
+				if out.Kinds == nil {
+					if !in.IsDelim(']') {
+						out.Kinds = make([]string, 0, 4)
+					} else {
+						out.Kinds = []string{}
+					}
+				} else {
+					out.Kinds = (out.Kinds)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v1 string
+					v1 = string(in.String())
+					out.Kinds = append(out.Kinds, v1)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "denoms":
+			if in.IsNull() {
+				in.Skip()
+				out.Denoms = nil
+			} else {
+				in.Delim('[')
+				if out.Denoms == nil {
+					if !in.IsDelim(']') {
+						out.Denoms = make([]string, 0, 4)
+					} else {
+						out.Denoms = []string{}
+					}
+				} else {
+					out.Denoms = (out.Denoms)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v2 string
+					v2 = string(in.String())
+					out.Denoms = append(out.Denoms, v2)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "recipients":
+			if in.IsNull() {
+				in.Skip()
+				out.Recipients = nil
+			} else {
+				in.Delim('[')
+				if out.Recipients == nil {
+					if !in.IsDelim(']') {
+						out.Recipients = make([]string, 0, 4)
+					} else {
+						out.Recipients = []string{}
+					}
+				} else {
+					out.Recipients = (out.Recipients)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v3 string
+					v3 = string(in.String())
+					out.Recipients = append(out.Recipients, v3)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "min_amount":
+			if in.IsNull() {
+				in.Skip()
+				out.MinAmount = nil
+			} else {
+				if out.MinAmount == nil {
+					out.MinAmount = new(math.Uint128)
+				}
+				if data := in.Raw(); in.Ok() {
+					in.AddError((*out.MinAmount).UnmarshalJSON(data))
+				}
+			}
+		case "max_amount":
+			if in.IsNull() {
+				in.Skip()
+				out.MaxAmount = nil
+			} else {
+				if out.MaxAmount == nil {
+					out.MaxAmount = new(math.Uint128)
+				}
+				if data := in.Raw(); in.Ok() {
+					in.AddError((*out.MaxAmount).UnmarshalJSON(data))
+				}
+			}
+		case "not_before":
+			if in.IsNull() {
+				in.Skip()
+				out.NotBefore = nil
+			} else {
+				if out.NotBefore == nil {
+					out.NotBefore = new(Expiration)
+				}
+				(*out.NotBefore).UnmarshalTinyJSON(in)
+			}
+		case "not_after":
+			if in.IsNull() {
+				in.Skip()
+				out.NotAfter = nil
+			} else {
+				if out.NotAfter == nil {
+					out.NotAfter = new(Expiration)
+				}
+				(*out.NotAfter).UnmarshalTinyJSON(in)
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func tinyjson2809e9c2EncodeGithubComJackalLabsBurrowContractsCw1SubkeysSrcTypes(out *jwriter.Writer, in PolicyRule) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"id\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.ID))
+	}
+	{
+		const prefix string = ",\"effect\":"
+		out.RawString(prefix)
+		out.String(string(in.Effect))
+	}
+	if len(in.Kinds) != 0 {
+		const prefix string = ",\"kinds\":"
+		out.RawString(prefix)
+		{
+			out.RawByte('[')
+			for v4, v5 := range in.Kinds {
+				if v4 > 0 {
+					out.RawByte(',')
+				}
+				out.String(string(v5))
+			}
+			out.RawByte(']')
+		}
+	}
+	if len(in.Denoms) != 0 {
+		const prefix string = ",\"denoms\":"
+		out.RawString(prefix)
+		{
+			out.RawByte('[')
+			for v6, v7 := range in.Denoms {
+				if v6 > 0 {
+					out.RawByte(',')
+				}
+				out.String(string(v7))
+			}
+			out.RawByte(']')
+		}
+	}
+	if len(in.Recipients) != 0 {
+		const prefix string = ",\"recipients\":"
+		out.RawString(prefix)
+		{
+			out.RawByte('[')
+			for v8, v9 := range in.Recipients {
+				if v8 > 0 {
+					out.RawByte(',')
+				}
+				out.String(string(v9))
+			}
+			out.RawByte(']')
+		}
+	}
+	if in.MinAmount != nil {
+		const prefix string = ",\"min_amount\":"
+		out.RawString(prefix)
+		out.Raw((*in.MinAmount).MarshalJSON())
+	}
+	if in.MaxAmount != nil {
+		const prefix string = ",\"max_amount\":"
+		out.RawString(prefix)
+		out.Raw((*in.MaxAmount).MarshalJSON())
+	}
+	if in.NotBefore != nil {
+		const prefix string = ",\"not_before\":"
+		out.RawString(prefix)
+		(*in.NotBefore).MarshalTinyJSON(out)
+	}
+	if in.NotAfter != nil {
+		const prefix string = ",\"not_after\":"
+		out.RawString(prefix)
+		(*in.NotAfter).MarshalTinyJSON(out)
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v PolicyRule) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	tinyjson2809e9c2EncodeGithubComJackalLabsBurrowContractsCw1SubkeysSrcTypes(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalTinyJSON supports tinyjson.Marshaler interface
+func (v PolicyRule) MarshalTinyJSON(w *jwriter.Writer) {
+	tinyjson2809e9c2EncodeGithubComJackalLabsBurrowContractsCw1SubkeysSrcTypes(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *PolicyRule) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	tinyjson2809e9c2DecodeGithubComJackalLabsBurrowContractsCw1SubkeysSrcTypes(&r, v)
+	return r.Error()
+}
+
+// UnmarshalTinyJSON supports tinyjson.Unmarshaler interface
+func (v *PolicyRule) UnmarshalTinyJSON(l *jlexer.Lexer) {
+	tinyjson2809e9c2DecodeGithubComJackalLabsBurrowContractsCw1SubkeysSrcTypes(l, v)
+}
+func tinyjson2809e9c2DecodeGithubComJackalLabsBurrowContractsCw1SubkeysSrcTypes1(in *jlexer.Lexer, out *PolicyDecision) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "allowed":
+			out.Allowed = bool(in.Bool())
+		case "rule":
+			out.Rule = string(in.String())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func tinyjson2809e9c2EncodeGithubComJackalLabsBurrowContractsCw1SubkeysSrcTypes1(out *jwriter.Writer, in PolicyDecision) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"allowed\":"
+		out.RawString(prefix[1:])
+		out.Bool(bool(in.Allowed))
+	}
+	if in.Rule != "" {
+		const prefix string = ",\"rule\":"
+		out.RawString(prefix)
+		out.String(string(in.Rule))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v PolicyDecision) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	tinyjson2809e9c2EncodeGithubComJackalLabsBurrowContractsCw1SubkeysSrcTypes1(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalTinyJSON supports tinyjson.Marshaler interface
+func (v PolicyDecision) MarshalTinyJSON(w *jwriter.Writer) {
+	tinyjson2809e9c2EncodeGithubComJackalLabsBurrowContractsCw1SubkeysSrcTypes1(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *PolicyDecision) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	tinyjson2809e9c2DecodeGithubComJackalLabsBurrowContractsCw1SubkeysSrcTypes1(&r, v)
+	return r.Error()
+}
+
+// UnmarshalTinyJSON supports tinyjson.Unmarshaler interface
+func (v *PolicyDecision) UnmarshalTinyJSON(l *jlexer.Lexer) {
+	tinyjson2809e9c2DecodeGithubComJackalLabsBurrowContractsCw1SubkeysSrcTypes1(l, v)
+}
+func tinyjson2809e9c2DecodeGithubComJackalLabsBurrowContractsCw1SubkeysSrcTypes2(in *jlexer.Lexer, out *Policy) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "rules":
+			if in.IsNull() {
+				in.Skip()
+				out.Rules = nil
+			} else {
+				in.Delim('[')
+				if out.Rules == nil {
+					if !in.IsDelim(']') {
+						out.Rules = make([]PolicyRule, 0, 0)
+					} else {
+						out.Rules = []PolicyRule{}
+					}
+				} else {
+					out.Rules = (out.Rules)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v10 PolicyRule
+					(v10).UnmarshalTinyJSON(in)
+					out.Rules = append(out.Rules, v10)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func tinyjson2809e9c2EncodeGithubComJackalLabsBurrowContractsCw1SubkeysSrcTypes2(out *jwriter.Writer, in Policy) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"rules\":"
+		out.RawString(prefix[1:])
+		if in.Rules == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v11, v12 := range in.Rules {
+				if v11 > 0 {
+					out.RawByte(',')
+				}
+				(v12).MarshalTinyJSON(out)
+			}
+			out.RawByte(']')
+		}
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v Policy) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	tinyjson2809e9c2EncodeGithubComJackalLabsBurrowContractsCw1SubkeysSrcTypes2(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalTinyJSON supports tinyjson.Marshaler interface
+func (v Policy) MarshalTinyJSON(w *jwriter.Writer) {
+	tinyjson2809e9c2EncodeGithubComJackalLabsBurrowContractsCw1SubkeysSrcTypes2(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *Policy) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	tinyjson2809e9c2DecodeGithubComJackalLabsBurrowContractsCw1SubkeysSrcTypes2(&r, v)
+	return r.Error()
+}
+
+// UnmarshalTinyJSON supports tinyjson.Unmarshaler interface
+func (v *Policy) UnmarshalTinyJSON(l *jlexer.Lexer) {
+	tinyjson2809e9c2DecodeGithubComJackalLabsBurrowContractsCw1SubkeysSrcTypes2(l, v)
+}
@@ -0,0 +1,54 @@
+package types
+
+import (
+	"errors"
+	"strconv"
+
+	"github.com/CosmWasm/cosmwasm-go/std/types"
+)
+
+// StargateAllowlist lets a subkey send Stargate messages of the listed type URLs
+type StargateAllowlist struct {
+	Types []StargateType `json:"types"`
+}
+
+// StargateType permits one type URL. A zero MaxBytes allows values of any size
+type StargateType struct {
+	TypeURL  string `json:"type_url"`
+	MaxBytes uint32 `json:"max_bytes,omitempty"`
+}
+
+func (a StargateAllowlist) Validate() error {
+	if len(a.Types) == 0 {
+		return errors.New("Type list cannot be empty")
+	}
+
+	seen := make(map[string]bool, len(a.Types))
+	for _, t := range a.Types {
+		if t.TypeURL == "" {
+			return errors.New("Type URL cannot be empty")
+		}
+		if seen[t.TypeURL] {
+			return errors.New("Duplicate type URL " + t.TypeURL)
+		}
+		seen[t.TypeURL] = true
+	}
+
+	return nil
+}
+
+// Check returns an error if the message's type URL isn't listed or its value is too large
+func (a StargateAllowlist) Check(msg *types.StargateMsg) error {
+	for _, t := range a.Types {
+		if t.TypeURL != msg.TypeURL {
+			continue
+		}
+
+		if t.MaxBytes != 0 && len(msg.Value) > int(t.MaxBytes) {
+			return errors.New("Contract Error: Stargate message exceeds " + strconv.FormatUint(uint64(t.MaxBytes), 10) + " bytes")
+		}
+		return nil
+	}
+
+	return errors.New("Contract Error: Type URL Not Allowed")
+}
@@ -1,10 +1,122 @@
 package types
 
+import (
+	"slices"
+
+	"github.com/CosmWasm/cosmwasm-go/std/types"
+)
+
 type Permissions struct {
 	Delegate   bool `json:"delegate"`
 	Redelegate bool `json:"redelegate"`
 	Undelegate bool `json:"undelegate"`
 	Withdraw   bool `json:"withdraw"`
+	/// Vote allows casting gov votes with the proxy's stake
+	Vote bool `json:"vote"`
+	/// VoteProposals limits Vote to these proposals, empty allows any proposal
+	VoteProposals []uint64 `json:"vote_proposals,omitempty"`
+	/// Expires makes the permissions absent once reached
+	Expires Expiration `json:"expires"`
+	/// Uses is how many more permissioned messages can be executed, nil for no limit
+	Uses *uint32 `json:"uses,omitempty"`
+}
+
+// IsActive checks that the permissions haven't expired or been used up
+func (p Permissions) IsActive(block types.BlockInfo) bool {
+	if p.Expires.IsExpired(block) {
+		return false
+	}
+	return p.Uses == nil || *p.Uses > 0
+}
+
+// Covers checks if every permission in other is also granted by p, for no more uses and no longer than p
+func (p Permissions) Covers(other Permissions) bool {
+	return (p.Delegate || !other.Delegate) &&
+		(p.Redelegate || !other.Redelegate) &&
+		(p.Undelegate || !other.Undelegate) &&
+		(p.Withdraw || !other.Withdraw) &&
+		(!other.Vote || p.Vote && p.coversProposals(other.VoteProposals)) &&
+		(p.Uses == nil || other.Uses != nil && *other.Uses <= *p.Uses) &&
+		other.Expires.Within(p.Expires)
+}
+
+// coversProposals checks if p can vote on every one of the proposals, an empty list meaning any proposal
+func (p Permissions) coversProposals(proposals []uint64) bool {
+	if len(p.VoteProposals) == 0 {
+		return true
+	}
+	if len(proposals) == 0 {
+		return false
+	}
+	for _, id := range proposals {
+		if !slices.Contains(p.VoteProposals, id) {
+			return false
+		}
+	}
+	return true
+}
+
+// CanVote checks if p allows voting on the proposal
+func (p Permissions) CanVote(proposalID uint64) bool {
+	return p.Vote && (len(p.VoteProposals) == 0 || slices.Contains(p.VoteProposals, proposalID))
+}
+
+// Flags names the permissions that are set, as used by QueryPermissionsWith
+func (p Permissions) Flags() []string {
+	var flags []string
+	for _, flag := range []struct {
+		name string
+		set  bool
+	}{
+		{"delegate", p.Delegate},
+		{"redelegate", p.Redelegate},
+		{"undelegate", p.Undelegate},
+		{"withdraw", p.Withdraw},
+		{"vote", p.Vote},
+	} {
+		if flag.set {
+			flags = append(flags, flag.name)
+		}
+	}
+	return flags
+}
+
+// Intersect returns the permissions granted by both p and other, with the fewer uses and earlier expiration.
+// If the expirations can't be compared the one of p is kept
+func (p Permissions) Intersect(other Permissions) Permissions {
+	perm := Permissions{
+		Delegate:   p.Delegate && other.Delegate,
+		Redelegate: p.Redelegate && other.Redelegate,
+		Undelegate: p.Undelegate && other.Undelegate,
+		Withdraw:   p.Withdraw && other.Withdraw,
+		Vote:       p.Vote && other.Vote,
+		Expires:    p.Expires,
+		Uses:       p.Uses,
+	}
+	if other.Uses != nil && (p.Uses == nil || *other.Uses < *p.Uses) {
+		perm.Uses = other.Uses
+	}
+	if cmp, err := other.Expires.Cmp(p.Expires); err == nil && cmp < 0 {
+		perm.Expires = other.Expires
+	}
+
+	switch {
+	case !perm.Vote:
+	case len(p.VoteProposals) == 0:
+		perm.VoteProposals = other.VoteProposals
+	case len(other.VoteProposals) == 0:
+		perm.VoteProposals = p.VoteProposals
+	default:
+		for _, id := range p.VoteProposals {
+			if slices.Contains(other.VoteProposals, id) {
+				perm.VoteProposals = append(perm.VoteProposals, id)
+			}
+		}
+		// no proposal in common
+		perm.Vote = len(perm.VoteProposals) > 0
+	}
+
+	return perm
 }
 
 type Allowances struct {
@@ -17,6 +129,39 @@ type ContractInfo struct {
 	Version string `json:"version"`
 }
 
-type BigMap struct {
-	Keys map[string][]byte
+// Hooks are the contracts notified of every subkey change
+type Hooks struct {
+	Hooks []string `json:"hooks"`
+}
+
+// TopUpConfig limits who can fund subkey allowances with attached coins.
+// Admins can always fund, anyone can if it isn't restricted
+type TopUpConfig struct {
+	Restricted bool     `json:"restricted"`
+	Funders    []string `json:"funders,omitempty"`
+}
+
+// CanFund checks if the address is one of the allowed funders
+func (c TopUpConfig) CanFund(addr string) bool {
+	return !c.Restricted || slices.Contains(c.Funders, addr)
+}
+
+// Grant links a subkey to the subkey that granted it and the subkeys it granted.
+// Subkeys set up by admins have no parent.
+type Grant struct {
+	Parent   string   `json:"parent,omitempty"`
+	Children []string `json:"children"`
+}
+
+// SpendRequestCount is the id of the last spend request filed
+type SpendRequestCount struct {
+	LastID uint64 `json:"last_id"`
+}
+
+// SpendRequest holds messages a subkey couldn't send on its own until an admin approves or rejects them
+type SpendRequest struct {
+	ID      uint64            `json:"id"`
+	Spender string            `json:"spender"`
+	Msgs    []types.CosmosMsg `json:"msgs"`
+	Expires Expiration        `json:"expires"`
 }
@@ -0,0 +1,166 @@
+package types
+
+import (
+	"time"
+
+	"github.com/CosmWasm/cosmwasm-go/std/math"
+	"github.com/CosmWasm/cosmwasm-go/std/types"
+	"github.com/JackalLabs/burrow-contracts/storage"
+)
+
+// binary codecs of the stored state. Values start with the version of their layout,
+// a changed layout bumps it and keeps decoding the older ones.
+// Version 0 is the layout written before values had a version, by the reflective codec
+// which wrote the fields in declaration order and AtTime with its own MarshalBinary
+
+const (
+	permissionsVersion = 1
+	allowancesVersion  = 1
+)
+
+// the kinds of Expiration in the binary layout, the zero value never expires
+const (
+	binaryExpiresDefault = iota
+	binaryExpiresAtHeight
+	binaryExpiresAtTime
+	binaryExpiresNever
+)
+
+func (p Permissions) MarshalBinary() ([]byte, error) {
+	var enc storage.Encoder
+	enc.Version(permissionsVersion)
+	enc.Bool(p.Delegate)
+	enc.Bool(p.Redelegate)
+	enc.Bool(p.Undelegate)
+	enc.Bool(p.Withdraw)
+	enc.Bool(p.Vote)
+	enc.Uint64(uint64(len(p.VoteProposals)))
+	for _, id := range p.VoteProposals {
+		enc.Uint64(id)
+	}
+	p.Expires.encodeBinary(&enc)
+	enc.Bool(p.Uses != nil)
+	if p.Uses != nil {
+		enc.Uint64(uint64(*p.Uses))
+	}
+	return enc.Data(), nil
+}
+
+func (p *Permissions) UnmarshalBinary(data []byte) error {
+	dec := storage.NewDecoder(data)
+	p.decodeBinary(dec, dec.Version(permissionsVersion))
+	return dec.Finish()
+}
+
+func (p *Permissions) UnmarshalUnversionedBinary(data []byte) error {
+	dec := storage.NewDecoder(data)
+	p.decodeBinary(dec, 0)
+	return dec.Finish()
+}
+
+func (p *Permissions) decodeBinary(dec *storage.Decoder, version byte) {
+	p.Delegate = dec.Bool()
+	p.Redelegate = dec.Bool()
+	p.Undelegate = dec.Bool()
+	p.Withdraw = dec.Bool()
+	p.Vote = dec.Bool()
+	p.VoteProposals = nil
+	if size := dec.Len(); size > 0 {
+		p.VoteProposals = make([]uint64, size)
+		for i := range p.VoteProposals {
+			p.VoteProposals[i] = dec.Uint64()
+		}
+	}
+	p.Expires.decodeBinary(dec, version)
+	p.Uses = nil
+	if dec.Bool() {
+		uses := dec.Uint32()
+		p.Uses = &uses
+	}
+}
+
+func (a Allowances) MarshalBinary() ([]byte, error) {
+	var enc storage.Encoder
+	enc.Version(allowancesVersion)
+	a.Balance.encodeBinary(&enc)
+	a.Expires.encodeBinary(&enc)
+	return enc.Data(), nil
+}
+
+func (a *Allowances) UnmarshalBinary(data []byte) error {
+	dec := storage.NewDecoder(data)
+	version := dec.Version(allowancesVersion)
+	a.Balance.decodeBinary(dec)
+	a.Expires.decodeBinary(dec, version)
+	return dec.Finish()
+}
+
+func (a *Allowances) UnmarshalUnversionedBinary(data []byte) error {
+	dec := storage.NewDecoder(data)
+	a.Balance.decodeBinary(dec)
+	a.Expires.decodeBinary(dec, 0)
+	return dec.Finish()
+}
+
+func (n NativeBalance) encodeBinary(enc *storage.Encoder) {
+	enc.Uint64(uint64(len(n.Coins)))
+	for _, coin := range n.Coins {
+		enc.String(coin.Denom)
+		enc.Uint64(coin.Amount.Lo)
+		enc.Uint64(coin.Amount.Hi)
+	}
+}
+
+func (n *NativeBalance) decodeBinary(dec *storage.Decoder) {
+	n.Coins = make([]types.Coin, dec.Len())
+	for i := range n.Coins {
+		n.Coins[i].Denom = dec.String()
+		n.Coins[i].Amount = math.NewUint128(dec.Uint64(), dec.Uint64())
+	}
+}
+
+// encodeBinary writes the kind of the expiration and its value, AtTime as seconds and nanoseconds since the epoch
+func (e Expiration) encodeBinary(enc *storage.Encoder) {
+	switch {
+	case e.AtHeight != nil:
+		enc.Uint64(binaryExpiresAtHeight)
+		enc.Uint64(*e.AtHeight)
+	case !e.AtTime.IsZero():
+		enc.Uint64(binaryExpiresAtTime)
+		enc.Int64(e.AtTime.Unix())
+		enc.Uint64(uint64(e.AtTime.Nanosecond()))
+	case e.Never:
+		enc.Uint64(binaryExpiresNever)
+	default:
+		enc.Uint64(binaryExpiresDefault)
+	}
+}
+
+func (e *Expiration) decodeBinary(dec *storage.Decoder, version byte) {
+	*e = Expiration{}
+	if version == 0 {
+		// a zero height was no height
+		if height := dec.Uint64(); height != 0 {
+			e.AtHeight = &height
+		}
+		err := e.AtTime.UnmarshalBinary(dec.Bytes())
+		if err != nil {
+			dec.Fail(err)
+		}
+		e.Never = dec.Bool()
+		return
+	}
+
+	switch dec.Uint64() {
+	case binaryExpiresDefault:
+	case binaryExpiresAtHeight:
+		height := dec.Uint64()
+		e.AtHeight = &height
+	case binaryExpiresAtTime:
+		e.AtTime = time.Unix(dec.Int64(), int64(dec.Uint32())).UTC()
+	case binaryExpiresNever:
+		e.Never = true
+	default:
+		dec.Fail(errExpirationVariant)
+	}
+}
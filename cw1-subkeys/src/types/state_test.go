@@ -0,0 +1,147 @@
+package types
+
+import (
+	"testing"
+	"time"
+
+	"github.com/CosmWasm/cosmwasm-go/std/math"
+	"github.com/CosmWasm/cosmwasm-go/std/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPermissionsVote(t *testing.T) {
+	all := Permissions{Vote: true}
+	some := Permissions{Vote: true, VoteProposals: []uint64{3, 4}}
+	other := Permissions{Vote: true, VoteProposals: []uint64{5}}
+
+	assert.True(t, all.CanVote(7))
+	assert.True(t, some.CanVote(4))
+	assert.False(t, some.CanVote(7))
+	assert.False(t, Permissions{}.CanVote(7))
+
+	assert.True(t, all.Covers(some))
+	assert.False(t, some.Covers(all))
+	assert.True(t, some.Covers(Permissions{Vote: true, VoteProposals: []uint64{4}}))
+	assert.False(t, some.Covers(other))
+	assert.True(t, some.Covers(Permissions{Delegate: false}))
+
+	assert.Equal(t, some, all.Intersect(some))
+	assert.Equal(t, Permissions{Vote: true, VoteProposals: []uint64{4}}, some.Intersect(Permissions{Vote: true, VoteProposals: []uint64{4, 9}}))
+	assert.Equal(t, Permissions{}, some.Intersect(other))
+	assert.Equal(t, Permissions{}, some.Intersect(Permissions{Delegate: false}))
+}
+
+func TestPermissionsLimits(t *testing.T) {
+	one, two := uint32(1), uint32(2)
+	limited := Permissions{Delegate: true, Expires: ExpiresAtHeight(100), Uses: &two}
+
+	assert.True(t, limited.Covers(Permissions{Delegate: true, Expires: ExpiresAtHeight(100), Uses: &one}))
+	assert.False(t, limited.Covers(Permissions{Delegate: true, Expires: ExpiresAtHeight(100)}))
+	assert.False(t, limited.Covers(Permissions{Delegate: true, Expires: ExpiresAtHeight(101), Uses: &one}))
+	assert.False(t, limited.Covers(Permissions{Delegate: true, Uses: &one}))
+	assert.False(t, limited.Covers(Permissions{Delegate: true, Expires: Expiration{AtTime: time.Unix(1, 0)}, Uses: &one}))
+	assert.True(t, Permissions{Delegate: true}.Covers(limited))
+
+	// the narrower limits of either side are kept
+	narrowed := Permissions{Delegate: true, Uses: &one}.Intersect(limited)
+	assert.Equal(t, Permissions{Delegate: true, Expires: ExpiresAtHeight(100), Uses: &one}, narrowed)
+}
+
+var (
+	uses = uint32(3)
+
+	storedPermissions = Permissions{
+		Delegate:      true,
+		Vote:          true,
+		VoteProposals: []uint64{4, 300},
+		Expires:       Expiration{AtTime: time.Unix(1700000000, 5).UTC()},
+		Uses:          &uses,
+	}
+	storedAllowances = Allowances{
+		Balance: NativeBalance{Coins: []types.Coin{
+			types.NewCoin(math.NewUint128FromUint64(1000000), "ujkl"),
+			types.NewCoin(math.NewUint128(0, 1), "ibc/27394FB092D2ECCD56123C74F36E4C1F926001CEADA9CA97EA622B25F41E5EB2"),
+		}},
+		Expires: ExpiresAtHeight(12345),
+	}
+)
+
+func TestStateBinary(t *testing.T) {
+	for _, perm := range []Permissions{storedPermissions, {}, {Expires: Expiration{Never: true}}, {Expires: ExpiresAtHeight(0)}} {
+		bz, err := perm.MarshalBinary()
+		require.NoError(t, err)
+
+		var decoded Permissions
+		require.NoError(t, decoded.UnmarshalBinary(bz))
+		assert.Equal(t, perm, decoded)
+
+		assert.Error(t, decoded.UnmarshalBinary(bz[:len(bz)-1]))
+	}
+
+	bz, err := storedAllowances.MarshalBinary()
+	require.NoError(t, err)
+
+	var decoded Allowances
+	require.NoError(t, decoded.UnmarshalBinary(bz))
+	assert.Equal(t, storedAllowances, decoded)
+	assert.Error(t, decoded.UnmarshalBinary(append(bz, 0)))
+	assert.Error(t, decoded.UnmarshalBinary(append([]byte{allowancesVersion + 1}, bz[1:]...)))
+}
+
+func TestStateBinaryLayout(t *testing.T) {
+	// the layout is frozen, changing it needs a new version
+	bz, err := storedPermissions.MarshalBinary()
+	require.NoError(t, err)
+	assert.Equal(t, []byte("\x01\x01\x00\x00\x00\x01\x02\x04\xac\x02\x02\x80\xc4\x9f\xd5\x0c\x05\x01\x03"), bz)
+
+	bz, err = storedAllowances.MarshalBinary()
+	require.NoError(t, err)
+	assert.Equal(t, []byte("\x01\x02\x04ujkl\xc0\x84=\x00Dibc/27394FB092D2ECCD56123C74F36E4C1F926001CEADA9CA97EA622B25F41E5EB2\x00\x01\x01\xb9`"), bz)
+
+	// values written before the layout had a version
+	var perm Permissions
+	require.NoError(t, perm.UnmarshalUnversionedBinary([]byte("\x01\x00\x00\x00\x01\x02\x04\xac\x02\x00\x0f\x01\x00\x00\x00\x0e\xdc\xe5\xe8\x00\x00\x00\x00\x05\xff\xff\x00\x01\x03")))
+	assert.Equal(t, storedPermissions, perm)
+	require.NoError(t, perm.UnmarshalUnversionedBinary([]byte("\x00\x00\x00\x00\x00\x00\x00\x0f\x01\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\xff\xff\x01\x00")))
+	assert.Equal(t, Permissions{Expires: Expiration{Never: true}}, perm)
+
+	var allow Allowances
+	require.NoError(t, allow.UnmarshalUnversionedBinary([]byte("\x02\x04ujkl\xc0\x84=\x00Dibc/27394FB092D2ECCD56123C74F36E4C1F926001CEADA9CA97EA622B25F41E5EB2\x00\x01\xb9`\x0f\x01\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\xff\xff\x00")))
+	assert.Equal(t, storedAllowances, allow)
+}
+
+// writeGas is the cosmos-sdk default gas of writing the value, ignoring the key
+func writeGas(value []byte) float64 {
+	const writeCostFlat, writeCostPerByte = 2000, 30
+	return float64(writeCostFlat + writeCostPerByte*len(value))
+}
+
+func benchmarkEncoding(b *testing.B, encode func() ([]byte, error)) {
+	var bz []byte
+	var err error
+	for i := 0; i < b.N; i++ {
+		bz, err = encode()
+		if err != nil {
+			b.Fatal(err)
+		}
+	}
+	b.ReportMetric(float64(len(bz)), "bytes/op")
+	b.ReportMetric(writeGas(bz), "gas/op")
+}
+
+func BenchmarkPermissionsJSON(b *testing.B) {
+	benchmarkEncoding(b, storedPermissions.MarshalJSON)
+}
+
+func BenchmarkPermissionsBinary(b *testing.B) {
+	benchmarkEncoding(b, storedPermissions.MarshalBinary)
+}
+
+func BenchmarkAllowancesJSON(b *testing.B) {
+	benchmarkEncoding(b, storedAllowances.MarshalJSON)
+}
+
+func BenchmarkAllowancesBinary(b *testing.B) {
+	benchmarkEncoding(b, storedAllowances.MarshalBinary)
+}
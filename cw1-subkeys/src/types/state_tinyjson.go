@@ -16,7 +16,7 @@ var (
 	_ tinyjson.Marshaler
 )
 
-func tinyjson4e1b3cc1DecodeGithubComJackalLabsBurrowContractsCw1SubkeysSrcTypes(in *jlexer.Lexer, out *Permissions) {
+func tinyjson4e1b3cc1DecodeGithubComJackalLabsBurrowContractsCw1SubkeysSrcTypes(in *jlexer.Lexer, out *TopUpConfig) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -35,14 +35,31 @@ func tinyjson4e1b3cc1DecodeGithubComJackalLabsBurrowContractsCw1SubkeysSrcTypes(
 			continue
 		}
 		switch key {
-		case "delegate":
-			out.Delegate = bool(in.Bool())
-		case "redelegate":
-			out.Redelegate = bool(in.Bool())
-		case "undelegate":
-			out.Undelegate = bool(in.Bool())
-		case "withdraw":
-			out.Withdraw = bool(in.Bool())
+		case "restricted":
+			out.Restricted = bool(in.Bool())
+		case "funders":
+			if in.IsNull() {
+				in.Skip()
+				out.Funders = nil
+			} else {
+				in.Delim('[')
+				if out.Funders == nil {
+					if !in.IsDelim(']') {
+						out.Funders = make([]string, 0, 4)
+					} else {
+						out.Funders = []string{}
+					}
+				} else {
+					out.Funders = (out.Funders)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v1 string
+					v1 = string(in.String())
+					out.Funders = append(out.Funders, v1)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
 		default:
 			in.SkipRecursive()
 		}
@@ -53,57 +70,56 @@ func tinyjson4e1b3cc1DecodeGithubComJackalLabsBurrowContractsCw1SubkeysSrcTypes(
 		in.Consumed()
 	}
 }
-func tinyjson4e1b3cc1EncodeGithubComJackalLabsBurrowContractsCw1SubkeysSrcTypes(out *jwriter.Writer, in Permissions) {
+func tinyjson4e1b3cc1EncodeGithubComJackalLabsBurrowContractsCw1SubkeysSrcTypes(out *jwriter.Writer, in TopUpConfig) {
 	out.RawByte('{')
 	first := true
 	_ = first
 	{
-		const prefix string = ",\"delegate\":"
+		const prefix string = ",\"restricted\":"
 		out.RawString(prefix[1:])
-		out.Bool(bool(in.Delegate))
+		out.Bool(bool(in.Restricted))
 	}
-	{
-		const prefix string = ",\"redelegate\":"
+	if len(in.Funders) != 0 {
+		const prefix string = ",\"funders\":"
 		out.RawString(prefix)
-		out.Bool(bool(in.Redelegate))
-	}
-	{
-		const prefix string = ",\"undelegate\":"
-		out.RawString(prefix)
-		out.Bool(bool(in.Undelegate))
-	}
-	{
-		const prefix string = ",\"withdraw\":"
-		out.RawString(prefix)
-		out.Bool(bool(in.Withdraw))
+		{
+			out.RawByte('[')
+			for v2, v3 := range in.Funders {
+				if v2 > 0 {
+					out.RawByte(',')
+				}
+				out.String(string(v3))
+			}
+			out.RawByte(']')
+		}
 	}
 	out.RawByte('}')
 }
 
 // MarshalJSON supports json.Marshaler interface
-func (v Permissions) MarshalJSON() ([]byte, error) {
+func (v TopUpConfig) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
 	tinyjson4e1b3cc1EncodeGithubComJackalLabsBurrowContractsCw1SubkeysSrcTypes(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalTinyJSON supports tinyjson.Marshaler interface
-func (v Permissions) MarshalTinyJSON(w *jwriter.Writer) {
+func (v TopUpConfig) MarshalTinyJSON(w *jwriter.Writer) {
 	tinyjson4e1b3cc1EncodeGithubComJackalLabsBurrowContractsCw1SubkeysSrcTypes(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
-func (v *Permissions) UnmarshalJSON(data []byte) error {
+func (v *TopUpConfig) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
 	tinyjson4e1b3cc1DecodeGithubComJackalLabsBurrowContractsCw1SubkeysSrcTypes(&r, v)
 	return r.Error()
 }
 
 // UnmarshalTinyJSON supports tinyjson.Unmarshaler interface
-func (v *Permissions) UnmarshalTinyJSON(l *jlexer.Lexer) {
+func (v *TopUpConfig) UnmarshalTinyJSON(l *jlexer.Lexer) {
 	tinyjson4e1b3cc1DecodeGithubComJackalLabsBurrowContractsCw1SubkeysSrcTypes(l, v)
 }
-func tinyjson4e1b3cc1DecodeGithubComJackalLabsBurrowContractsCw1SubkeysSrcTypes1(in *jlexer.Lexer, out *ContractInfo) {
+func tinyjson4e1b3cc1DecodeGithubComJackalLabsBurrowContractsCw1SubkeysSrcTypes1(in *jlexer.Lexer, out *SpendRequestCount) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -122,10 +138,8 @@ func tinyjson4e1b3cc1DecodeGithubComJackalLabsBurrowContractsCw1SubkeysSrcTypes1
 			continue
 		}
 		switch key {
-		case "name":
-			out.Name = string(in.String())
-		case "version":
-			out.Version = string(in.String())
+		case "last_id":
+			out.LastID = uint64(in.Uint64())
 		default:
 			in.SkipRecursive()
 		}
@@ -136,47 +150,42 @@ func tinyjson4e1b3cc1DecodeGithubComJackalLabsBurrowContractsCw1SubkeysSrcTypes1
 		in.Consumed()
 	}
 }
-func tinyjson4e1b3cc1EncodeGithubComJackalLabsBurrowContractsCw1SubkeysSrcTypes1(out *jwriter.Writer, in ContractInfo) {
+func tinyjson4e1b3cc1EncodeGithubComJackalLabsBurrowContractsCw1SubkeysSrcTypes1(out *jwriter.Writer, in SpendRequestCount) {
 	out.RawByte('{')
 	first := true
 	_ = first
 	{
-		const prefix string = ",\"name\":"
+		const prefix string = ",\"last_id\":"
 		out.RawString(prefix[1:])
-		out.String(string(in.Name))
-	}
-	{
-		const prefix string = ",\"version\":"
-		out.RawString(prefix)
-		out.String(string(in.Version))
+		out.Uint64(uint64(in.LastID))
 	}
 	out.RawByte('}')
 }
 
 // MarshalJSON supports json.Marshaler interface
-func (v ContractInfo) MarshalJSON() ([]byte, error) {
+func (v SpendRequestCount) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
 	tinyjson4e1b3cc1EncodeGithubComJackalLabsBurrowContractsCw1SubkeysSrcTypes1(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalTinyJSON supports tinyjson.Marshaler interface
-func (v ContractInfo) MarshalTinyJSON(w *jwriter.Writer) {
+func (v SpendRequestCount) MarshalTinyJSON(w *jwriter.Writer) {
 	tinyjson4e1b3cc1EncodeGithubComJackalLabsBurrowContractsCw1SubkeysSrcTypes1(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
-func (v *ContractInfo) UnmarshalJSON(data []byte) error {
+func (v *SpendRequestCount) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
 	tinyjson4e1b3cc1DecodeGithubComJackalLabsBurrowContractsCw1SubkeysSrcTypes1(&r, v)
 	return r.Error()
 }
 
 // UnmarshalTinyJSON supports tinyjson.Unmarshaler interface
-func (v *ContractInfo) UnmarshalTinyJSON(l *jlexer.Lexer) {
+func (v *SpendRequestCount) UnmarshalTinyJSON(l *jlexer.Lexer) {
 	tinyjson4e1b3cc1DecodeGithubComJackalLabsBurrowContractsCw1SubkeysSrcTypes1(l, v)
 }
-func tinyjson4e1b3cc1DecodeGithubComJackalLabsBurrowContractsCw1SubkeysSrcTypes2(in *jlexer.Lexer, out *BigMap) {
+func tinyjson4e1b3cc1DecodeGithubComJackalLabsBurrowContractsCw1SubkeysSrcTypes2(in *jlexer.Lexer, out *SpendRequest) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -195,27 +204,35 @@ func tinyjson4e1b3cc1DecodeGithubComJackalLabsBurrowContractsCw1SubkeysSrcTypes2
 			continue
 		}
 		switch key {
-		case "keys":
+		case "id":
+			out.ID = uint64(in.Uint64())
+		case "spender":
+			out.Spender = string(in.String())
+		case "msgs":
 			if in.IsNull() {
 				in.Skip()
+				out.Msgs = nil
 			} else {
-				in.Delim('{')
-				out.Keys = make(map[string][]uint8)
-				for !in.IsDelim('}') {
-					key := string(in.String())
-					in.WantColon()
-					var v1 []uint8
-					if in.IsNull() {
-						in.Skip()
-						v1 = nil
+				in.Delim('[')
+				if out.Msgs == nil {
+					if !in.IsDelim(']') {
+						out.Msgs = make([]types.CosmosMsg, 0, 0)
 					} else {
-						v1 = in.Bytes()
+						out.Msgs = []types.CosmosMsg{}
 					}
-					(out.Keys)[key] = v1
+				} else {
+					out.Msgs = (out.Msgs)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v4 types.CosmosMsg
+					(v4).UnmarshalTinyJSON(in)
+					out.Msgs = append(out.Msgs, v4)
 					in.WantComma()
 				}
-				in.Delim('}')
+				in.Delim(']')
 			}
+		case "expires":
+			(out.Expires).UnmarshalTinyJSON(in)
 		default:
 			in.SkipRecursive()
 		}
@@ -226,58 +243,68 @@ func tinyjson4e1b3cc1DecodeGithubComJackalLabsBurrowContractsCw1SubkeysSrcTypes2
 		in.Consumed()
 	}
 }
-func tinyjson4e1b3cc1EncodeGithubComJackalLabsBurrowContractsCw1SubkeysSrcTypes2(out *jwriter.Writer, in BigMap) {
+func tinyjson4e1b3cc1EncodeGithubComJackalLabsBurrowContractsCw1SubkeysSrcTypes2(out *jwriter.Writer, in SpendRequest) {
 	out.RawByte('{')
 	first := true
 	_ = first
 	{
-		const prefix string = ",\"keys\":"
+		const prefix string = ",\"id\":"
 		out.RawString(prefix[1:])
-		if in.Keys == nil && (out.Flags&jwriter.NilMapAsEmpty) == 0 {
-			out.RawString(`null`)
+		out.Uint64(uint64(in.ID))
+	}
+	{
+		const prefix string = ",\"spender\":"
+		out.RawString(prefix)
+		out.String(string(in.Spender))
+	}
+	{
+		const prefix string = ",\"msgs\":"
+		out.RawString(prefix)
+		if in.Msgs == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
 		} else {
-			out.RawByte('{')
-			v3First := true
-			for v3Name, v3Value := range in.Keys {
-				if v3First {
-					v3First = false
-				} else {
+			out.RawByte('[')
+			for v5, v6 := range in.Msgs {
+				if v5 > 0 {
 					out.RawByte(',')
 				}
-				out.String(string(v3Name))
-				out.RawByte(':')
-				out.Base64Bytes(v3Value)
+				(v6).MarshalTinyJSON(out)
 			}
-			out.RawByte('}')
+			out.RawByte(']')
 		}
 	}
+	{
+		const prefix string = ",\"expires\":"
+		out.RawString(prefix)
+		(in.Expires).MarshalTinyJSON(out)
+	}
 	out.RawByte('}')
 }
 
 // MarshalJSON supports json.Marshaler interface
-func (v BigMap) MarshalJSON() ([]byte, error) {
+func (v SpendRequest) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
 	tinyjson4e1b3cc1EncodeGithubComJackalLabsBurrowContractsCw1SubkeysSrcTypes2(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalTinyJSON supports tinyjson.Marshaler interface
-func (v BigMap) MarshalTinyJSON(w *jwriter.Writer) {
+func (v SpendRequest) MarshalTinyJSON(w *jwriter.Writer) {
 	tinyjson4e1b3cc1EncodeGithubComJackalLabsBurrowContractsCw1SubkeysSrcTypes2(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
-func (v *BigMap) UnmarshalJSON(data []byte) error {
+func (v *SpendRequest) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
 	tinyjson4e1b3cc1DecodeGithubComJackalLabsBurrowContractsCw1SubkeysSrcTypes2(&r, v)
 	return r.Error()
 }
 
 // UnmarshalTinyJSON supports tinyjson.Unmarshaler interface
-func (v *BigMap) UnmarshalTinyJSON(l *jlexer.Lexer) {
+func (v *SpendRequest) UnmarshalTinyJSON(l *jlexer.Lexer) {
 	tinyjson4e1b3cc1DecodeGithubComJackalLabsBurrowContractsCw1SubkeysSrcTypes2(l, v)
 }
-func tinyjson4e1b3cc1DecodeGithubComJackalLabsBurrowContractsCw1SubkeysSrcTypes3(in *jlexer.Lexer, out *Allowances) {
+func tinyjson4e1b3cc1DecodeGithubComJackalLabsBurrowContractsCw1SubkeysSrcTypes3(in *jlexer.Lexer, out *Permissions) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -296,10 +323,51 @@ func tinyjson4e1b3cc1DecodeGithubComJackalLabsBurrowContractsCw1SubkeysSrcTypes3
 			continue
 		}
 		switch key {
-		case "native_balance":
-			tinyjson4e1b3cc1DecodeGithubComJackalLabsBurrowContractsCw1SubkeysSrcTypes4(in, &out.Balance)
-		case "expiration":
-			tinyjson4e1b3cc1DecodeGithubComJackalLabsBurrowContractsCw1SubkeysSrcTypes5(in, &out.Expires)
+		case "delegate":
+			out.Delegate = bool(in.Bool())
+		case "redelegate":
+			out.Redelegate = bool(in.Bool())
+		case "undelegate":
+			out.Undelegate = bool(in.Bool())
+		case "withdraw":
+			out.Withdraw = bool(in.Bool())
+		case "vote":
+			out.Vote = bool(in.Bool())
+		case "vote_proposals":
+			if in.IsNull() {
+				in.Skip()
+				out.VoteProposals = nil
+			} else {
+				in.Delim('[')
+				if out.VoteProposals == nil {
+					if !in.IsDelim(']') {
+						out.VoteProposals = make([]uint64, 0, 8)
+					} else {
+						out.VoteProposals = []uint64{}
+					}
+				} else {
+					out.VoteProposals = (out.VoteProposals)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v7 uint64
+					v7 = uint64(in.Uint64())
+					out.VoteProposals = append(out.VoteProposals, v7)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "expires":
+			(out.Expires).UnmarshalTinyJSON(in)
+		case "uses":
+			if in.IsNull() {
+				in.Skip()
+				out.Uses = nil
+			} else {
+				if out.Uses == nil {
+					out.Uses = new(uint32)
+				}
+				*out.Uses = uint32(in.Uint32())
+			}
 		default:
 			in.SkipRecursive()
 		}
@@ -310,47 +378,86 @@ func tinyjson4e1b3cc1DecodeGithubComJackalLabsBurrowContractsCw1SubkeysSrcTypes3
 		in.Consumed()
 	}
 }
-func tinyjson4e1b3cc1EncodeGithubComJackalLabsBurrowContractsCw1SubkeysSrcTypes3(out *jwriter.Writer, in Allowances) {
+func tinyjson4e1b3cc1EncodeGithubComJackalLabsBurrowContractsCw1SubkeysSrcTypes3(out *jwriter.Writer, in Permissions) {
 	out.RawByte('{')
 	first := true
 	_ = first
 	{
-		const prefix string = ",\"native_balance\":"
+		const prefix string = ",\"delegate\":"
 		out.RawString(prefix[1:])
-		tinyjson4e1b3cc1EncodeGithubComJackalLabsBurrowContractsCw1SubkeysSrcTypes4(out, in.Balance)
+		out.Bool(bool(in.Delegate))
 	}
 	{
-		const prefix string = ",\"expiration\":"
+		const prefix string = ",\"redelegate\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.Redelegate))
+	}
+	{
+		const prefix string = ",\"undelegate\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.Undelegate))
+	}
+	{
+		const prefix string = ",\"withdraw\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.Withdraw))
+	}
+	{
+		const prefix string = ",\"vote\":"
 		out.RawString(prefix)
-		tinyjson4e1b3cc1EncodeGithubComJackalLabsBurrowContractsCw1SubkeysSrcTypes5(out, in.Expires)
+		out.Bool(bool(in.Vote))
+	}
+	if len(in.VoteProposals) != 0 {
+		const prefix string = ",\"vote_proposals\":"
+		out.RawString(prefix)
+		{
+			out.RawByte('[')
+			for v8, v9 := range in.VoteProposals {
+				if v8 > 0 {
+					out.RawByte(',')
+				}
+				out.Uint64(uint64(v9))
+			}
+			out.RawByte(']')
+		}
+	}
+	{
+		const prefix string = ",\"expires\":"
+		out.RawString(prefix)
+		(in.Expires).MarshalTinyJSON(out)
+	}
+	if in.Uses != nil {
+		const prefix string = ",\"uses\":"
+		out.RawString(prefix)
+		out.Uint32(uint32(*in.Uses))
 	}
 	out.RawByte('}')
 }
 
 // MarshalJSON supports json.Marshaler interface
-func (v Allowances) MarshalJSON() ([]byte, error) {
+func (v Permissions) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
 	tinyjson4e1b3cc1EncodeGithubComJackalLabsBurrowContractsCw1SubkeysSrcTypes3(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalTinyJSON supports tinyjson.Marshaler interface
-func (v Allowances) MarshalTinyJSON(w *jwriter.Writer) {
+func (v Permissions) MarshalTinyJSON(w *jwriter.Writer) {
 	tinyjson4e1b3cc1EncodeGithubComJackalLabsBurrowContractsCw1SubkeysSrcTypes3(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
-func (v *Allowances) UnmarshalJSON(data []byte) error {
+func (v *Permissions) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
 	tinyjson4e1b3cc1DecodeGithubComJackalLabsBurrowContractsCw1SubkeysSrcTypes3(&r, v)
 	return r.Error()
 }
 
 // UnmarshalTinyJSON supports tinyjson.Unmarshaler interface
-func (v *Allowances) UnmarshalTinyJSON(l *jlexer.Lexer) {
+func (v *Permissions) UnmarshalTinyJSON(l *jlexer.Lexer) {
 	tinyjson4e1b3cc1DecodeGithubComJackalLabsBurrowContractsCw1SubkeysSrcTypes3(l, v)
 }
-func tinyjson4e1b3cc1DecodeGithubComJackalLabsBurrowContractsCw1SubkeysSrcTypes5(in *jlexer.Lexer, out *Expiration) {
+func tinyjson4e1b3cc1DecodeGithubComJackalLabsBurrowContractsCw1SubkeysSrcTypes4(in *jlexer.Lexer, out *Hooks) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -369,14 +476,29 @@ func tinyjson4e1b3cc1DecodeGithubComJackalLabsBurrowContractsCw1SubkeysSrcTypes5
 			continue
 		}
 		switch key {
-		case "at_height":
-			out.AtHeight = uint64(in.Uint64())
-		case "at_time":
-			if data := in.Raw(); in.Ok() {
-				in.AddError((out.AtTime).UnmarshalJSON(data))
+		case "hooks":
+			if in.IsNull() {
+				in.Skip()
+				out.Hooks = nil
+			} else {
+				in.Delim('[')
+				if out.Hooks == nil {
+					if !in.IsDelim(']') {
+						out.Hooks = make([]string, 0, 4)
+					} else {
+						out.Hooks = []string{}
+					}
+				} else {
+					out.Hooks = (out.Hooks)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v10 string
+					v10 = string(in.String())
+					out.Hooks = append(out.Hooks, v10)
+					in.WantComma()
+				}
+				in.Delim(']')
 			}
-		case "never":
-			out.Never = bool(in.Bool())
 		default:
 			in.SkipRecursive()
 		}
@@ -387,28 +509,53 @@ func tinyjson4e1b3cc1DecodeGithubComJackalLabsBurrowContractsCw1SubkeysSrcTypes5
 		in.Consumed()
 	}
 }
-func tinyjson4e1b3cc1EncodeGithubComJackalLabsBurrowContractsCw1SubkeysSrcTypes5(out *jwriter.Writer, in Expiration) {
+func tinyjson4e1b3cc1EncodeGithubComJackalLabsBurrowContractsCw1SubkeysSrcTypes4(out *jwriter.Writer, in Hooks) {
 	out.RawByte('{')
 	first := true
 	_ = first
 	{
-		const prefix string = ",\"at_height\":"
+		const prefix string = ",\"hooks\":"
 		out.RawString(prefix[1:])
-		out.Uint64(uint64(in.AtHeight))
-	}
-	{
-		const prefix string = ",\"at_time\":"
-		out.RawString(prefix)
-		out.Raw((in.AtTime).MarshalJSON())
-	}
-	{
-		const prefix string = ",\"never\":"
-		out.RawString(prefix)
-		out.Bool(bool(in.Never))
+		if in.Hooks == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v11, v12 := range in.Hooks {
+				if v11 > 0 {
+					out.RawByte(',')
+				}
+				out.String(string(v12))
+			}
+			out.RawByte(']')
+		}
 	}
 	out.RawByte('}')
 }
-func tinyjson4e1b3cc1DecodeGithubComJackalLabsBurrowContractsCw1SubkeysSrcTypes4(in *jlexer.Lexer, out *NativeBalance) {
+
+// MarshalJSON supports json.Marshaler interface
+func (v Hooks) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	tinyjson4e1b3cc1EncodeGithubComJackalLabsBurrowContractsCw1SubkeysSrcTypes4(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalTinyJSON supports tinyjson.Marshaler interface
+func (v Hooks) MarshalTinyJSON(w *jwriter.Writer) {
+	tinyjson4e1b3cc1EncodeGithubComJackalLabsBurrowContractsCw1SubkeysSrcTypes4(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *Hooks) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	tinyjson4e1b3cc1DecodeGithubComJackalLabsBurrowContractsCw1SubkeysSrcTypes4(&r, v)
+	return r.Error()
+}
+
+// UnmarshalTinyJSON supports tinyjson.Unmarshaler interface
+func (v *Hooks) UnmarshalTinyJSON(l *jlexer.Lexer) {
+	tinyjson4e1b3cc1DecodeGithubComJackalLabsBurrowContractsCw1SubkeysSrcTypes4(l, v)
+}
+func tinyjson4e1b3cc1DecodeGithubComJackalLabsBurrowContractsCw1SubkeysSrcTypes5(in *jlexer.Lexer, out *Grant) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -427,25 +574,27 @@ func tinyjson4e1b3cc1DecodeGithubComJackalLabsBurrowContractsCw1SubkeysSrcTypes4
 			continue
 		}
 		switch key {
-		case "coins":
+		case "parent":
+			out.Parent = string(in.String())
+		case "children":
 			if in.IsNull() {
 				in.Skip()
-				out.Coins = nil
+				out.Children = nil
 			} else {
 				in.Delim('[')
-				if out.Coins == nil {
+				if out.Children == nil {
 					if !in.IsDelim(']') {
-						out.Coins = make([]types.Coin, 0, 2)
+						out.Children = make([]string, 0, 4)
 					} else {
-						out.Coins = []types.Coin{}
+						out.Children = []string{}
 					}
 				} else {
-					out.Coins = (out.Coins)[:0]
+					out.Children = (out.Children)[:0]
 				}
 				for !in.IsDelim(']') {
-					var v6 types.Coin
-					(v6).UnmarshalTinyJSON(in)
-					out.Coins = append(out.Coins, v6)
+					var v13 string
+					v13 = string(in.String())
+					out.Children = append(out.Children, v13)
 					in.WantComma()
 				}
 				in.Delim(']')
@@ -460,25 +609,206 @@ func tinyjson4e1b3cc1DecodeGithubComJackalLabsBurrowContractsCw1SubkeysSrcTypes4
 		in.Consumed()
 	}
 }
-func tinyjson4e1b3cc1EncodeGithubComJackalLabsBurrowContractsCw1SubkeysSrcTypes4(out *jwriter.Writer, in NativeBalance) {
+func tinyjson4e1b3cc1EncodeGithubComJackalLabsBurrowContractsCw1SubkeysSrcTypes5(out *jwriter.Writer, in Grant) {
 	out.RawByte('{')
 	first := true
 	_ = first
-	{
-		const prefix string = ",\"coins\":"
+	if in.Parent != "" {
+		const prefix string = ",\"parent\":"
+		first = false
 		out.RawString(prefix[1:])
-		if in.Coins == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+		out.String(string(in.Parent))
+	}
+	{
+		const prefix string = ",\"children\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		if in.Children == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
 			out.RawString("null")
 		} else {
 			out.RawByte('[')
-			for v7, v8 := range in.Coins {
-				if v7 > 0 {
+			for v14, v15 := range in.Children {
+				if v14 > 0 {
 					out.RawByte(',')
 				}
-				(v8).MarshalTinyJSON(out)
+				out.String(string(v15))
 			}
 			out.RawByte(']')
 		}
 	}
 	out.RawByte('}')
 }
+
+// MarshalJSON supports json.Marshaler interface
+func (v Grant) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	tinyjson4e1b3cc1EncodeGithubComJackalLabsBurrowContractsCw1SubkeysSrcTypes5(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalTinyJSON supports tinyjson.Marshaler interface
+func (v Grant) MarshalTinyJSON(w *jwriter.Writer) {
+	tinyjson4e1b3cc1EncodeGithubComJackalLabsBurrowContractsCw1SubkeysSrcTypes5(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *Grant) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	tinyjson4e1b3cc1DecodeGithubComJackalLabsBurrowContractsCw1SubkeysSrcTypes5(&r, v)
+	return r.Error()
+}
+
+// UnmarshalTinyJSON supports tinyjson.Unmarshaler interface
+func (v *Grant) UnmarshalTinyJSON(l *jlexer.Lexer) {
+	tinyjson4e1b3cc1DecodeGithubComJackalLabsBurrowContractsCw1SubkeysSrcTypes5(l, v)
+}
+func tinyjson4e1b3cc1DecodeGithubComJackalLabsBurrowContractsCw1SubkeysSrcTypes6(in *jlexer.Lexer, out *ContractInfo) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "name":
+			out.Name = string(in.String())
+		case "version":
+			out.Version = string(in.String())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func tinyjson4e1b3cc1EncodeGithubComJackalLabsBurrowContractsCw1SubkeysSrcTypes6(out *jwriter.Writer, in ContractInfo) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"name\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.Name))
+	}
+	{
+		const prefix string = ",\"version\":"
+		out.RawString(prefix)
+		out.String(string(in.Version))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v ContractInfo) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	tinyjson4e1b3cc1EncodeGithubComJackalLabsBurrowContractsCw1SubkeysSrcTypes6(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalTinyJSON supports tinyjson.Marshaler interface
+func (v ContractInfo) MarshalTinyJSON(w *jwriter.Writer) {
+	tinyjson4e1b3cc1EncodeGithubComJackalLabsBurrowContractsCw1SubkeysSrcTypes6(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *ContractInfo) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	tinyjson4e1b3cc1DecodeGithubComJackalLabsBurrowContractsCw1SubkeysSrcTypes6(&r, v)
+	return r.Error()
+}
+
+// UnmarshalTinyJSON supports tinyjson.Unmarshaler interface
+func (v *ContractInfo) UnmarshalTinyJSON(l *jlexer.Lexer) {
+	tinyjson4e1b3cc1DecodeGithubComJackalLabsBurrowContractsCw1SubkeysSrcTypes6(l, v)
+}
+func tinyjson4e1b3cc1DecodeGithubComJackalLabsBurrowContractsCw1SubkeysSrcTypes7(in *jlexer.Lexer, out *Allowances) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "native_balance":
+			(out.Balance).UnmarshalTinyJSON(in)
+		case "expiration":
+			(out.Expires).UnmarshalTinyJSON(in)
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func tinyjson4e1b3cc1EncodeGithubComJackalLabsBurrowContractsCw1SubkeysSrcTypes7(out *jwriter.Writer, in Allowances) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"native_balance\":"
+		out.RawString(prefix[1:])
+		(in.Balance).MarshalTinyJSON(out)
+	}
+	{
+		const prefix string = ",\"expiration\":"
+		out.RawString(prefix)
+		(in.Expires).MarshalTinyJSON(out)
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v Allowances) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	tinyjson4e1b3cc1EncodeGithubComJackalLabsBurrowContractsCw1SubkeysSrcTypes7(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalTinyJSON supports tinyjson.Marshaler interface
+func (v Allowances) MarshalTinyJSON(w *jwriter.Writer) {
+	tinyjson4e1b3cc1EncodeGithubComJackalLabsBurrowContractsCw1SubkeysSrcTypes7(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *Allowances) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	tinyjson4e1b3cc1DecodeGithubComJackalLabsBurrowContractsCw1SubkeysSrcTypes7(&r, v)
+	return r.Error()
+}
+
+// UnmarshalTinyJSON supports tinyjson.Unmarshaler interface
+func (v *Allowances) UnmarshalTinyJSON(l *jlexer.Lexer) {
+	tinyjson4e1b3cc1DecodeGithubComJackalLabsBurrowContractsCw1SubkeysSrcTypes7(l, v)
+}
@@ -2,8 +2,9 @@ package types
 
 import (
 	"errors"
-	"reflect"
-	"sort"
+	"math"
+	"slices"
+	"strings"
 	"time"
 
 	"github.com/CosmWasm/cosmwasm-go/std/types"
@@ -12,53 +13,72 @@ import (
 // https://docs.rs/cw-utils/1.0.2/src/cw_utils/balance.rs.html
 
 // NATIVE BALANCE
+
+// NativeBalance is a set of coins. Its methods never modify the receiver,
+// and return balances that are normalized: sorted by denom, without zero or duplicate denoms
 type NativeBalance struct {
 	Coins []types.Coin
 }
 
-// Normalize sorts the wallet by denom, removes 0 elements, and consolidates duplicate denoms.
-func (n *NativeBalance) Normalize() {
-	// Drop 0's
-	n.Coins = removeZeroAmounts(n.Coins)
+// UnderflowError reports the denom that a subtraction would take below zero
+type UnderflowError struct {
+	Denom string
+}
 
-	// Sort
-	sortCoinsByDenom(n.Coins)
+func (e UnderflowError) Error() string {
+	return "Insufficient " + e.Denom + " balance"
+}
+
+var errBalanceOverflow = errors.New("overflow error")
 
-	// Consolidate duplicate denoms
-	consolidateDuplicates(n.Coins)
+// Normalize sorts the wallet by denom, removes 0 elements, and consolidates duplicate denoms.
+// It returns an error and leaves the wallet unchanged if consolidating overflows
+func (n *NativeBalance) Normalize() error {
+	coins, err := normalizeCoins(n.Coins)
+	if err != nil {
+		return err
+	}
+	n.Coins = coins
+	return nil
 }
 
-// removeZeroAmounts removes coins with amount 0 from the slice.
-func removeZeroAmounts(coins []types.Coin) []types.Coin {
-	var result []types.Coin
+// normalizeCoins returns a normalized copy of the coins, or an error if merging duplicates overflows
+func normalizeCoins(coins []types.Coin) ([]types.Coin, error) {
+	sorted := make([]types.Coin, 0, len(coins))
 	for _, c := range coins {
-		if !reflect.ValueOf(c.Amount).IsZero() {
-			result = append(result, c)
+		if !c.Amount.IsZero() {
+			sorted = append(sorted, c)
 		}
 	}
-	return result
-}
+	if len(sorted) == 0 {
+		return nil, nil
+	}
 
-// sortCoinsByDenom sorts coins by denom in the slice.
-func sortCoinsByDenom(coins []types.Coin) {
-	sort.Slice(coins, func(i, j int) bool {
-		return coins[i].Denom < coins[j].Denom
+	slices.SortStableFunc(sorted, func(a, b types.Coin) int {
+		return strings.Compare(a.Denom, b.Denom)
 	})
-}
 
-// consolidateDuplicates consolidates coins with the same denom in the slice.
-func consolidateDuplicates(coins []types.Coin) {
-	var consolidated []types.Coin
-	for i, c := range coins {
-		if i > 0 && c.Denom == coins[i-1].Denom {
-			this := consolidated[len(consolidated)-1]
-			this.Amount.Add(c.Amount)
-		} else {
-			consolidated = append(consolidated, c)
+	merged := sorted[:1]
+	for _, c := range sorted[1:] {
+		last := &merged[len(merged)-1]
+		if c.Denom != last.Denom {
+			merged = append(merged, c)
+			continue
+		}
+
+		sum, err := last.Amount.SafeAdd(c.Amount)
+		if err != nil {
+			return nil, errBalanceOverflow
 		}
+		last.Amount = sum
 	}
-	copy(coins, consolidated)
-	coins = coins[:len(consolidated)]
+	return merged, nil
+}
+
+// normalized returns a normalized copy of nb
+func (nb NativeBalance) normalized() (NativeBalance, error) {
+	coins, err := normalizeCoins(nb.Coins)
+	return NativeBalance{Coins: coins}, err
 }
 
 // Find returns the index and coin with the given denom, or nil if not found.
@@ -83,96 +103,280 @@ func (nb NativeBalance) InsertPos(denom string) int {
 	return -1
 }
 
-// AddAssign adds the given coin to NativeBalance.
-func (nb NativeBalance) AddAssign(other types.Coin) NativeBalance {
-	idx, c := nb.Find(other.Denom)
-	if c != nil {
-		nb.Coins[idx].Amount = c.Amount.Add(other.Amount)
-		return nb
-	} else {
-		pos := nb.InsertPos(other.Denom)
-		if pos != -1 {
-			nb.Coins = append(nb.Coins[:pos], append([]types.Coin{other}, nb.Coins[pos:]...)...)
-		} else {
-			nb.Coins = append(nb.Coins, other)
+// IsEmpty checks if the balance holds no coin with a positive amount
+func (nb NativeBalance) IsEmpty() bool {
+	for _, c := range nb.Coins {
+		if !c.Amount.IsZero() {
+			return false
 		}
 	}
-	return nb
+	return true
 }
 
-// SubSaturating is similar to Balance.Sub, but doesn't fail when minuend is less than subtrahend.
-func (nb NativeBalance) SubSaturating(other types.Coin) (NativeBalance, error) {
-	idx, c := nb.Find(other.Denom)
-	if c != nil {
-		if c.Amount.LTE(other.Amount) {
-			nb.Coins = append(nb.Coins[:idx], nb.Coins[idx+1:]...)
-		} else {
-			nb.Coins[idx].Amount = c.Amount.Sub(other.Amount)
+// Equal checks if both balances hold the same amount of every denom, however they're ordered
+func (nb NativeBalance) Equal(other NativeBalance) bool {
+	a, err := nb.normalized()
+	if err != nil {
+		return false
+	}
+	b, err := other.normalized()
+	if err != nil || len(a.Coins) != len(b.Coins) {
+		return false
+	}
+
+	for i := range a.Coins {
+		if a.Coins[i].Denom != b.Coins[i].Denom || !a.Coins[i].Amount.Equals(b.Coins[i].Amount) {
+			return false
 		}
-	} else {
-		return NativeBalance{}, errors.New("overflow error")
 	}
-	return nb, nil
+	return true
 }
 
-// Sub subtracts the given coin from NativeBalance.
-func (nb NativeBalance) Sub(other types.Coin) (NativeBalance, error) {
-	idx, c := nb.Find(other.Denom)
-	if c != nil {
-		remainder := c.Amount.Sub(other.Amount)
-		if remainder.IsZero() {
-			nb.Coins = append(nb.Coins[:idx], nb.Coins[idx+1:]...)
-		} else {
-			nb.Coins[idx].Amount = remainder
+// HasAtLeast checks if the balance holds at least the amount of every denom in other
+func (nb NativeBalance) HasAtLeast(other NativeBalance) bool {
+	_, err := nb.SubBalance(other)
+	return err == nil
+}
+
+// AddBalance returns the sum of both balances, or an error if a denom overflows
+func (nb NativeBalance) AddBalance(other NativeBalance) (NativeBalance, error) {
+	coins := make([]types.Coin, 0, len(nb.Coins)+len(other.Coins))
+	coins = append(append(coins, nb.Coins...), other.Coins...)
+	return NativeBalance{Coins: coins}.normalized()
+}
+
+// AddAssign adds the given coin to NativeBalance, see AddBalance
+func (nb NativeBalance) AddAssign(other types.Coin) (NativeBalance, error) {
+	return nb.AddBalance(NativeBalance{Coins: []types.Coin{other}})
+}
+
+// SubBalance subtracts every coin of other, returning an UnderflowError
+// with the first denom the balance doesn't hold enough of
+func (nb NativeBalance) SubBalance(other NativeBalance) (NativeBalance, error) {
+	return nb.sub(other, false)
+}
+
+// SubBalanceSaturating is like SubBalance, but removes the denoms that would go below zero.
+// Denoms the balance doesn't hold at all are still an UnderflowError, as in cw-utils
+func (nb NativeBalance) SubBalanceSaturating(other NativeBalance) (NativeBalance, error) {
+	return nb.sub(other, true)
+}
+
+func (nb NativeBalance) sub(other NativeBalance, saturating bool) (NativeBalance, error) {
+	result, err := nb.normalized()
+	if err != nil {
+		return NativeBalance{}, err
+	}
+	subtrahend, err := other.normalized()
+	if err != nil {
+		return NativeBalance{}, err
+	}
+
+	for _, c := range subtrahend.Coins {
+		idx, held := result.Find(c.Denom)
+		if held == nil {
+			return NativeBalance{}, UnderflowError{Denom: c.Denom}
+		}
+
+		remainder, err := held.Amount.SafeSub(c.Amount)
+		switch {
+		case err != nil && !saturating:
+			return NativeBalance{}, UnderflowError{Denom: c.Denom}
+		case err != nil || remainder.IsZero():
+			result.Coins = append(result.Coins[:idx], result.Coins[idx+1:]...)
+		default:
+			result.Coins[idx].Amount = remainder
 		}
-	} else {
-		return NativeBalance{}, errors.New("overflow error")
 	}
-	return nb, nil
+
+	if len(result.Coins) == 0 {
+		result.Coins = nil
+	}
+	return result, nil
+}
+
+// Sub subtracts the given coin from NativeBalance, see SubBalance
+func (nb NativeBalance) Sub(other types.Coin) (NativeBalance, error) {
+	return nb.SubBalance(NativeBalance{Coins: []types.Coin{other}})
+}
+
+// SubSaturating is similar to Sub, but doesn't fail when minuend is less than subtrahend, see SubBalanceSaturating
+func (nb NativeBalance) SubSaturating(other types.Coin) (NativeBalance, error) {
+	return nb.SubBalanceSaturating(NativeBalance{Coins: []types.Coin{other}})
 }
 
 // EXPIRATION
 
+// Expiration is encoded as the cw-utils enum, see utils_json.go
+//
+//tinyjson:skip
 type Expiration struct {
-	/// AtHeight will expire when `env.block.height` >= height
-	AtHeight uint64
+	/// AtHeight will expire when `env.block.height` >= height, it's nil for the other variants
+	AtHeight *uint64
 	/// AtTime will expire when `env.block.time` >= time
 	AtTime time.Time
 	/// Never will never expire. Used to express the empty variant
 	Never bool
 }
 
+// ExpiresAtHeight returns the at_height variant
+func ExpiresAtHeight(height uint64) Expiration {
+	return Expiration{AtHeight: &height}
+}
+
+// Duration is encoded as the cw-utils enum, see utils_json.go
+//
+//tinyjson:skip
 type Duration struct {
 	Height uint64
 	Time   uint64
 }
 
-// Adds more time to an Expiration
+// the kinds of Expiration, only expirations of the same kind can be compared
+const (
+	expiresNever = iota
+	expiresAtHeight
+	expiresAtTime
+)
+
+var (
+	errMixedExpiration = errors.New("Cannot compare height and time")
+	errMixedDuration   = errors.New("Cannot add height and time")
+	errExpirationRange = errors.New("Expiration out of range")
+)
+
+func (e Expiration) kind() int {
+	switch {
+	case e.AtHeight != nil:
+		return expiresAtHeight
+	case !e.AtTime.IsZero():
+		return expiresAtTime
+	default:
+		return expiresNever
+	}
+}
+
+// Validate checks that at most one of the variants is set, the zero value never expires
+func (e Expiration) Validate() error {
+	set := 0
+	for _, variant := range []bool{e.AtHeight != nil, !e.AtTime.IsZero(), e.Never} {
+		if variant {
+			set++
+		}
+	}
+	if set > 1 {
+		return errExpirationVariant
+	}
+	if !e.AtTime.IsZero() && e.AtTime.Unix() < 0 {
+		return errors.New("Expiration at_time is before the epoch")
+	}
+	return nil
+}
+
+// Cmp returns -1, 0 or 1 as e expires before, with or after other.
+// Never is after every other expiration, heights and times can't be compared
+func (e Expiration) Cmp(other Expiration) (int, error) {
+	kind, otherKind := e.kind(), other.kind()
+	switch {
+	case kind == expiresNever && otherKind == expiresNever:
+		return 0, nil
+	case kind == expiresNever:
+		return 1, nil
+	case otherKind == expiresNever:
+		return -1, nil
+	case kind != otherKind:
+		return 0, errMixedExpiration
+	case kind == expiresAtHeight:
+		return cmpUint64(*e.AtHeight, *other.AtHeight), nil
+	default:
+		return e.AtTime.Compare(other.AtTime), nil
+	}
+}
+
+// Within checks that e expires no later than other, heights and times can't be compared
+func (e Expiration) Within(other Expiration) bool {
+	cmp, err := e.Cmp(other)
+	return err == nil && cmp <= 0
+}
+
+func cmpUint64(a uint64, b uint64) int {
+	switch {
+	case a < b:
+		return -1
+	case a > b:
+		return 1
+	default:
+		return 0
+	}
+}
+
+// Add moves the expiration later by the duration, which must be of the same kind. Never stays never
 func (e Expiration) Add(d Duration) (Expiration, error) {
-	if e.Never {
+	switch e.kind() {
+	case expiresNever:
 		return Expiration{Never: true}, nil
+	case expiresAtHeight:
+		if d.Time != 0 {
+			return Expiration{}, errMixedDuration
+		}
+		if *e.AtHeight+d.Height < *e.AtHeight {
+			return Expiration{}, errExpirationRange
+		}
+		return ExpiresAtHeight(*e.AtHeight + d.Height), nil
+	default:
+		if d.Height != 0 {
+			return Expiration{}, errMixedDuration
+		}
+		nanos, ok := addSeconds(timeToNanos(e.AtTime), d.Time)
+		if !ok {
+			return Expiration{}, errExpirationRange
+		}
+		return Expiration{AtTime: nanosToTime(nanos)}, nil
 	}
+}
 
-	switch {
-	case d.Time != 0 && e.AtTime.IsZero():
-		return Expiration{}, errors.New("Cannot add height and time")
-	case d.Height != 0:
-		return Expiration{AtHeight: e.AtHeight + d.Height}, nil
-	case d.Time != 0:
-		return Expiration{AtTime: e.AtTime.Add(time.Second * time.Duration(d.Time))}, nil
+// Sub moves the expiration earlier by the duration, which must be of the same kind. Never stays never
+func (e Expiration) Sub(d Duration) (Expiration, error) {
+	switch e.kind() {
+	case expiresNever:
+		return Expiration{Never: true}, nil
+	case expiresAtHeight:
+		if d.Time != 0 {
+			return Expiration{}, errMixedDuration
+		}
+		// stops above height and time 0, which are only there to be read as already expired
+		if d.Height >= *e.AtHeight {
+			return Expiration{}, errExpirationRange
+		}
+		return ExpiresAtHeight(*e.AtHeight - d.Height), nil
 	default:
-		return Expiration{}, errors.New("Invalid Duration")
+		if d.Height != 0 {
+			return Expiration{}, errMixedDuration
+		}
+		nanos := timeToNanos(e.AtTime)
+		if d.Time > math.MaxUint64/nanosPerSecond || d.Time*nanosPerSecond >= nanos {
+			return Expiration{}, errExpirationRange
+		}
+		return Expiration{AtTime: nanosToTime(nanos - d.Time*nanosPerSecond)}, nil
+	}
+}
+
+// addSeconds adds seconds to a nanosecond timestamp, reporting whether it fit
+func addSeconds(nanos uint64, seconds uint64) (uint64, bool) {
+	if seconds > math.MaxUint64/nanosPerSecond {
+		return 0, false
 	}
+	sum := nanos + seconds*nanosPerSecond
+	return sum, sum >= nanos
 }
 
-// Checks if the expiration is expired
+// IsExpired checks if the block reached the expiration, which happens at the expiration height or time itself
 func (e Expiration) IsExpired(block types.BlockInfo) bool {
-	switch {
-	case e.AtHeight != 0:
-		return block.Height >= e.AtHeight
-	case !e.AtTime.IsZero():
-		blockTime := time.Unix(0, int64(block.Time))
-		return blockTime.After(e.AtTime)
+	switch e.kind() {
+	case expiresAtHeight:
+		return block.Height >= *e.AtHeight
+	case expiresAtTime:
+		return block.Time >= timeToNanos(e.AtTime)
 	default:
 		return false
 	}
@@ -180,17 +384,23 @@ func (e Expiration) IsExpired(block types.BlockInfo) bool {
 
 // DURATION //
 
-// Create an expiration after current block
-func (d Duration) After(block types.BlockInfo) Expiration {
+// After returns the expiration the duration after the block, an empty duration never expires.
+// Durations past the last height or cosmwasm timestamp are out of range
+func (d Duration) After(block types.BlockInfo) (Expiration, error) {
 	switch {
 	case d.Height != 0:
-		return Expiration{AtHeight: block.Height + d.Height}
+		if block.Height+d.Height < block.Height {
+			return Expiration{}, errExpirationRange
+		}
+		return ExpiresAtHeight(block.Height + d.Height), nil
 	case d.Time != 0:
-		duration := time.Second * time.Duration(d.Time)
-		blockTime := time.Unix(0, int64(block.Time))
-		return Expiration{AtTime: blockTime.Add(duration)}
+		nanos, ok := addSeconds(block.Time, d.Time)
+		if !ok {
+			return Expiration{}, errExpirationRange
+		}
+		return Expiration{AtTime: nanosToTime(nanos)}, nil
 	default:
-		return Expiration{}
+		return Expiration{}, nil
 	}
 }
 
@@ -229,8 +439,3 @@ func (d Duration) Multiply(v uint64) Duration {
 		return Duration{}
 	}
 }
-
-// Compares two Expirations
-// func (e Expiration) PartialCmp (other Expiration) int {
-// 	!todo do we even need this?
-// }
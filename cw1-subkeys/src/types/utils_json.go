@@ -0,0 +1,180 @@
+package types
+
+import (
+	"errors"
+	"strconv"
+	"time"
+
+	"github.com/CosmWasm/tinyjson/jlexer"
+	"github.com/CosmWasm/tinyjson/jwriter"
+)
+
+// Expiration and Duration are enums in cw-utils, so they're written by hand rather than generated:
+//
+//	{"at_height":12345}  {"at_time":"1571797419879305533"}  {"never":{}}
+//	{"height":100}       {"time":3600}
+//
+// at_time is in nanoseconds, encoded as a string, and Duration time in seconds.
+// Exactly one variant must be set, anything else is rejected rather than guessed at.
+// at_height 0 is a height like any other and at_time 0 is the epoch, as the zero Expiration means never.
+// Both are already expired, which the message handlers reject
+
+var (
+	errExpirationVariant = errors.New("Expiration must be exactly one of at_height, at_time or never")
+	errDurationVariant   = errors.New("Duration must be exactly one of height or time")
+)
+
+const nanosPerSecond = uint64(time.Second)
+
+// timeToNanos converts to the nanoseconds since the epoch of a cosmwasm Timestamp
+func timeToNanos(t time.Time) uint64 {
+	return uint64(t.Unix())*nanosPerSecond + uint64(t.Nanosecond())
+}
+
+func nanosToTime(nanos uint64) time.Time {
+	return time.Unix(int64(nanos/nanosPerSecond), int64(nanos%nanosPerSecond)).UTC()
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v Expiration) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	v.MarshalTinyJSON(&w)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalTinyJSON supports tinyjson.Marshaler interface
+func (v Expiration) MarshalTinyJSON(w *jwriter.Writer) {
+	err := v.Validate()
+	if err != nil {
+		w.Error = err
+		return
+	}
+
+	switch v.kind() {
+	case expiresAtHeight:
+		w.RawString(`{"at_height":`)
+		w.Uint64(*v.AtHeight)
+		w.RawByte('}')
+	case expiresAtTime:
+		w.RawString(`{"at_time":`)
+		w.Uint64Str(timeToNanos(v.AtTime))
+		w.RawByte('}')
+	default:
+		w.RawString(`{"never":{}}`)
+	}
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *Expiration) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	v.UnmarshalTinyJSON(&r)
+	r.Consumed()
+	return r.Error()
+}
+
+// UnmarshalTinyJSON supports tinyjson.Unmarshaler interface
+func (v *Expiration) UnmarshalTinyJSON(in *jlexer.Lexer) {
+	if in.IsNull() {
+		in.Skip()
+		return
+	}
+
+	*v = Expiration{}
+	variants := 0
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		variants++
+
+		switch key {
+		case "at_height":
+			height := in.Uint64()
+			v.AtHeight = &height
+		case "at_time":
+			nanos, err := strconv.ParseUint(in.String(), 10, 64)
+			if in.Ok() && err != nil {
+				in.AddError(errors.New("Expiration at_time must be a nanosecond timestamp string"))
+			}
+			v.AtTime = nanosToTime(nanos)
+		case "never":
+			in.Delim('{')
+			if !in.IsDelim('}') {
+				in.AddError(errors.New("Expiration never must be an empty object"))
+			}
+			in.Delim('}')
+			v.Never = true
+		default:
+			in.AddError(errors.New("unknown Expiration variant " + strconv.Quote(key)))
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+
+	if in.Ok() && variants != 1 {
+		in.AddError(errExpirationVariant)
+	}
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v Duration) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	v.MarshalTinyJSON(&w)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalTinyJSON supports tinyjson.Marshaler interface
+func (v Duration) MarshalTinyJSON(w *jwriter.Writer) {
+	switch {
+	case v.Height != 0 && v.Time != 0:
+		w.Error = errDurationVariant
+	case v.Time != 0:
+		w.RawString(`{"time":`)
+		w.Uint64(v.Time)
+		w.RawByte('}')
+	default:
+		w.RawString(`{"height":`)
+		w.Uint64(v.Height)
+		w.RawByte('}')
+	}
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *Duration) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	v.UnmarshalTinyJSON(&r)
+	r.Consumed()
+	return r.Error()
+}
+
+// UnmarshalTinyJSON supports tinyjson.Unmarshaler interface
+func (v *Duration) UnmarshalTinyJSON(in *jlexer.Lexer) {
+	if in.IsNull() {
+		in.Skip()
+		return
+	}
+
+	*v = Duration{}
+	variants := 0
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		variants++
+
+		switch key {
+		case "height":
+			v.Height = in.Uint64()
+		case "time":
+			v.Time = in.Uint64()
+		default:
+			in.AddError(errors.New("unknown Duration variant " + strconv.Quote(key)))
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+
+	if in.Ok() && variants != 1 {
+		in.AddError(errDurationVariant)
+	}
+}
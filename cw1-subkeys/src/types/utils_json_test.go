@@ -0,0 +1,112 @@
+package types
+
+import (
+	"testing"
+	"time"
+
+	"github.com/CosmWasm/cosmwasm-go/std/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// fixtures as serialized by cw-utils
+func TestExpirationJSON(t *testing.T) {
+	for _, fixture := range []struct {
+		json       string
+		expiration Expiration
+	}{
+		{`{"at_height":12345}`, ExpiresAtHeight(12345)},
+		{`{"at_time":"1571797419879305533"}`, Expiration{AtTime: time.Unix(1571797419, 879305533).UTC()}},
+		{`{"at_time":"18446744073709551615"}`, Expiration{AtTime: time.Unix(18446744073, 709551615).UTC()}},
+		{`{"never":{}}`, Expiration{Never: true}},
+		// already expired, for the message handlers to reject
+		{`{"at_height":0}`, ExpiresAtHeight(0)},
+		{`{"at_time":"0"}`, Expiration{AtTime: time.Unix(0, 0).UTC()}},
+	} {
+		var expiration Expiration
+		require.NoError(t, expiration.UnmarshalJSON([]byte(fixture.json)), fixture.json)
+		assert.Equal(t, fixture.expiration, expiration)
+
+		bz, err := expiration.MarshalJSON()
+		require.NoError(t, err)
+		assert.Equal(t, fixture.json, string(bz))
+	}
+
+	for _, input := range []string{`{"at_height":0}`, `{"at_time":"0"}`} {
+		var expiration Expiration
+		require.NoError(t, expiration.UnmarshalJSON([]byte(input)))
+		assert.True(t, expiration.IsExpired(types.BlockInfo{}), input)
+	}
+
+	// the zero value never expires either
+	bz, err := Expiration{}.MarshalJSON()
+	require.NoError(t, err)
+	assert.Equal(t, `{"never":{}}`, string(bz))
+
+	height := uint64(10)
+	_, err = Expiration{AtHeight: &height, Never: true}.MarshalJSON()
+	assert.EqualError(t, err, "Expiration must be exactly one of at_height, at_time or never")
+	_, err = Expiration{AtTime: time.Unix(-1, 0)}.MarshalJSON()
+	assert.Error(t, err)
+}
+
+func TestExpirationJSONRejects(t *testing.T) {
+	for _, input := range []string{
+		`{}`,
+		`{"at_height":10,"never":{}}`,
+		`{"at_height":10,"at_height":11}`,
+		`{"at_height":"10"}`,
+		`{"at_height":-1}`,
+		`{"at_time":1571797419879305533}`,
+		`{"at_time":"2019-10-23T02:23:39Z"}`,
+		`{"never":true}`,
+		`{"never":{"at_height":10}}`,
+		`{"expires_at":10}`,
+		`{"at_height":10}x`,
+		`"never"`,
+		// the encoding before the cw-utils one
+		`{"at_height":10,"at_time":"0001-01-01T00:00:00Z","never":false}`,
+	} {
+		var expiration Expiration
+		assert.Error(t, expiration.UnmarshalJSON([]byte(input)), input)
+	}
+}
+
+func TestDurationJSON(t *testing.T) {
+	for _, fixture := range []struct {
+		json     string
+		duration Duration
+	}{
+		{`{"height":100}`, Duration{Height: 100}},
+		{`{"time":3600}`, Duration{Time: 3600}},
+	} {
+		var duration Duration
+		require.NoError(t, duration.UnmarshalJSON([]byte(fixture.json)), fixture.json)
+		assert.Equal(t, fixture.duration, duration)
+
+		bz, err := duration.MarshalJSON()
+		require.NoError(t, err)
+		assert.Equal(t, fixture.json, string(bz))
+	}
+
+	_, err := Duration{Height: 1, Time: 1}.MarshalJSON()
+	assert.EqualError(t, err, "Duration must be exactly one of height or time")
+
+	for _, input := range []string{`{}`, `{"height":1,"time":1}`, `{"time":"3600"}`, `{"seconds":1}`} {
+		var duration Duration
+		assert.Error(t, duration.UnmarshalJSON([]byte(input)), input)
+	}
+}
+
+func TestExpirationInMessages(t *testing.T) {
+	var perm Permissions
+	require.NoError(t, perm.UnmarshalJSON([]byte(`{"delegate":true,"expires":{"at_time":"1571797419879305533"}}`)))
+	assert.Equal(t, time.Unix(1571797419, 879305533).UTC(), perm.Expires.AtTime)
+
+	// an omitted expiration never expires
+	perm = Permissions{}
+	require.NoError(t, perm.UnmarshalJSON([]byte(`{"withdraw":true}`)))
+	assert.Equal(t, Expiration{}, perm.Expires)
+
+	assert.Error(t, perm.UnmarshalJSON([]byte(`{"expires":{"at_height":1,"never":{}}}`)))
+}
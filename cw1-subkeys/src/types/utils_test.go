@@ -0,0 +1,351 @@
+package types
+
+import (
+	"math/rand"
+	"testing"
+	"time"
+
+	"github.com/CosmWasm/cosmwasm-go/std/math"
+	"github.com/CosmWasm/cosmwasm-go/std/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func atSeconds(seconds int64) Expiration {
+	return Expiration{AtTime: time.Unix(seconds, 0).UTC()}
+}
+
+func TestExpirationIsExpired(t *testing.T) {
+	block := types.BlockInfo{Height: 100, Time: 1000 * uint64(time.Second)}
+
+	for _, tc := range []struct {
+		expiration Expiration
+		expired    bool
+	}{
+		{ExpiresAtHeight(99), true},
+		// expired at the height itself, as in cw-utils
+		{ExpiresAtHeight(100), true},
+		{ExpiresAtHeight(101), false},
+		{atSeconds(999), true},
+		{atSeconds(1000), true},
+		{Expiration{AtTime: time.Unix(1000, 1)}, false},
+		{Expiration{Never: true}, false},
+		{Expiration{}, false},
+	} {
+		assert.Equal(t, tc.expired, tc.expiration.IsExpired(block), "%+v", tc.expiration)
+	}
+}
+
+func TestExpirationCmp(t *testing.T) {
+	never := Expiration{Never: true}
+
+	for _, tc := range []struct {
+		a, b Expiration
+		cmp  int
+	}{
+		{ExpiresAtHeight(5), ExpiresAtHeight(6), -1},
+		{ExpiresAtHeight(6), ExpiresAtHeight(6), 0},
+		{ExpiresAtHeight(7), ExpiresAtHeight(6), 1},
+		{atSeconds(5), atSeconds(6), -1},
+		{atSeconds(6), Expiration{AtTime: time.Unix(6, 0)}, 0},
+		{atSeconds(7), atSeconds(6), 1},
+		{never, Expiration{}, 0},
+		{never, ExpiresAtHeight(1 << 60), 1},
+		{atSeconds(1 << 40), never, -1},
+	} {
+		cmp, err := tc.a.Cmp(tc.b)
+		require.NoError(t, err)
+		assert.Equal(t, tc.cmp, cmp, "%+v %+v", tc.a, tc.b)
+	}
+
+	_, err := ExpiresAtHeight(5).Cmp(atSeconds(5))
+	assert.EqualError(t, err, "Cannot compare height and time")
+	_, err = atSeconds(5).Cmp(ExpiresAtHeight(5))
+	assert.Error(t, err)
+}
+
+func TestExpirationAddSub(t *testing.T) {
+	for _, tc := range []struct {
+		expiration Expiration
+		duration   Duration
+		added      Expiration
+		subtracted Expiration
+	}{
+		{ExpiresAtHeight(10), Duration{Height: 5}, ExpiresAtHeight(15), ExpiresAtHeight(5)},
+		{atSeconds(100), Duration{Time: 30}, atSeconds(130), atSeconds(70)},
+		{Expiration{Never: true}, Duration{Height: 5}, Expiration{Never: true}, Expiration{Never: true}},
+		{Expiration{}, Duration{Time: 5}, Expiration{Never: true}, Expiration{Never: true}},
+	} {
+		added, err := tc.expiration.Add(tc.duration)
+		require.NoError(t, err)
+		assert.Equal(t, tc.added, added)
+
+		subtracted, err := tc.expiration.Sub(tc.duration)
+		require.NoError(t, err)
+		assert.Equal(t, tc.subtracted, subtracted)
+	}
+
+	for _, tc := range []struct {
+		expiration Expiration
+		duration   Duration
+	}{
+		{ExpiresAtHeight(10), Duration{Time: 5}},
+		{atSeconds(100), Duration{Height: 5}},
+	} {
+		_, err := tc.expiration.Add(tc.duration)
+		assert.EqualError(t, err, "Cannot add height and time")
+		_, err = tc.expiration.Sub(tc.duration)
+		assert.EqualError(t, err, "Cannot add height and time")
+	}
+
+	_, err := ExpiresAtHeight(^uint64(0)).Add(Duration{Height: 1})
+	assert.EqualError(t, err, "Expiration out of range")
+	_, err = atSeconds(100).Add(Duration{Time: ^uint64(0)})
+	assert.Error(t, err)
+	// height and time 0 would read as never
+	_, err = ExpiresAtHeight(10).Sub(Duration{Height: 10})
+	assert.EqualError(t, err, "Expiration out of range")
+	_, err = atSeconds(100).Sub(Duration{Time: 100})
+	assert.Error(t, err)
+}
+
+func TestDurationAfter(t *testing.T) {
+	block := types.BlockInfo{Height: 100, Time: 1000*uint64(time.Second) + 5}
+
+	after := func(d Duration, block types.BlockInfo) Expiration {
+		expiration, err := d.After(block)
+		require.NoError(t, err)
+		return expiration
+	}
+	assert.Equal(t, ExpiresAtHeight(110), after(Duration{Height: 10}, block))
+	assert.Equal(t, Expiration{AtTime: time.Unix(1060, 5).UTC()}, after(Duration{Time: 60}, block))
+	assert.Equal(t, Expiration{}, after(Duration{}, block))
+
+	// expires exactly at the end of the duration
+	expiration := after(Duration{Height: 10}, block)
+	assert.False(t, expiration.IsExpired(types.BlockInfo{Height: 109}))
+	assert.True(t, expiration.IsExpired(types.BlockInfo{Height: 110}))
+
+	expiration = after(Duration{Time: 60}, block)
+	assert.False(t, expiration.IsExpired(types.BlockInfo{Time: block.Time + 60*uint64(time.Second) - 1}))
+	assert.True(t, expiration.IsExpired(types.BlockInfo{Time: block.Time + 60*uint64(time.Second)}))
+
+	// past the last height or timestamp
+	_, err := Duration{Height: ^uint64(0) - 99}.After(block)
+	assert.EqualError(t, err, "Expiration out of range")
+	assert.Equal(t, ExpiresAtHeight(^uint64(0)), after(Duration{Height: ^uint64(0) - 100}, block))
+	_, err = Duration{Time: ^uint64(0) / uint64(time.Second)}.After(block)
+	assert.EqualError(t, err, "Expiration out of range")
+}
+
+func TestExpirationValidate(t *testing.T) {
+	assert.NoError(t, Expiration{}.Validate())
+	assert.NoError(t, ExpiresAtHeight(1).Validate())
+	height := uint64(1)
+	assert.Error(t, Expiration{AtHeight: &height, Never: true}.Validate())
+	assert.Error(t, Expiration{AtHeight: &height, AtTime: time.Unix(1, 0)}.Validate())
+	assert.Error(t, Expiration{AtTime: time.Unix(-1, 0)}.Validate())
+}
+
+func balance(coins ...any) NativeBalance {
+	var nb NativeBalance
+	for i := 0; i < len(coins); i += 2 {
+		nb.Coins = append(nb.Coins, types.NewCoin(math.NewUint128FromUint64(uint64(coins[i+1].(int))), coins[i].(string)))
+	}
+	return nb
+}
+
+func TestNativeBalanceNormalize(t *testing.T) {
+	nb := balance("uatom", 5, "ujkl", 0, "uatom", 7, "abc", 1)
+	original := append([]types.Coin{}, nb.Coins...)
+
+	normalized, err := nb.normalized()
+	require.NoError(t, err)
+	assert.Equal(t, balance("abc", 1, "uatom", 12).Coins, normalized.Coins)
+	assert.Equal(t, original, nb.Coins)
+
+	require.NoError(t, nb.Normalize())
+	assert.Equal(t, balance("abc", 1, "uatom", 12).Coins, nb.Coins)
+
+	nb = balance("ujkl", 0)
+	require.NoError(t, nb.Normalize())
+	assert.Nil(t, nb.Coins)
+
+	// two coins of a denom that overflow together
+	half := types.NewCoin(math.NewUint128(0, 1<<63), "ujkl")
+	nb = NativeBalance{Coins: []types.Coin{half, types.NewCoin(math.NewUint128FromUint64(1), "uatom"), half}}
+	assert.EqualError(t, nb.Normalize(), "overflow error")
+	assert.Equal(t, []types.Coin{half, types.NewCoin(math.NewUint128FromUint64(1), "uatom"), half}, nb.Coins)
+
+	info := AllowanceInfo{Spender: "dave", Balance: nb}
+	_, err = info.Canonical()
+	assert.EqualError(t, err, "overflow error")
+	_, err = AllAllowancesResponse{Allowances: []AllowanceInfo{info}}.Canonical()
+	assert.EqualError(t, err, "overflow error")
+}
+
+func TestNativeBalanceArithmetic(t *testing.T) {
+	for _, tc := range []struct {
+		name        string
+		a, b        NativeBalance
+		sum         NativeBalance
+		difference  NativeBalance
+		saturated   NativeBalance
+		underflowed string
+	}{
+		{
+			name:       "subset",
+			a:          balance("uatom", 10, "ujkl", 20),
+			b:          balance("ujkl", 5),
+			sum:        balance("uatom", 10, "ujkl", 25),
+			difference: balance("uatom", 10, "ujkl", 15),
+			saturated:  balance("uatom", 10, "ujkl", 15),
+		},
+		{
+			name:        "too little of a denom",
+			a:           balance("uatom", 10, "ujkl", 20),
+			b:           balance("ujkl", 25, "uatom", 5),
+			sum:         balance("uatom", 15, "ujkl", 45),
+			saturated:   balance("uatom", 5),
+			underflowed: "ujkl",
+		},
+		{
+			name:       "everything",
+			a:          balance("ujkl", 20),
+			b:          balance("ujkl", 20),
+			sum:        balance("ujkl", 40),
+			difference: NativeBalance{},
+			saturated:  NativeBalance{},
+		},
+		{
+			name:        "missing denom",
+			a:           balance("ujkl", 20),
+			b:           balance("uatom", 1),
+			sum:         balance("uatom", 1, "ujkl", 20),
+			underflowed: "uatom",
+		},
+		{
+			name:       "duplicates and zeros",
+			a:          balance("ujkl", 10, "ujkl", 10, "uatom", 0),
+			b:          balance("ujkl", 5, "ujkl", 5, "uatom", 0),
+			sum:        balance("ujkl", 30),
+			difference: balance("ujkl", 10),
+			saturated:  balance("ujkl", 10),
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			sum, err := tc.a.AddBalance(tc.b)
+			require.NoError(t, err)
+			assert.Equal(t, tc.sum, sum)
+
+			difference, err := tc.a.SubBalance(tc.b)
+			if tc.underflowed != "" {
+				assert.Equal(t, UnderflowError{Denom: tc.underflowed}, err)
+				assert.False(t, tc.a.HasAtLeast(tc.b))
+			} else {
+				require.NoError(t, err)
+				assert.Equal(t, tc.difference, difference)
+				assert.True(t, tc.a.HasAtLeast(tc.b))
+			}
+
+			saturated, err := tc.a.SubBalanceSaturating(tc.b)
+			if tc.name == "missing denom" {
+				assert.EqualError(t, err, "Insufficient uatom balance")
+			} else {
+				require.NoError(t, err)
+				assert.Equal(t, tc.saturated, saturated)
+			}
+		})
+	}
+
+	max := NativeBalance{Coins: []types.Coin{types.NewCoin(math.MaxUint128(), "ujkl")}}
+	_, err := max.AddBalance(balance("ujkl", 1))
+	assert.EqualError(t, err, "overflow error")
+}
+
+func TestNativeBalanceCoin(t *testing.T) {
+	nb := balance("ujkl", 10)
+
+	added, err := nb.AddAssign(types.NewCoin(math.NewUint128FromUint64(5), "uatom"))
+	require.NoError(t, err)
+	assert.Equal(t, balance("uatom", 5, "ujkl", 10), added)
+	assert.Equal(t, balance("ujkl", 10), nb)
+
+	half := types.NewCoin(math.NewUint128(0, 1<<63), "ujkl")
+	_, err = NativeBalance{Coins: []types.Coin{half}}.AddAssign(half)
+	assert.EqualError(t, err, "overflow error")
+
+	_, err = nb.Sub(types.NewCoin(math.NewUint128FromUint64(11), "ujkl"))
+	assert.Equal(t, UnderflowError{Denom: "ujkl"}, err)
+
+	saturated, err := nb.SubSaturating(types.NewCoin(math.NewUint128FromUint64(11), "ujkl"))
+	require.NoError(t, err)
+	assert.True(t, saturated.IsEmpty())
+
+	// the receiver is never modified
+	assert.Equal(t, balance("ujkl", 10), nb)
+}
+
+func TestNativeBalanceEqual(t *testing.T) {
+	assert.True(t, balance("a", 1, "b", 2).Equal(balance("b", 2, "a", 1, "c", 0)))
+	assert.True(t, balance("a", 1, "a", 1).Equal(balance("a", 2)))
+	assert.False(t, balance("a", 1).Equal(balance("a", 2)))
+	assert.False(t, balance("a", 1).Equal(balance("b", 1)))
+	assert.True(t, NativeBalance{}.Equal(balance("a", 0)))
+
+	assert.True(t, NativeBalance{}.IsEmpty())
+	assert.True(t, balance("a", 0).IsEmpty())
+	assert.False(t, balance("a", 0, "b", 1).IsEmpty())
+}
+
+func randomBalance(r *rand.Rand) NativeBalance {
+	var nb NativeBalance
+	for i := r.Intn(5); i > 0; i-- {
+		denom := string(rune('a' + r.Intn(4)))
+		amount := math.NewUint128(r.Uint64(), uint64(r.Intn(3)))
+		nb.Coins = append(nb.Coins, types.NewCoin(amount, denom))
+	}
+	return nb
+}
+
+func TestNativeBalanceProperties(t *testing.T) {
+	r := rand.New(rand.NewSource(1))
+
+	for i := 0; i < 1000; i++ {
+		a, b := randomBalance(r), randomBalance(r)
+
+		sum, err := a.AddBalance(b)
+		require.NoError(t, err)
+
+		// addition commutes
+		other, err := b.AddBalance(a)
+		require.NoError(t, err)
+		assert.True(t, sum.Equal(other))
+
+		// subtraction undoes addition
+		assert.True(t, sum.HasAtLeast(a))
+		assert.True(t, sum.HasAtLeast(b))
+		difference, err := sum.SubBalance(b)
+		require.NoError(t, err)
+		assert.True(t, difference.Equal(a), "%v + %v - %v", a, b, b)
+
+		// saturating never leaves more than the checked subtraction would take
+		saturated, err := a.SubBalanceSaturating(b)
+		if err == nil {
+			assert.True(t, a.HasAtLeast(saturated))
+			if a.HasAtLeast(b) {
+				checked, err := a.SubBalance(b)
+				require.NoError(t, err)
+				assert.True(t, checked.Equal(saturated))
+			}
+		} else {
+			assert.IsType(t, UnderflowError{}, err)
+		}
+
+		// results are normalized
+		normalized, err := sum.normalized()
+		require.NoError(t, err)
+		assert.Equal(t, normalized, sum)
+		assert.Equal(t, sum.IsEmpty(), a.IsEmpty() && b.IsEmpty())
+	}
+}
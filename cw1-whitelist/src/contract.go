@@ -25,10 +25,30 @@ func Instantiate(deps *std.Deps, env types.Env, info types.MessageInfo, data []b
 		Mutable: initMsg.Mutable,
 	}
 
-	err = SaveState(deps.Storage, &state)
+	err = SaveState(deps.Storage, &state, env.Block.Height)
 	if err != nil {
 		return nil, err
 	}
+
+	if initMsg.Guardians != nil {
+		err = setGuardians(deps, initMsg.Guardians)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if initMsg.Inheritance != nil {
+		err = setInheritance(deps, initMsg.Inheritance)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	err = SaveLastActivity(deps.Storage, env.Block)
+	if err != nil {
+		return nil, err
+	}
+
 	res := &types.Response{
 		Attributes: []types.EventAttribute{
 			{Key: "success", Value: "true"},
@@ -56,6 +76,24 @@ func Execute(deps *std.Deps, env types.Env, info types.MessageInfo, data []byte)
 		return ExecuteFreeze(deps, &env, &info, msg.FreezeRequest)
 	case msg.UpdateAdminsRequest != nil:
 		return ExecuteUpdateAdmins(deps, &env, &info, msg.UpdateAdminsRequest)
+	case msg.UpdateGuardiansRequest != nil:
+		return ExecuteUpdateGuardians(deps, &env, &info, msg.UpdateGuardiansRequest)
+	case msg.ProposeRecoveryRequest != nil:
+		return ExecuteProposeRecovery(deps, &env, &info, msg.ProposeRecoveryRequest)
+	case msg.ApproveRecoveryRequest != nil:
+		return ExecuteApproveRecovery(deps, &env, &info, msg.ApproveRecoveryRequest)
+	case msg.VetoRecoveryRequest != nil:
+		return ExecuteVetoRecovery(deps, &env, &info, msg.VetoRecoveryRequest)
+	case msg.ExecuteRecoveryRequest != nil:
+		return ExecuteExecuteRecovery(deps, &env, &info, msg.ExecuteRecoveryRequest)
+	case msg.HeartbeatRequest != nil:
+		return ExecuteHeartbeat(deps, &env, &info, msg.HeartbeatRequest)
+	case msg.SetInheritanceRequest != nil:
+		return ExecuteSetInheritance(deps, &env, &info, msg.SetInheritanceRequest)
+	case msg.ClaimInheritanceRequest != nil:
+		return ExecuteClaimInheritance(deps, &env, &info, msg.ClaimInheritanceRequest)
+	case msg.SweepInheritanceRequest != nil:
+		return ExecuteSweepInheritance(deps, &env, &info, msg.SweepInheritanceRequest)
 	default:
 		return nil, types.GenericError("Unknown ExecuteMsg")
 	}
@@ -75,6 +113,12 @@ func Query(deps *std.Deps, env types.Env, data []byte) ([]byte, error) {
 		res, err = QueryAdminList(deps, &env, msg.QueryAdminListRequest)
 	case msg.QueryCanExecuteRequest != nil:
 		res, err = queryCanExecute(deps, &env, msg.QueryCanExecuteRequest)
+	case msg.QueryGuardiansRequest != nil:
+		res, err = QueryGuardians(deps, &env, msg.QueryGuardiansRequest)
+	case msg.QueryRecoveryRequest != nil:
+		res, err = QueryRecovery(deps, &env, msg.QueryRecoveryRequest)
+	case msg.QueryInheritanceRequest != nil:
+		res, err = QueryInheritance(deps, &env, msg.QueryInheritanceRequest)
 	default:
 		err = types.GenericError("Unknown QueryMsg " + string(data))
 	}
@@ -102,7 +146,10 @@ func ExecuteExecute(deps *std.Deps, env *types.Env, info *types.MessageInfo, msg
 		return nil, errors.New("Unauthorized")
 	}
 
-	_ = env
+	err = SaveLastActivity(deps.Storage, env.Block)
+	if err != nil {
+		return nil, err
+	}
 
 	var messages []types.SubMsg
 
@@ -134,7 +181,12 @@ func ExecuteFreeze(deps *std.Deps, env *types.Env, info *types.MessageInfo, msg
 
 	state.Mutable = false
 
-	err = SaveState(deps.Storage, state)
+	err = SaveState(deps.Storage, state, env.Block.Height)
+	if err != nil {
+		return nil, err
+	}
+
+	err = SaveLastActivity(deps.Storage, env.Block)
 	if err != nil {
 		return nil, err
 	}
@@ -161,7 +213,12 @@ func ExecuteUpdateAdmins(deps *std.Deps, env *types.Env, info *types.MessageInfo
 
 	state.Admins = msg.Admins
 
-	err = SaveState(deps.Storage, state)
+	err = SaveState(deps.Storage, state, env.Block.Height)
+	if err != nil {
+		return nil, err
+	}
+
+	err = SaveLastActivity(deps.Storage, env.Block)
 	if err != nil {
 		return nil, err
 	}
@@ -175,14 +232,17 @@ func ExecuteUpdateAdmins(deps *std.Deps, env *types.Env, info *types.MessageInfo
 }
 
 func QueryAdminList(deps *std.Deps, env *types.Env, msg *contractTypes.QueryAdminListRequest) (*contractTypes.AdminListResponse, error) {
-	state, err := LoadState(deps.Storage)
+	var state *contractTypes.AdminList
+	var err error
+	if msg.Height == 0 {
+		state, err = LoadState(deps.Storage)
+	} else {
+		state, err = LoadStateAt(deps.Storage, msg.Height)
+	}
 	if err != nil {
 		return nil, err
 	}
 
-	_ = env
-	_ = msg
-
 	return &contractTypes.AdminListResponse{
 		Admins:  state.Admins,
 		Mutable: state.Mutable,
@@ -2,6 +2,7 @@ package src
 
 import (
 	"encoding/json"
+	"strconv"
 	"testing"
 
 	contractTypes "github.com/JackalLabs/burrow-contracts/cw1-whitelist/src/types"
@@ -100,6 +101,35 @@ func TestInitAndModify(t *testing.T) {
 	require.EqualError(t, err, "Can't update admin list")
 }
 
+func TestAdminListHistory(t *testing.T) {
+	deps, env := defaultInit(t, FUND)
+	created := env.Block.Height
+
+	env.Block.Height += 10
+	_, err := Execute(deps, env, mock.Info("alice", nil), []byte(`{"update_admins":{"admins":["alice","dave"]}}`))
+	require.NoError(t, err)
+
+	env.Block.Height += 10
+	_, err = Execute(deps, env, mock.Info("dave", nil), []byte(`{"update_admins":{"admins":["dave"]}}`))
+	require.NoError(t, err)
+
+	adminsAt := func(height uint64) []string {
+		data, err := Query(deps, env, []byte(`{"admin_list":{"height":`+strconv.FormatUint(height, 10)+`}}`))
+		require.NoError(t, err)
+		var qres contractTypes.AdminListResponse
+		require.NoError(t, json.Unmarshal(data, &qres))
+		return qres.Admins
+	}
+
+	// heights show the list before that block's changes
+	_, err = Query(deps, env, []byte(`{"admin_list":{"height":`+strconv.FormatUint(created, 10)+`}}`))
+	require.EqualError(t, err, "state not found")
+	assert.Equal(t, []string{"alice", "bob", "charlie"}, adminsAt(created+1))
+	assert.Equal(t, []string{"alice", "bob", "charlie"}, adminsAt(created+10))
+	assert.Equal(t, []string{"alice", "dave"}, adminsAt(created+11))
+	assert.Equal(t, []string{"dave"}, adminsAt(created+21))
+}
+
 func TestQueryCanExecute(t *testing.T) {
 	deps, env := defaultInit(t, FUND)
 
@@ -122,3 +152,262 @@ func TestQueryCanExecute(t *testing.T) {
 	require.NoError(t, err)
 	assert.True(t, qres.CanExecute)
 }
+
+func guardianInit(t *testing.T) (*std.Deps, types.Env) {
+	deps := mock.Deps(FUND)
+	env := mock.Env()
+	info := mock.Info(FUNDER, FUND)
+	initMsg := contractTypes.InitMsg{
+		Admins:  []string{"alice", "bob"},
+		Mutable: true,
+		Guardians: &contractTypes.GuardianConfig{
+			Guardians:      []string{"gina", "gus", "gwen"},
+			Threshold:      2,
+			ApprovalWindow: contractTypes.Duration{Height: 100},
+			VetoPeriod:     contractTypes.Duration{Height: 10},
+		},
+	}
+	_, err := Instantiate(deps, env, info, mustEncode(t, initMsg))
+	require.NoError(t, err)
+	return deps, env
+}
+
+func TestInitInvalidGuardians(t *testing.T) {
+	deps := mock.Deps(FUND)
+	initMsg := contractTypes.InitMsg{
+		Admins: []string{"alice"},
+		Guardians: &contractTypes.GuardianConfig{
+			Guardians:      []string{"gina"},
+			Threshold:      2,
+			ApprovalWindow: contractTypes.Duration{Height: 100},
+			VetoPeriod:     contractTypes.Duration{Height: 10},
+		},
+	}
+	_, err := Instantiate(deps, mock.Env(), mock.Info(FUNDER, nil), mustEncode(t, initMsg))
+	require.EqualError(t, err, "Invalid guardian threshold")
+}
+
+func TestRecoveryAfterFreeze(t *testing.T) {
+	deps, env := guardianInit(t)
+
+	_, err := Execute(deps, env, mock.Info("alice", nil), []byte(`{"freeze":{}}`))
+	require.NoError(t, err)
+
+	// only guardians can propose
+	_, err = Execute(deps, env, mock.Info("alice", nil), []byte(`{"propose_recovery":{"admins":["dave"]}}`))
+	require.EqualError(t, err, "Unauthorized")
+
+	res, err := Execute(deps, env, mock.Info("gina", nil), []byte(`{"propose_recovery":{"admins":["dave"]}}`))
+	require.NoError(t, err)
+	assert.Equal(t, "1", res.Attributes[2].Value)
+
+	// a second proposal can't replace an open one
+	_, err = Execute(deps, env, mock.Info("gus", nil), []byte(`{"propose_recovery":{"admins":["eve"]}}`))
+	require.EqualError(t, err, "Recovery already in progress")
+
+	_, err = Execute(deps, env, mock.Info("gina", nil), []byte(`{"approve_recovery":{"id":1}}`))
+	require.EqualError(t, err, "Guardian already approved")
+
+	_, err = Execute(deps, env, mock.Info("gus", nil), []byte(`{"approve_recovery":{"id":1}}`))
+	require.NoError(t, err)
+
+	// the veto period hasn't passed yet
+	_, err = Execute(deps, env, mock.Info("gus", nil), []byte(`{"execute_recovery":{}}`))
+	require.EqualError(t, err, "Recovery cannot be executed yet")
+
+	env.Block.Height += 10
+	data, err := Query(deps, env, []byte(`{"recovery":{}}`))
+	require.NoError(t, err)
+	var rres contractTypes.RecoveryResponse
+	require.NoError(t, json.Unmarshal(data, &rres))
+	assert.True(t, rres.Executable)
+
+	res, err = Execute(deps, env, mock.Info("anyone", nil), []byte(`{"execute_recovery":{}}`))
+	require.NoError(t, err)
+	assert.Equal(t, "execute_recovery", res.Attributes[0].Value)
+
+	data, err = Query(deps, env, []byte(`{"admin_list":{}}`))
+	require.NoError(t, err)
+	var qres contractTypes.AdminListResponse
+	require.NoError(t, json.Unmarshal(data, &qres))
+	assert.Equal(t, []string{"dave"}, qres.Admins)
+	assert.False(t, qres.Mutable)
+
+	// the executed proposal can't be replayed
+	_, err = Execute(deps, env, mock.Info("gus", nil), []byte(`{"execute_recovery":{}}`))
+	require.EqualError(t, err, "Recovery cannot be executed yet")
+}
+
+func TestRecoveryVetoAndExpiry(t *testing.T) {
+	deps, env := guardianInit(t)
+
+	_, err := Execute(deps, env, mock.Info("gina", nil), []byte(`{"propose_recovery":{"admins":["mallory"]}}`))
+	require.NoError(t, err)
+	_, err = Execute(deps, env, mock.Info("gwen", nil), []byte(`{"approve_recovery":{"id":1}}`))
+	require.NoError(t, err)
+
+	// guardians can't veto, admins can
+	_, err = Execute(deps, env, mock.Info("gus", nil), []byte(`{"veto_recovery":{}}`))
+	require.EqualError(t, err, "Unauthorized")
+	_, err = Execute(deps, env, mock.Info("bob", nil), []byte(`{"veto_recovery":{}}`))
+	require.NoError(t, err)
+
+	env.Block.Height += 10
+	_, err = Execute(deps, env, mock.Info("gina", nil), []byte(`{"execute_recovery":{}}`))
+	require.EqualError(t, err, "Recovery cannot be executed yet")
+
+	// a new proposal gets a new id and lapses without enough approvals
+	res, err := Execute(deps, env, mock.Info("gina", nil), []byte(`{"propose_recovery":{"admins":["mallory"]}}`))
+	require.NoError(t, err)
+	assert.Equal(t, "2", res.Attributes[2].Value)
+
+	env.Block.Height += 100
+	_, err = Execute(deps, env, mock.Info("gus", nil), []byte(`{"approve_recovery":{"id":2}}`))
+	require.EqualError(t, err, "Recovery is not open")
+
+	data, err := Query(deps, env, []byte(`{"admin_list":{}}`))
+	require.NoError(t, err)
+	var qres contractTypes.AdminListResponse
+	require.NoError(t, json.Unmarshal(data, &qres))
+	assert.Equal(t, []string{"alice", "bob"}, qres.Admins)
+}
+
+func TestRecoveryVetoPeriodOverflow(t *testing.T) {
+	deps := mock.Deps(FUND)
+	env := mock.Env()
+	initMsg := contractTypes.InitMsg{
+		Admins:  []string{"alice", "bob"},
+		Mutable: true,
+		Guardians: &contractTypes.GuardianConfig{
+			Guardians:      []string{"gina", "gus", "gwen"},
+			Threshold:      2,
+			ApprovalWindow: contractTypes.Duration{Height: 100},
+			VetoPeriod:     contractTypes.Duration{Height: ^uint64(0)},
+		},
+	}
+	_, err := Instantiate(deps, env, mock.Info(FUNDER, FUND), mustEncode(t, initMsg))
+	require.NoError(t, err)
+
+	_, err = Execute(deps, env, mock.Info("gina", nil), []byte(`{"propose_recovery":{"admins":["mallory"]}}`))
+	require.NoError(t, err)
+	_, err = Execute(deps, env, mock.Info("gus", nil), []byte(`{"approve_recovery":{"id":1}}`))
+	require.NoError(t, err)
+
+	// the end of the veto period doesn't wrap around to a height that already passed
+	env.Block.Height++
+	_, err = Execute(deps, env, mock.Info("gina", nil), []byte(`{"execute_recovery":{}}`))
+	require.EqualError(t, err, "Recovery cannot be executed yet")
+}
+
+func inheritanceInit(t *testing.T) (*std.Deps, types.Env) {
+	deps := mock.Deps(FUND)
+	env := mock.Env()
+	initMsg := contractTypes.InitMsg{
+		Admins:  []string{"alice"},
+		Mutable: true,
+		Inheritance: &contractTypes.InheritanceConfig{
+			Beneficiary: "heir",
+			Inactivity:  contractTypes.Duration{Time: 3600},
+		},
+	}
+	_, err := Instantiate(deps, env, mock.Info(FUNDER, nil), mustEncode(t, initMsg))
+	require.NoError(t, err)
+	return deps, env
+}
+
+func TestInheritanceHeartbeat(t *testing.T) {
+	deps, env := inheritanceInit(t)
+
+	// too early to claim
+	env.Block.Time += 3599 * 1_000_000_000
+	_, err := Execute(deps, env, mock.Info("heir", nil), []byte(`{"claim_inheritance":{}}`))
+	require.EqualError(t, err, "Admins are still active")
+
+	// heartbeat resets the timer
+	_, err = Execute(deps, env, mock.Info("heir", nil), []byte(`{"heartbeat":{}}`))
+	require.EqualError(t, err, "Unauthorized")
+	_, err = Execute(deps, env, mock.Info("alice", nil), []byte(`{"heartbeat":{}}`))
+	require.NoError(t, err)
+
+	env.Block.Time += 3599 * 1_000_000_000
+	data, err := Query(deps, env, []byte(`{"inheritance":{}}`))
+	require.NoError(t, err)
+	var ires contractTypes.InheritanceResponse
+	require.NoError(t, json.Unmarshal(data, &ires))
+	assert.False(t, ires.Claimable)
+	assert.Equal(t, "heir", ires.Inheritance.Beneficiary)
+
+	env.Block.Time += 1_000_000_000
+	data, err = Query(deps, env, []byte(`{"inheritance":{}}`))
+	require.NoError(t, err)
+	require.NoError(t, json.Unmarshal(data, &ires))
+	assert.True(t, ires.Claimable)
+
+	// only the beneficiary can claim
+	_, err = Execute(deps, env, mock.Info("bob", nil), []byte(`{"claim_inheritance":{}}`))
+	require.EqualError(t, err, "Unauthorized")
+
+	res, err := Execute(deps, env, mock.Info("heir", nil), []byte(`{"sweep_inheritance":{}}`))
+	require.NoError(t, err)
+	require.Len(t, res.Messages, 1)
+	assert.Equal(t, "heir", res.Messages[0].Msg.Bank.Send.ToAddress)
+	assert.Equal(t, FUND, res.Messages[0].Msg.Bank.Send.Amount)
+
+	_, err = Execute(deps, env, mock.Info("heir", nil), []byte(`{"claim_inheritance":{}}`))
+	require.NoError(t, err)
+
+	data, err = Query(deps, env, []byte(`{"admin_list":{}}`))
+	require.NoError(t, err)
+	var qres contractTypes.AdminListResponse
+	require.NoError(t, json.Unmarshal(data, &qres))
+	assert.Equal(t, []string{"heir"}, qres.Admins)
+}
+
+func TestInheritanceActivity(t *testing.T) {
+	deps, env := inheritanceInit(t)
+
+	// any admin action counts as activity
+	env.Block.Time += 3000 * 1_000_000_000
+	_, err := Execute(deps, env, mock.Info("alice", nil), []byte(`{"execute":{"msgs":[]}}`))
+	require.NoError(t, err)
+
+	env.Block.Time += 3000 * 1_000_000_000
+	_, err = Execute(deps, env, mock.Info("heir", nil), []byte(`{"claim_inheritance":{}}`))
+	require.EqualError(t, err, "Admins are still active")
+
+	// clearing the beneficiary disables claims
+	_, err = Execute(deps, env, mock.Info("alice", nil), []byte(`{"set_inheritance":{}}`))
+	require.NoError(t, err)
+
+	env.Block.Time += 7200 * 1_000_000_000
+	_, err = Execute(deps, env, mock.Info("heir", nil), []byte(`{"claim_inheritance":{}}`))
+	require.EqualError(t, err, "No inheritance configured")
+}
+
+func TestInheritanceInactivityOverflow(t *testing.T) {
+	deps := mock.Deps(FUND)
+	env := mock.Env()
+	initMsg := contractTypes.InitMsg{
+		Admins:  []string{"alice"},
+		Mutable: true,
+		Inheritance: &contractTypes.InheritanceConfig{
+			Beneficiary: "heir",
+			Inactivity:  contractTypes.Duration{Time: ^uint64(0)},
+		},
+	}
+	_, err := Instantiate(deps, env, mock.Info(FUNDER, nil), mustEncode(t, initMsg))
+	require.NoError(t, err)
+
+	// the end of the inactivity period doesn't wrap around to a time that already passed
+	env.Block.Time += 1_000_000_000
+	_, err = Execute(deps, env, mock.Info("heir", nil), []byte(`{"sweep_inheritance":{}}`))
+	require.EqualError(t, err, "Admins are still active")
+	_, err = Execute(deps, env, mock.Info("heir", nil), []byte(`{"claim_inheritance":{}}`))
+	require.EqualError(t, err, "Admins are still active")
+
+	data, err := Query(deps, env, []byte(`{"inheritance":{}}`))
+	require.NoError(t, err)
+	var ires contractTypes.InheritanceResponse
+	require.NoError(t, json.Unmarshal(data, &ires))
+	assert.False(t, ires.Claimable)
+}
@@ -0,0 +1,193 @@
+package src
+
+import (
+	"errors"
+
+	contractTypes "github.com/JackalLabs/burrow-contracts/cw1-whitelist/src/types"
+
+	"github.com/CosmWasm/cosmwasm-go/std"
+	"github.com/CosmWasm/cosmwasm-go/std/types"
+)
+
+func setInheritance(deps *std.Deps, config *contractTypes.InheritanceConfig) error {
+	err := config.Validate()
+	if err != nil {
+		return err
+	}
+
+	err = deps.Api.ValidateAddress(config.Beneficiary)
+	if err != nil {
+		return err
+	}
+
+	return SaveInheritance(deps.Storage, config)
+}
+
+// loadClaimableInheritance returns the inheritance config if the sender is the beneficiary
+// and the admins have been idle long enough
+func loadClaimableInheritance(deps *std.Deps, env *types.Env, sender string) (*contractTypes.InheritanceConfig, error) {
+	config, err := LoadInheritance(deps.Storage)
+	if err != nil {
+		return nil, errors.New("No inheritance configured")
+	}
+
+	if config.Beneficiary != sender {
+		return nil, errors.New("Unauthorized")
+	}
+
+	lastActivity, err := LoadLastActivity(deps.Storage)
+	if err != nil {
+		return nil, err
+	}
+
+	if !config.IsClaimable(*lastActivity, env.Block) {
+		return nil, errors.New("Admins are still active")
+	}
+
+	return config, nil
+}
+
+func ExecuteHeartbeat(deps *std.Deps, env *types.Env, info *types.MessageInfo, msg *contractTypes.HeartbeatRequest) (*types.Response, error) {
+	sender := info.Sender
+
+	state, err := LoadState(deps.Storage)
+	if err != nil {
+		return nil, err
+	}
+
+	if !state.IsAdmin(sender) {
+		return nil, errors.New("Unauthorized")
+	}
+
+	err = SaveLastActivity(deps.Storage, env.Block)
+	if err != nil {
+		return nil, err
+	}
+
+	res := &types.Response{
+		Attributes: []types.EventAttribute{
+			{Key: "action", Value: "heartbeat"},
+			{Key: "owner", Value: sender},
+		},
+	}
+	return res, nil
+}
+
+func ExecuteSetInheritance(deps *std.Deps, env *types.Env, info *types.MessageInfo, msg *contractTypes.SetInheritanceRequest) (*types.Response, error) {
+	sender := info.Sender
+
+	state, err := LoadState(deps.Storage)
+	if err != nil {
+		return nil, err
+	}
+
+	if !state.CanModify(sender) {
+		return nil, errors.New("Can't update inheritance")
+	}
+
+	if msg.Inheritance == nil {
+		RemoveInheritance(deps.Storage)
+	} else {
+		err = setInheritance(deps, msg.Inheritance)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	err = SaveLastActivity(deps.Storage, env.Block)
+	if err != nil {
+		return nil, err
+	}
+
+	res := &types.Response{
+		Attributes: []types.EventAttribute{
+			{Key: "action", Value: "set_inheritance"},
+			{Key: "owner", Value: sender},
+		},
+	}
+	return res, nil
+}
+
+func ExecuteClaimInheritance(deps *std.Deps, env *types.Env, info *types.MessageInfo, msg *contractTypes.ClaimInheritanceRequest) (*types.Response, error) {
+	config, err := loadClaimableInheritance(deps, env, info.Sender)
+	if err != nil {
+		return nil, err
+	}
+
+	state, err := LoadState(deps.Storage)
+	if err != nil {
+		return nil, err
+	}
+
+	// claiming bypasses the mutable flag on purpose
+	state.Admins = []string{config.Beneficiary}
+
+	err = SaveState(deps.Storage, state, env.Block.Height)
+	if err != nil {
+		return nil, err
+	}
+
+	// the beneficiary is now the admin, so the timer starts over
+	err = SaveLastActivity(deps.Storage, env.Block)
+	if err != nil {
+		return nil, err
+	}
+
+	res := &types.Response{
+		Attributes: []types.EventAttribute{
+			{Key: "action", Value: "claim_inheritance"},
+			{Key: "beneficiary", Value: config.Beneficiary},
+		},
+	}
+	return res, nil
+}
+
+func ExecuteSweepInheritance(deps *std.Deps, env *types.Env, info *types.MessageInfo, msg *contractTypes.SweepInheritanceRequest) (*types.Response, error) {
+	config, err := loadClaimableInheritance(deps, env, info.Sender)
+	if err != nil {
+		return nil, err
+	}
+
+	querier := std.QuerierWrapper{Querier: deps.Querier}
+	balance, err := querier.QueryAllBalances(env.Contract.Address)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(balance) == 0 {
+		return nil, errors.New("Nothing to sweep")
+	}
+
+	sweep := types.SendMsg{
+		ToAddress: config.Beneficiary,
+		Amount:    balance,
+	}
+
+	res := &types.Response{
+		Attributes: []types.EventAttribute{
+			{Key: "action", Value: "sweep_inheritance"},
+			{Key: "beneficiary", Value: config.Beneficiary},
+		},
+		Messages: []types.SubMsg{types.NewSubMsg(sweep)},
+	}
+	return res, nil
+}
+
+func QueryInheritance(deps *std.Deps, env *types.Env, msg *contractTypes.QueryInheritanceRequest) (*contractTypes.InheritanceResponse, error) {
+	lastActivity, err := LoadLastActivity(deps.Storage)
+	if err != nil {
+		return nil, err
+	}
+
+	res := &contractTypes.InheritanceResponse{
+		LastActivity: *lastActivity,
+	}
+
+	config, err := LoadInheritance(deps.Storage)
+	if err == nil {
+		res.Inheritance = config
+		res.Claimable = config.IsClaimable(*lastActivity, env.Block)
+	}
+
+	return res, nil
+}
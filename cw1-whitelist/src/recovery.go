@@ -0,0 +1,297 @@
+package src
+
+import (
+	"errors"
+	"slices"
+	"strconv"
+
+	contractTypes "github.com/JackalLabs/burrow-contracts/cw1-whitelist/src/types"
+
+	"github.com/CosmWasm/cosmwasm-go/std"
+	"github.com/CosmWasm/cosmwasm-go/std/types"
+)
+
+func setGuardians(deps *std.Deps, config *contractTypes.GuardianConfig) error {
+	err := config.Validate()
+	if err != nil {
+		return err
+	}
+
+	for _, guardian := range config.Guardians {
+		err = deps.Api.ValidateAddress(guardian)
+		if err != nil {
+			return err
+		}
+	}
+
+	return SaveGuardians(deps.Storage, config)
+}
+
+func ExecuteUpdateGuardians(deps *std.Deps, env *types.Env, info *types.MessageInfo, msg *contractTypes.UpdateGuardiansRequest) (*types.Response, error) {
+	sender := info.Sender
+
+	state, err := LoadState(deps.Storage)
+	if err != nil {
+		return nil, err
+	}
+
+	if !state.CanModify(sender) {
+		return nil, errors.New("Can't update guardians")
+	}
+
+	err = setGuardians(deps, &msg.Guardians)
+	if err != nil {
+		return nil, err
+	}
+
+	err = SaveLastActivity(deps.Storage, env.Block)
+	if err != nil {
+		return nil, err
+	}
+
+	// approvals from the previous guardian set don't carry over
+	proposal, err := LoadRecovery(deps.Storage)
+	if err == nil && proposal.Status == contractTypes.RecoveryPending {
+		proposal.Status = contractTypes.RecoveryVetoed
+		err = SaveRecovery(deps.Storage, proposal)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	res := &types.Response{
+		Attributes: []types.EventAttribute{
+			{Key: "action", Value: "update_guardians"},
+		},
+	}
+	return res, nil
+}
+
+func ExecuteProposeRecovery(deps *std.Deps, env *types.Env, info *types.MessageInfo, msg *contractTypes.ProposeRecoveryRequest) (*types.Response, error) {
+	sender := info.Sender
+
+	config, err := LoadGuardians(deps.Storage)
+	if err != nil {
+		return nil, errors.New("No guardians configured")
+	}
+
+	if !config.IsGuardian(sender) {
+		return nil, errors.New("Unauthorized")
+	}
+
+	if len(msg.Admins) == 0 {
+		return nil, errors.New("Admin list cannot be empty")
+	}
+
+	for _, admin := range msg.Admins {
+		err = deps.Api.ValidateAddress(admin)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	id := uint64(1)
+	prev, err := LoadRecovery(deps.Storage)
+	if err == nil {
+		if prev.IsOpen(*config, env.Block) {
+			return nil, errors.New("Recovery already in progress")
+		}
+		id = prev.ID + 1
+	}
+
+	proposal := contractTypes.RecoveryProposal{
+		ID:         id,
+		Admins:     msg.Admins,
+		Approvals:  []string{sender},
+		Status:     contractTypes.RecoveryPending,
+		ProposedAt: env.Block,
+	}
+
+	if len(proposal.Approvals) >= int(config.Threshold) {
+		approvedAt := env.Block
+		proposal.ApprovedAt = &approvedAt
+	}
+
+	err = SaveRecovery(deps.Storage, &proposal)
+	if err != nil {
+		return nil, err
+	}
+
+	res := &types.Response{
+		Attributes: []types.EventAttribute{
+			{Key: "action", Value: "propose_recovery"},
+			{Key: "guardian", Value: sender},
+			{Key: "recovery_id", Value: strconv.FormatUint(id, 10)},
+		},
+	}
+	return res, nil
+}
+
+func ExecuteApproveRecovery(deps *std.Deps, env *types.Env, info *types.MessageInfo, msg *contractTypes.ApproveRecoveryRequest) (*types.Response, error) {
+	sender := info.Sender
+
+	config, err := LoadGuardians(deps.Storage)
+	if err != nil {
+		return nil, errors.New("No guardians configured")
+	}
+
+	if !config.IsGuardian(sender) {
+		return nil, errors.New("Unauthorized")
+	}
+
+	proposal, err := LoadRecovery(deps.Storage)
+	if err != nil || proposal.ID != msg.ID {
+		return nil, errors.New("Recovery not found")
+	}
+
+	if !proposal.IsOpen(*config, env.Block) {
+		return nil, errors.New("Recovery is not open")
+	}
+
+	if proposal.ApprovedAt != nil {
+		return nil, errors.New("Recovery already approved")
+	}
+
+	if slices.Contains(proposal.Approvals, sender) {
+		return nil, errors.New("Guardian already approved")
+	}
+
+	proposal.Approvals = append(proposal.Approvals, sender)
+	if len(proposal.Approvals) >= int(config.Threshold) {
+		approvedAt := env.Block
+		proposal.ApprovedAt = &approvedAt
+	}
+
+	err = SaveRecovery(deps.Storage, proposal)
+	if err != nil {
+		return nil, err
+	}
+
+	res := &types.Response{
+		Attributes: []types.EventAttribute{
+			{Key: "action", Value: "approve_recovery"},
+			{Key: "guardian", Value: sender},
+			{Key: "recovery_id", Value: strconv.FormatUint(proposal.ID, 10)},
+			{Key: "approvals", Value: strconv.Itoa(len(proposal.Approvals))},
+		},
+	}
+	return res, nil
+}
+
+func ExecuteVetoRecovery(deps *std.Deps, env *types.Env, info *types.MessageInfo, msg *contractTypes.VetoRecoveryRequest) (*types.Response, error) {
+	sender := info.Sender
+
+	state, err := LoadState(deps.Storage)
+	if err != nil {
+		return nil, err
+	}
+
+	// admins can veto even when the contract is frozen
+	if !state.IsAdmin(sender) {
+		return nil, errors.New("Unauthorized")
+	}
+
+	config, err := LoadGuardians(deps.Storage)
+	if err != nil {
+		return nil, errors.New("No guardians configured")
+	}
+
+	proposal, err := LoadRecovery(deps.Storage)
+	if err != nil || !proposal.IsOpen(*config, env.Block) {
+		return nil, errors.New("No recovery to veto")
+	}
+
+	proposal.Status = contractTypes.RecoveryVetoed
+
+	err = SaveRecovery(deps.Storage, proposal)
+	if err != nil {
+		return nil, err
+	}
+
+	err = SaveLastActivity(deps.Storage, env.Block)
+	if err != nil {
+		return nil, err
+	}
+
+	res := &types.Response{
+		Attributes: []types.EventAttribute{
+			{Key: "action", Value: "veto_recovery"},
+			{Key: "owner", Value: sender},
+			{Key: "recovery_id", Value: strconv.FormatUint(proposal.ID, 10)},
+		},
+	}
+	return res, nil
+}
+
+func ExecuteExecuteRecovery(deps *std.Deps, env *types.Env, info *types.MessageInfo, msg *contractTypes.ExecuteRecoveryRequest) (*types.Response, error) {
+	config, err := LoadGuardians(deps.Storage)
+	if err != nil {
+		return nil, errors.New("No guardians configured")
+	}
+
+	proposal, err := LoadRecovery(deps.Storage)
+	if err != nil {
+		return nil, errors.New("Recovery not found")
+	}
+
+	if !proposal.CanExecute(*config, env.Block) {
+		return nil, errors.New("Recovery cannot be executed yet")
+	}
+
+	state, err := LoadState(deps.Storage)
+	if err != nil {
+		return nil, err
+	}
+
+	// recovery bypasses the mutable flag on purpose
+	state.Admins = proposal.Admins
+
+	err = SaveState(deps.Storage, state, env.Block.Height)
+	if err != nil {
+		return nil, err
+	}
+
+	proposal.Status = contractTypes.RecoveryExecuted
+
+	err = SaveRecovery(deps.Storage, proposal)
+	if err != nil {
+		return nil, err
+	}
+
+	res := &types.Response{
+		Attributes: []types.EventAttribute{
+			{Key: "action", Value: "execute_recovery"},
+			{Key: "recovery_id", Value: strconv.FormatUint(proposal.ID, 10)},
+		},
+	}
+	return res, nil
+}
+
+func QueryGuardians(deps *std.Deps, env *types.Env, msg *contractTypes.QueryGuardiansRequest) (*contractTypes.GuardiansResponse, error) {
+	config, err := LoadGuardians(deps.Storage)
+	if err != nil {
+		return &contractTypes.GuardiansResponse{}, nil
+	}
+
+	return &contractTypes.GuardiansResponse{
+		Guardians: config,
+	}, nil
+}
+
+func QueryRecovery(deps *std.Deps, env *types.Env, msg *contractTypes.QueryRecoveryRequest) (*contractTypes.RecoveryResponse, error) {
+	config, err := LoadGuardians(deps.Storage)
+	if err != nil {
+		return &contractTypes.RecoveryResponse{}, nil
+	}
+
+	proposal, err := LoadRecovery(deps.Storage)
+	if err != nil {
+		return &contractTypes.RecoveryResponse{}, nil
+	}
+
+	return &contractTypes.RecoveryResponse{
+		Proposal:   proposal,
+		Open:       proposal.IsOpen(*config, env.Block),
+		Executable: proposal.CanExecute(*config, env.Block),
+	}, nil
+}
@@ -4,33 +4,95 @@ import (
 	"errors"
 
 	"github.com/CosmWasm/cosmwasm-go/std"
+	"github.com/CosmWasm/cosmwasm-go/std/types"
 	contractTypes "github.com/JackalLabs/burrow-contracts/cw1-whitelist/src/types"
+	"github.com/JackalLabs/burrow-contracts/storage"
 )
 
-var ADMIN_LIST = []byte("admin_list")
+// the admin list is read on every execute, so it's kept in the compact binary format
+var ADMIN_LIST = storage.NewSnapshotItem[contractTypes.AdminList]("admin_list", "admin_list_changelog").WithFormat(storage.FormatBinary)
 
-func LoadState(storage std.Storage) (*contractTypes.AdminList, error) {
-	data := storage.Get(ADMIN_LIST)
-	if data == nil {
+func LoadState(store std.Storage) (*contractTypes.AdminList, error) {
+	state, err := ADMIN_LIST.MayLoad(store)
+	if err == nil && state == nil {
 		return nil, errors.New("state not found") // TODO(fdymylja): replace when errors API is ready
 	}
+	return state, err
+}
+
+// LoadStateAt returns the admin list as it was at the start of the block height
+func LoadStateAt(store std.Storage, height uint64) (*contractTypes.AdminList, error) {
+	state, err := ADMIN_LIST.MayLoadAt(store, height)
+	if err == nil && state == nil {
+		return nil, errors.New("state not found")
+	}
+	return state, err
+}
+
+// SaveState stores the admin list, keeping the previous one for queries at past heights
+func SaveState(store std.Storage, state *contractTypes.AdminList, height uint64) error {
+	return ADMIN_LIST.Save(store, state, height)
+}
 
-	var state contractTypes.AdminList
-	err := state.UnmarshalJSON(data)
-	if err != nil {
-		return nil, err
+var (
+	GUARDIANS = storage.NewItem[contractTypes.GuardianConfig]("guardians")
+	RECOVERY  = storage.NewItem[contractTypes.RecoveryProposal]("recovery")
+)
+
+func LoadGuardians(store std.Storage) (*contractTypes.GuardianConfig, error) {
+	config, err := GUARDIANS.MayLoad(store)
+	if err == nil && config == nil {
+		return nil, errors.New("guardians not found")
 	}
+	return config, err
+}
 
-	return &state, nil
+func SaveGuardians(store std.Storage, config *contractTypes.GuardianConfig) error {
+	return GUARDIANS.Save(store, config)
 }
 
-func SaveState(storage std.Storage, state *contractTypes.AdminList) error {
-	bz, err := state.MarshalJSON()
-	if err != nil {
-		return err
+func LoadRecovery(store std.Storage) (*contractTypes.RecoveryProposal, error) {
+	proposal, err := RECOVERY.MayLoad(store)
+	if err == nil && proposal == nil {
+		return nil, errors.New("recovery not found")
 	}
+	return proposal, err
+}
+
+func SaveRecovery(store std.Storage, proposal *contractTypes.RecoveryProposal) error {
+	return RECOVERY.Save(store, proposal)
+}
 
-	storage.Set(ADMIN_LIST, bz)
+var (
+	INHERITANCE   = storage.NewItem[contractTypes.InheritanceConfig]("inheritance")
+	LAST_ACTIVITY = storage.NewItem[types.BlockInfo]("last_activity")
+)
+
+func LoadInheritance(store std.Storage) (*contractTypes.InheritanceConfig, error) {
+	config, err := INHERITANCE.MayLoad(store)
+	if err == nil && config == nil {
+		return nil, errors.New("inheritance not found")
+	}
+	return config, err
+}
+
+func SaveInheritance(store std.Storage, config *contractTypes.InheritanceConfig) error {
+	return INHERITANCE.Save(store, config)
+}
+
+func RemoveInheritance(store std.Storage) {
+	INHERITANCE.Remove(store)
+}
+
+func LoadLastActivity(store std.Storage) (*types.BlockInfo, error) {
+	block, err := LAST_ACTIVITY.MayLoad(store)
+	if err == nil && block == nil {
+		return nil, errors.New("last activity not found")
+	}
+	return block, err
+}
 
-	return nil
+// SaveLastActivity records the block of the latest admin action
+func SaveLastActivity(store std.Storage, block types.BlockInfo) error {
+	return LAST_ACTIVITY.Save(store, &block)
 }
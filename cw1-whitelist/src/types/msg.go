@@ -5,6 +5,12 @@ import "github.com/CosmWasm/cosmwasm-go/std/types"
 type InitMsg struct {
 	Admins  []string `json:"admins"`
 	Mutable bool     `json:"mutable"`
+
+	/// Guardians optionally sets up social recovery of the admin list
+	Guardians *GuardianConfig `json:"guardians,omitempty"`
+
+	/// Inheritance optionally names a beneficiary who can take over after a period of admin inactivity
+	Inheritance *InheritanceConfig `json:"inheritance,omitempty"`
 }
 
 type MigrateMsg struct{}
@@ -21,11 +27,46 @@ type ExecuteMsg struct {
 	/// UpdateAdmins will change the admin set of the contract, must be called by an existing admin,
 	/// and only works if the contract is mutable
 	UpdateAdminsRequest *UpdateAdminsRequest `json:"update_admins,omitempty"`
+
+	/// UpdateGuardians will change the guardian set of the contract, must be called by an existing admin,
+	/// and only works if the contract is mutable
+	UpdateGuardiansRequest *UpdateGuardiansRequest `json:"update_guardians,omitempty"`
+
+	/// ProposeRecovery opens a request to replace the admin set, must be called by a guardian
+	ProposeRecoveryRequest *ProposeRecoveryRequest `json:"propose_recovery,omitempty"`
+
+	/// ApproveRecovery adds the guardian's approval to the open recovery
+	ApproveRecoveryRequest *ApproveRecoveryRequest `json:"approve_recovery,omitempty"`
+
+	/// VetoRecovery cancels the open recovery, must be called by an existing admin
+	VetoRecoveryRequest *VetoRecoveryRequest `json:"veto_recovery,omitempty"`
+
+	/// ExecuteRecovery replaces the admin set once the recovery is approved and the veto period is over.
+	/// This works even if the contract is frozen
+	ExecuteRecoveryRequest *ExecuteRecoveryRequest `json:"execute_recovery,omitempty"`
+
+	/// Heartbeat resets the inactivity timer, must be called by an existing admin
+	HeartbeatRequest *HeartbeatRequest `json:"heartbeat,omitempty"`
+
+	/// SetInheritance will change or clear the beneficiary, must be called by an existing admin,
+	/// and only works if the contract is mutable
+	SetInheritanceRequest *SetInheritanceRequest `json:"set_inheritance,omitempty"`
+
+	/// ClaimInheritance makes the beneficiary the only admin once the admins have been inactive long enough.
+	/// This works even if the contract is frozen
+	ClaimInheritanceRequest *ClaimInheritanceRequest `json:"claim_inheritance,omitempty"`
+
+	/// SweepInheritance sends all the contract's funds to the beneficiary once the admins have been inactive long enough
+	SweepInheritanceRequest *SweepInheritanceRequest `json:"sweep_inheritance,omitempty"`
 }
 
 type QueryMsg struct {
 	QueryAdminListRequest  *QueryAdminListRequest  `json:"admin_list,omitempty"`
 	QueryCanExecuteRequest *QueryCanExecuteRequest `json:"can_execute,omitempty"`
+	QueryGuardiansRequest  *QueryGuardiansRequest  `json:"guardians,omitempty"`
+	QueryRecoveryRequest   *QueryRecoveryRequest   `json:"recovery,omitempty"`
+
+	QueryInheritanceRequest *QueryInheritanceRequest `json:"inheritance,omitempty"`
 }
 
 // Requests
@@ -39,13 +80,49 @@ type UpdateAdminsRequest struct {
 	Admins []string `json:"admins,omitempty"`
 }
 
-type QueryAdminListRequest struct{}
+type UpdateGuardiansRequest struct {
+	Guardians GuardianConfig `json:"guardians"`
+}
+
+type ProposeRecoveryRequest struct {
+	Admins []string `json:"admins,omitempty"`
+}
+
+type ApproveRecoveryRequest struct {
+	ID uint64 `json:"id"`
+}
+
+type VetoRecoveryRequest struct{}
+
+type ExecuteRecoveryRequest struct{}
+
+type HeartbeatRequest struct{}
+
+type SetInheritanceRequest struct {
+	Inheritance *InheritanceConfig `json:"inheritance,omitempty"`
+}
+
+type ClaimInheritanceRequest struct{}
+
+type SweepInheritanceRequest struct{}
+
+type QueryAdminListRequest struct {
+	/// Height returns the admin list as it was at the start of that block, before its changes.
+	/// Zero returns the current list
+	Height uint64 `json:"height,omitempty"`
+}
 
 type QueryCanExecuteRequest struct {
 	Sender string `json:"sender,omitempty"`
 	// Msg    types.CosmosMsg `json:"msg,omitempty"`
 }
 
+type QueryGuardiansRequest struct{}
+
+type QueryRecoveryRequest struct{}
+
+type QueryInheritanceRequest struct{}
+
 // Responses
 type AdminListResponse struct {
 	Admins  []string `json:"admins"`
@@ -55,3 +132,19 @@ type AdminListResponse struct {
 type CanExecuteResponse struct {
 	CanExecute bool `json:"can_execute"`
 }
+
+type GuardiansResponse struct {
+	Guardians *GuardianConfig `json:"guardians,omitempty"`
+}
+
+type RecoveryResponse struct {
+	Proposal   *RecoveryProposal `json:"proposal,omitempty"`
+	Open       bool              `json:"open"`
+	Executable bool              `json:"executable"`
+}
+
+type InheritanceResponse struct {
+	Inheritance  *InheritanceConfig `json:"inheritance,omitempty"`
+	LastActivity types.BlockInfo    `json:"last_activity"`
+	Claimable    bool               `json:"claimable"`
+}
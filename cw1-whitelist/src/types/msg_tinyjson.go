@@ -16,7 +16,7 @@ var (
 	_ tinyjson.Marshaler
 )
 
-func tinyjsonF5cd6cf9DecodeGithubComJackalLabsBurrowContractsCw1WhitelistSrcTypes(in *jlexer.Lexer, out *UpdateAdminsRequest) {
+func tinyjsonF5cd6cf9DecodeGithubComJackalLabsBurrowContractsCw1WhitelistSrcTypes(in *jlexer.Lexer, out *VetoRecoveryRequest) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -35,29 +35,6 @@ func tinyjsonF5cd6cf9DecodeGithubComJackalLabsBurrowContractsCw1WhitelistSrcType
 			continue
 		}
 		switch key {
-		case "admins":
-			if in.IsNull() {
-				in.Skip()
-				out.Admins = nil
-			} else {
-				in.Delim('[')
-				if out.Admins == nil {
-					if !in.IsDelim(']') {
-						out.Admins = make([]string, 0, 4)
-					} else {
-						out.Admins = []string{}
-					}
-				} else {
-					out.Admins = (out.Admins)[:0]
-				}
-				for !in.IsDelim(']') {
-					var v1 string
-					v1 = string(in.String())
-					out.Admins = append(out.Admins, v1)
-					in.WantComma()
-				}
-				in.Delim(']')
-			}
 		default:
 			in.SkipRecursive()
 		}
@@ -68,52 +45,37 @@ func tinyjsonF5cd6cf9DecodeGithubComJackalLabsBurrowContractsCw1WhitelistSrcType
 		in.Consumed()
 	}
 }
-func tinyjsonF5cd6cf9EncodeGithubComJackalLabsBurrowContractsCw1WhitelistSrcTypes(out *jwriter.Writer, in UpdateAdminsRequest) {
+func tinyjsonF5cd6cf9EncodeGithubComJackalLabsBurrowContractsCw1WhitelistSrcTypes(out *jwriter.Writer, in VetoRecoveryRequest) {
 	out.RawByte('{')
 	first := true
 	_ = first
-	if len(in.Admins) != 0 {
-		const prefix string = ",\"admins\":"
-		first = false
-		out.RawString(prefix[1:])
-		{
-			out.RawByte('[')
-			for v2, v3 := range in.Admins {
-				if v2 > 0 {
-					out.RawByte(',')
-				}
-				out.String(string(v3))
-			}
-			out.RawByte(']')
-		}
-	}
 	out.RawByte('}')
 }
 
 // MarshalJSON supports json.Marshaler interface
-func (v UpdateAdminsRequest) MarshalJSON() ([]byte, error) {
+func (v VetoRecoveryRequest) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
 	tinyjsonF5cd6cf9EncodeGithubComJackalLabsBurrowContractsCw1WhitelistSrcTypes(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalTinyJSON supports tinyjson.Marshaler interface
-func (v UpdateAdminsRequest) MarshalTinyJSON(w *jwriter.Writer) {
+func (v VetoRecoveryRequest) MarshalTinyJSON(w *jwriter.Writer) {
 	tinyjsonF5cd6cf9EncodeGithubComJackalLabsBurrowContractsCw1WhitelistSrcTypes(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
-func (v *UpdateAdminsRequest) UnmarshalJSON(data []byte) error {
+func (v *VetoRecoveryRequest) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
 	tinyjsonF5cd6cf9DecodeGithubComJackalLabsBurrowContractsCw1WhitelistSrcTypes(&r, v)
 	return r.Error()
 }
 
 // UnmarshalTinyJSON supports tinyjson.Unmarshaler interface
-func (v *UpdateAdminsRequest) UnmarshalTinyJSON(l *jlexer.Lexer) {
+func (v *VetoRecoveryRequest) UnmarshalTinyJSON(l *jlexer.Lexer) {
 	tinyjsonF5cd6cf9DecodeGithubComJackalLabsBurrowContractsCw1WhitelistSrcTypes(l, v)
 }
-func tinyjsonF5cd6cf9DecodeGithubComJackalLabsBurrowContractsCw1WhitelistSrcTypes1(in *jlexer.Lexer, out *QueryMsg) {
+func tinyjsonF5cd6cf9DecodeGithubComJackalLabsBurrowContractsCw1WhitelistSrcTypes1(in *jlexer.Lexer, out *UpdateGuardiansRequest) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -132,26 +94,8 @@ func tinyjsonF5cd6cf9DecodeGithubComJackalLabsBurrowContractsCw1WhitelistSrcType
 			continue
 		}
 		switch key {
-		case "admin_list":
-			if in.IsNull() {
-				in.Skip()
-				out.QueryAdminListRequest = nil
-			} else {
-				if out.QueryAdminListRequest == nil {
-					out.QueryAdminListRequest = new(QueryAdminListRequest)
-				}
-				(*out.QueryAdminListRequest).UnmarshalTinyJSON(in)
-			}
-		case "can_execute":
-			if in.IsNull() {
-				in.Skip()
-				out.QueryCanExecuteRequest = nil
-			} else {
-				if out.QueryCanExecuteRequest == nil {
-					out.QueryCanExecuteRequest = new(QueryCanExecuteRequest)
-				}
-				(*out.QueryCanExecuteRequest).UnmarshalTinyJSON(in)
-			}
+		case "guardians":
+			(out.Guardians).UnmarshalTinyJSON(in)
 		default:
 			in.SkipRecursive()
 		}
@@ -162,53 +106,42 @@ func tinyjsonF5cd6cf9DecodeGithubComJackalLabsBurrowContractsCw1WhitelistSrcType
 		in.Consumed()
 	}
 }
-func tinyjsonF5cd6cf9EncodeGithubComJackalLabsBurrowContractsCw1WhitelistSrcTypes1(out *jwriter.Writer, in QueryMsg) {
+func tinyjsonF5cd6cf9EncodeGithubComJackalLabsBurrowContractsCw1WhitelistSrcTypes1(out *jwriter.Writer, in UpdateGuardiansRequest) {
 	out.RawByte('{')
 	first := true
 	_ = first
-	if in.QueryAdminListRequest != nil {
-		const prefix string = ",\"admin_list\":"
-		first = false
+	{
+		const prefix string = ",\"guardians\":"
 		out.RawString(prefix[1:])
-		(*in.QueryAdminListRequest).MarshalTinyJSON(out)
-	}
-	if in.QueryCanExecuteRequest != nil {
-		const prefix string = ",\"can_execute\":"
-		if first {
-			first = false
-			out.RawString(prefix[1:])
-		} else {
-			out.RawString(prefix)
-		}
-		(*in.QueryCanExecuteRequest).MarshalTinyJSON(out)
+		(in.Guardians).MarshalTinyJSON(out)
 	}
 	out.RawByte('}')
 }
 
 // MarshalJSON supports json.Marshaler interface
-func (v QueryMsg) MarshalJSON() ([]byte, error) {
+func (v UpdateGuardiansRequest) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
 	tinyjsonF5cd6cf9EncodeGithubComJackalLabsBurrowContractsCw1WhitelistSrcTypes1(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalTinyJSON supports tinyjson.Marshaler interface
-func (v QueryMsg) MarshalTinyJSON(w *jwriter.Writer) {
+func (v UpdateGuardiansRequest) MarshalTinyJSON(w *jwriter.Writer) {
 	tinyjsonF5cd6cf9EncodeGithubComJackalLabsBurrowContractsCw1WhitelistSrcTypes1(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
-func (v *QueryMsg) UnmarshalJSON(data []byte) error {
+func (v *UpdateGuardiansRequest) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
 	tinyjsonF5cd6cf9DecodeGithubComJackalLabsBurrowContractsCw1WhitelistSrcTypes1(&r, v)
 	return r.Error()
 }
 
 // UnmarshalTinyJSON supports tinyjson.Unmarshaler interface
-func (v *QueryMsg) UnmarshalTinyJSON(l *jlexer.Lexer) {
+func (v *UpdateGuardiansRequest) UnmarshalTinyJSON(l *jlexer.Lexer) {
 	tinyjsonF5cd6cf9DecodeGithubComJackalLabsBurrowContractsCw1WhitelistSrcTypes1(l, v)
 }
-func tinyjsonF5cd6cf9DecodeGithubComJackalLabsBurrowContractsCw1WhitelistSrcTypes2(in *jlexer.Lexer, out *QueryCanExecuteRequest) {
+func tinyjsonF5cd6cf9DecodeGithubComJackalLabsBurrowContractsCw1WhitelistSrcTypes2(in *jlexer.Lexer, out *UpdateAdminsRequest) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -227,8 +160,29 @@ func tinyjsonF5cd6cf9DecodeGithubComJackalLabsBurrowContractsCw1WhitelistSrcType
 			continue
 		}
 		switch key {
-		case "sender":
-			out.Sender = string(in.String())
+		case "admins":
+			if in.IsNull() {
+				in.Skip()
+				out.Admins = nil
+			} else {
+				in.Delim('[')
+				if out.Admins == nil {
+					if !in.IsDelim(']') {
+						out.Admins = make([]string, 0, 4)
+					} else {
+						out.Admins = []string{}
+					}
+				} else {
+					out.Admins = (out.Admins)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v1 string
+					v1 = string(in.String())
+					out.Admins = append(out.Admins, v1)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
 		default:
 			in.SkipRecursive()
 		}
@@ -239,43 +193,52 @@ func tinyjsonF5cd6cf9DecodeGithubComJackalLabsBurrowContractsCw1WhitelistSrcType
 		in.Consumed()
 	}
 }
-func tinyjsonF5cd6cf9EncodeGithubComJackalLabsBurrowContractsCw1WhitelistSrcTypes2(out *jwriter.Writer, in QueryCanExecuteRequest) {
+func tinyjsonF5cd6cf9EncodeGithubComJackalLabsBurrowContractsCw1WhitelistSrcTypes2(out *jwriter.Writer, in UpdateAdminsRequest) {
 	out.RawByte('{')
 	first := true
 	_ = first
-	if in.Sender != "" {
-		const prefix string = ",\"sender\":"
+	if len(in.Admins) != 0 {
+		const prefix string = ",\"admins\":"
 		first = false
 		out.RawString(prefix[1:])
-		out.String(string(in.Sender))
+		{
+			out.RawByte('[')
+			for v2, v3 := range in.Admins {
+				if v2 > 0 {
+					out.RawByte(',')
+				}
+				out.String(string(v3))
+			}
+			out.RawByte(']')
+		}
 	}
 	out.RawByte('}')
 }
 
 // MarshalJSON supports json.Marshaler interface
-func (v QueryCanExecuteRequest) MarshalJSON() ([]byte, error) {
+func (v UpdateAdminsRequest) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
 	tinyjsonF5cd6cf9EncodeGithubComJackalLabsBurrowContractsCw1WhitelistSrcTypes2(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalTinyJSON supports tinyjson.Marshaler interface
-func (v QueryCanExecuteRequest) MarshalTinyJSON(w *jwriter.Writer) {
+func (v UpdateAdminsRequest) MarshalTinyJSON(w *jwriter.Writer) {
 	tinyjsonF5cd6cf9EncodeGithubComJackalLabsBurrowContractsCw1WhitelistSrcTypes2(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
-func (v *QueryCanExecuteRequest) UnmarshalJSON(data []byte) error {
+func (v *UpdateAdminsRequest) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
 	tinyjsonF5cd6cf9DecodeGithubComJackalLabsBurrowContractsCw1WhitelistSrcTypes2(&r, v)
 	return r.Error()
 }
 
 // UnmarshalTinyJSON supports tinyjson.Unmarshaler interface
-func (v *QueryCanExecuteRequest) UnmarshalTinyJSON(l *jlexer.Lexer) {
+func (v *UpdateAdminsRequest) UnmarshalTinyJSON(l *jlexer.Lexer) {
 	tinyjsonF5cd6cf9DecodeGithubComJackalLabsBurrowContractsCw1WhitelistSrcTypes2(l, v)
 }
-func tinyjsonF5cd6cf9DecodeGithubComJackalLabsBurrowContractsCw1WhitelistSrcTypes3(in *jlexer.Lexer, out *QueryAdminListRequest) {
+func tinyjsonF5cd6cf9DecodeGithubComJackalLabsBurrowContractsCw1WhitelistSrcTypes3(in *jlexer.Lexer, out *SweepInheritanceRequest) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -304,7 +267,7 @@ func tinyjsonF5cd6cf9DecodeGithubComJackalLabsBurrowContractsCw1WhitelistSrcType
 		in.Consumed()
 	}
 }
-func tinyjsonF5cd6cf9EncodeGithubComJackalLabsBurrowContractsCw1WhitelistSrcTypes3(out *jwriter.Writer, in QueryAdminListRequest) {
+func tinyjsonF5cd6cf9EncodeGithubComJackalLabsBurrowContractsCw1WhitelistSrcTypes3(out *jwriter.Writer, in SweepInheritanceRequest) {
 	out.RawByte('{')
 	first := true
 	_ = first
@@ -312,29 +275,29 @@ func tinyjsonF5cd6cf9EncodeGithubComJackalLabsBurrowContractsCw1WhitelistSrcType
 }
 
 // MarshalJSON supports json.Marshaler interface
-func (v QueryAdminListRequest) MarshalJSON() ([]byte, error) {
+func (v SweepInheritanceRequest) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
 	tinyjsonF5cd6cf9EncodeGithubComJackalLabsBurrowContractsCw1WhitelistSrcTypes3(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalTinyJSON supports tinyjson.Marshaler interface
-func (v QueryAdminListRequest) MarshalTinyJSON(w *jwriter.Writer) {
+func (v SweepInheritanceRequest) MarshalTinyJSON(w *jwriter.Writer) {
 	tinyjsonF5cd6cf9EncodeGithubComJackalLabsBurrowContractsCw1WhitelistSrcTypes3(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
-func (v *QueryAdminListRequest) UnmarshalJSON(data []byte) error {
+func (v *SweepInheritanceRequest) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
 	tinyjsonF5cd6cf9DecodeGithubComJackalLabsBurrowContractsCw1WhitelistSrcTypes3(&r, v)
 	return r.Error()
 }
 
 // UnmarshalTinyJSON supports tinyjson.Unmarshaler interface
-func (v *QueryAdminListRequest) UnmarshalTinyJSON(l *jlexer.Lexer) {
+func (v *SweepInheritanceRequest) UnmarshalTinyJSON(l *jlexer.Lexer) {
 	tinyjsonF5cd6cf9DecodeGithubComJackalLabsBurrowContractsCw1WhitelistSrcTypes3(l, v)
 }
-func tinyjsonF5cd6cf9DecodeGithubComJackalLabsBurrowContractsCw1WhitelistSrcTypes4(in *jlexer.Lexer, out *MigrateMsg) {
+func tinyjsonF5cd6cf9DecodeGithubComJackalLabsBurrowContractsCw1WhitelistSrcTypes4(in *jlexer.Lexer, out *SetInheritanceRequest) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -353,6 +316,16 @@ func tinyjsonF5cd6cf9DecodeGithubComJackalLabsBurrowContractsCw1WhitelistSrcType
 			continue
 		}
 		switch key {
+		case "inheritance":
+			if in.IsNull() {
+				in.Skip()
+				out.Inheritance = nil
+			} else {
+				if out.Inheritance == nil {
+					out.Inheritance = new(InheritanceConfig)
+				}
+				(*out.Inheritance).UnmarshalTinyJSON(in)
+			}
 		default:
 			in.SkipRecursive()
 		}
@@ -363,37 +336,43 @@ func tinyjsonF5cd6cf9DecodeGithubComJackalLabsBurrowContractsCw1WhitelistSrcType
 		in.Consumed()
 	}
 }
-func tinyjsonF5cd6cf9EncodeGithubComJackalLabsBurrowContractsCw1WhitelistSrcTypes4(out *jwriter.Writer, in MigrateMsg) {
+func tinyjsonF5cd6cf9EncodeGithubComJackalLabsBurrowContractsCw1WhitelistSrcTypes4(out *jwriter.Writer, in SetInheritanceRequest) {
 	out.RawByte('{')
 	first := true
 	_ = first
+	if in.Inheritance != nil {
+		const prefix string = ",\"inheritance\":"
+		first = false
+		out.RawString(prefix[1:])
+		(*in.Inheritance).MarshalTinyJSON(out)
+	}
 	out.RawByte('}')
 }
 
 // MarshalJSON supports json.Marshaler interface
-func (v MigrateMsg) MarshalJSON() ([]byte, error) {
+func (v SetInheritanceRequest) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
 	tinyjsonF5cd6cf9EncodeGithubComJackalLabsBurrowContractsCw1WhitelistSrcTypes4(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalTinyJSON supports tinyjson.Marshaler interface
-func (v MigrateMsg) MarshalTinyJSON(w *jwriter.Writer) {
+func (v SetInheritanceRequest) MarshalTinyJSON(w *jwriter.Writer) {
 	tinyjsonF5cd6cf9EncodeGithubComJackalLabsBurrowContractsCw1WhitelistSrcTypes4(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
-func (v *MigrateMsg) UnmarshalJSON(data []byte) error {
+func (v *SetInheritanceRequest) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
 	tinyjsonF5cd6cf9DecodeGithubComJackalLabsBurrowContractsCw1WhitelistSrcTypes4(&r, v)
 	return r.Error()
 }
 
 // UnmarshalTinyJSON supports tinyjson.Unmarshaler interface
-func (v *MigrateMsg) UnmarshalTinyJSON(l *jlexer.Lexer) {
+func (v *SetInheritanceRequest) UnmarshalTinyJSON(l *jlexer.Lexer) {
 	tinyjsonF5cd6cf9DecodeGithubComJackalLabsBurrowContractsCw1WhitelistSrcTypes4(l, v)
 }
-func tinyjsonF5cd6cf9DecodeGithubComJackalLabsBurrowContractsCw1WhitelistSrcTypes5(in *jlexer.Lexer, out *InitMsg) {
+func tinyjsonF5cd6cf9DecodeGithubComJackalLabsBurrowContractsCw1WhitelistSrcTypes5(in *jlexer.Lexer, out *RecoveryResponse) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -412,31 +391,20 @@ func tinyjsonF5cd6cf9DecodeGithubComJackalLabsBurrowContractsCw1WhitelistSrcType
 			continue
 		}
 		switch key {
-		case "admins":
+		case "proposal":
 			if in.IsNull() {
 				in.Skip()
-				out.Admins = nil
+				out.Proposal = nil
 			} else {
-				in.Delim('[')
-				if out.Admins == nil {
-					if !in.IsDelim(']') {
-						out.Admins = make([]string, 0, 4)
-					} else {
-						out.Admins = []string{}
-					}
-				} else {
-					out.Admins = (out.Admins)[:0]
-				}
-				for !in.IsDelim(']') {
-					var v4 string
-					v4 = string(in.String())
-					out.Admins = append(out.Admins, v4)
-					in.WantComma()
+				if out.Proposal == nil {
+					out.Proposal = new(RecoveryProposal)
 				}
-				in.Delim(']')
+				(*out.Proposal).UnmarshalTinyJSON(in)
 			}
-		case "mutable":
-			out.Mutable = bool(in.Bool())
+		case "open":
+			out.Open = bool(in.Bool())
+		case "executable":
+			out.Executable = bool(in.Bool())
 		default:
 			in.SkipRecursive()
 		}
@@ -447,58 +415,58 @@ func tinyjsonF5cd6cf9DecodeGithubComJackalLabsBurrowContractsCw1WhitelistSrcType
 		in.Consumed()
 	}
 }
-func tinyjsonF5cd6cf9EncodeGithubComJackalLabsBurrowContractsCw1WhitelistSrcTypes5(out *jwriter.Writer, in InitMsg) {
+func tinyjsonF5cd6cf9EncodeGithubComJackalLabsBurrowContractsCw1WhitelistSrcTypes5(out *jwriter.Writer, in RecoveryResponse) {
 	out.RawByte('{')
 	first := true
 	_ = first
-	{
-		const prefix string = ",\"admins\":"
+	if in.Proposal != nil {
+		const prefix string = ",\"proposal\":"
+		first = false
 		out.RawString(prefix[1:])
-		if in.Admins == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
-			out.RawString("null")
+		(*in.Proposal).MarshalTinyJSON(out)
+	}
+	{
+		const prefix string = ",\"open\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
 		} else {
-			out.RawByte('[')
-			for v5, v6 := range in.Admins {
-				if v5 > 0 {
-					out.RawByte(',')
-				}
-				out.String(string(v6))
-			}
-			out.RawByte(']')
+			out.RawString(prefix)
 		}
+		out.Bool(bool(in.Open))
 	}
 	{
-		const prefix string = ",\"mutable\":"
+		const prefix string = ",\"executable\":"
 		out.RawString(prefix)
-		out.Bool(bool(in.Mutable))
+		out.Bool(bool(in.Executable))
 	}
 	out.RawByte('}')
 }
 
 // MarshalJSON supports json.Marshaler interface
-func (v InitMsg) MarshalJSON() ([]byte, error) {
+func (v RecoveryResponse) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
 	tinyjsonF5cd6cf9EncodeGithubComJackalLabsBurrowContractsCw1WhitelistSrcTypes5(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalTinyJSON supports tinyjson.Marshaler interface
-func (v InitMsg) MarshalTinyJSON(w *jwriter.Writer) {
+func (v RecoveryResponse) MarshalTinyJSON(w *jwriter.Writer) {
 	tinyjsonF5cd6cf9EncodeGithubComJackalLabsBurrowContractsCw1WhitelistSrcTypes5(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
-func (v *InitMsg) UnmarshalJSON(data []byte) error {
+func (v *RecoveryResponse) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
 	tinyjsonF5cd6cf9DecodeGithubComJackalLabsBurrowContractsCw1WhitelistSrcTypes5(&r, v)
 	return r.Error()
 }
 
 // UnmarshalTinyJSON supports tinyjson.Unmarshaler interface
-func (v *InitMsg) UnmarshalTinyJSON(l *jlexer.Lexer) {
+func (v *RecoveryResponse) UnmarshalTinyJSON(l *jlexer.Lexer) {
 	tinyjsonF5cd6cf9DecodeGithubComJackalLabsBurrowContractsCw1WhitelistSrcTypes5(l, v)
 }
-func tinyjsonF5cd6cf9DecodeGithubComJackalLabsBurrowContractsCw1WhitelistSrcTypes6(in *jlexer.Lexer, out *FreezeRequest) {
+func tinyjsonF5cd6cf9DecodeGithubComJackalLabsBurrowContractsCw1WhitelistSrcTypes6(in *jlexer.Lexer, out *QueryRecoveryRequest) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -527,7 +495,7 @@ func tinyjsonF5cd6cf9DecodeGithubComJackalLabsBurrowContractsCw1WhitelistSrcType
 		in.Consumed()
 	}
 }
-func tinyjsonF5cd6cf9EncodeGithubComJackalLabsBurrowContractsCw1WhitelistSrcTypes6(out *jwriter.Writer, in FreezeRequest) {
+func tinyjsonF5cd6cf9EncodeGithubComJackalLabsBurrowContractsCw1WhitelistSrcTypes6(out *jwriter.Writer, in QueryRecoveryRequest) {
 	out.RawByte('{')
 	first := true
 	_ = first
@@ -535,29 +503,29 @@ func tinyjsonF5cd6cf9EncodeGithubComJackalLabsBurrowContractsCw1WhitelistSrcType
 }
 
 // MarshalJSON supports json.Marshaler interface
-func (v FreezeRequest) MarshalJSON() ([]byte, error) {
+func (v QueryRecoveryRequest) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
 	tinyjsonF5cd6cf9EncodeGithubComJackalLabsBurrowContractsCw1WhitelistSrcTypes6(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalTinyJSON supports tinyjson.Marshaler interface
-func (v FreezeRequest) MarshalTinyJSON(w *jwriter.Writer) {
+func (v QueryRecoveryRequest) MarshalTinyJSON(w *jwriter.Writer) {
 	tinyjsonF5cd6cf9EncodeGithubComJackalLabsBurrowContractsCw1WhitelistSrcTypes6(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
-func (v *FreezeRequest) UnmarshalJSON(data []byte) error {
+func (v *QueryRecoveryRequest) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
 	tinyjsonF5cd6cf9DecodeGithubComJackalLabsBurrowContractsCw1WhitelistSrcTypes6(&r, v)
 	return r.Error()
 }
 
 // UnmarshalTinyJSON supports tinyjson.Unmarshaler interface
-func (v *FreezeRequest) UnmarshalTinyJSON(l *jlexer.Lexer) {
+func (v *QueryRecoveryRequest) UnmarshalTinyJSON(l *jlexer.Lexer) {
 	tinyjsonF5cd6cf9DecodeGithubComJackalLabsBurrowContractsCw1WhitelistSrcTypes6(l, v)
 }
-func tinyjsonF5cd6cf9DecodeGithubComJackalLabsBurrowContractsCw1WhitelistSrcTypes7(in *jlexer.Lexer, out *ExecuteRequest) {
+func tinyjsonF5cd6cf9DecodeGithubComJackalLabsBurrowContractsCw1WhitelistSrcTypes7(in *jlexer.Lexer, out *QueryMsg) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -576,28 +544,55 @@ func tinyjsonF5cd6cf9DecodeGithubComJackalLabsBurrowContractsCw1WhitelistSrcType
 			continue
 		}
 		switch key {
-		case "msgs":
+		case "admin_list":
 			if in.IsNull() {
 				in.Skip()
-				out.Msgs = nil
+				out.QueryAdminListRequest = nil
 			} else {
-				in.Delim('[')
-				if out.Msgs == nil {
-					if !in.IsDelim(']') {
-						out.Msgs = make([]types.CosmosMsg, 0, 0)
-					} else {
-						out.Msgs = []types.CosmosMsg{}
-					}
-				} else {
-					out.Msgs = (out.Msgs)[:0]
+				if out.QueryAdminListRequest == nil {
+					out.QueryAdminListRequest = new(QueryAdminListRequest)
 				}
-				for !in.IsDelim(']') {
-					var v7 types.CosmosMsg
-					(v7).UnmarshalTinyJSON(in)
-					out.Msgs = append(out.Msgs, v7)
-					in.WantComma()
+				(*out.QueryAdminListRequest).UnmarshalTinyJSON(in)
+			}
+		case "can_execute":
+			if in.IsNull() {
+				in.Skip()
+				out.QueryCanExecuteRequest = nil
+			} else {
+				if out.QueryCanExecuteRequest == nil {
+					out.QueryCanExecuteRequest = new(QueryCanExecuteRequest)
 				}
-				in.Delim(']')
+				(*out.QueryCanExecuteRequest).UnmarshalTinyJSON(in)
+			}
+		case "guardians":
+			if in.IsNull() {
+				in.Skip()
+				out.QueryGuardiansRequest = nil
+			} else {
+				if out.QueryGuardiansRequest == nil {
+					out.QueryGuardiansRequest = new(QueryGuardiansRequest)
+				}
+				(*out.QueryGuardiansRequest).UnmarshalTinyJSON(in)
+			}
+		case "recovery":
+			if in.IsNull() {
+				in.Skip()
+				out.QueryRecoveryRequest = nil
+			} else {
+				if out.QueryRecoveryRequest == nil {
+					out.QueryRecoveryRequest = new(QueryRecoveryRequest)
+				}
+				(*out.QueryRecoveryRequest).UnmarshalTinyJSON(in)
+			}
+		case "inheritance":
+			if in.IsNull() {
+				in.Skip()
+				out.QueryInheritanceRequest = nil
+			} else {
+				if out.QueryInheritanceRequest == nil {
+					out.QueryInheritanceRequest = new(QueryInheritanceRequest)
+				}
+				(*out.QueryInheritanceRequest).UnmarshalTinyJSON(in)
 			}
 		default:
 			in.SkipRecursive()
@@ -609,52 +604,1069 @@ func tinyjsonF5cd6cf9DecodeGithubComJackalLabsBurrowContractsCw1WhitelistSrcType
 		in.Consumed()
 	}
 }
-func tinyjsonF5cd6cf9EncodeGithubComJackalLabsBurrowContractsCw1WhitelistSrcTypes7(out *jwriter.Writer, in ExecuteRequest) {
+func tinyjsonF5cd6cf9EncodeGithubComJackalLabsBurrowContractsCw1WhitelistSrcTypes7(out *jwriter.Writer, in QueryMsg) {
 	out.RawByte('{')
 	first := true
 	_ = first
-	if len(in.Msgs) != 0 {
-		const prefix string = ",\"msgs\":"
+	if in.QueryAdminListRequest != nil {
+		const prefix string = ",\"admin_list\":"
 		first = false
 		out.RawString(prefix[1:])
-		{
-			out.RawByte('[')
-			for v8, v9 := range in.Msgs {
-				if v8 > 0 {
-					out.RawByte(',')
-				}
-				(v9).MarshalTinyJSON(out)
-			}
-			out.RawByte(']')
-		}
+		(*in.QueryAdminListRequest).MarshalTinyJSON(out)
 	}
-	out.RawByte('}')
-}
-
-// MarshalJSON supports json.Marshaler interface
-func (v ExecuteRequest) MarshalJSON() ([]byte, error) {
-	w := jwriter.Writer{}
+	if in.QueryCanExecuteRequest != nil {
+		const prefix string = ",\"can_execute\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		(*in.QueryCanExecuteRequest).MarshalTinyJSON(out)
+	}
+	if in.QueryGuardiansRequest != nil {
+		const prefix string = ",\"guardians\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		(*in.QueryGuardiansRequest).MarshalTinyJSON(out)
+	}
+	if in.QueryRecoveryRequest != nil {
+		const prefix string = ",\"recovery\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		(*in.QueryRecoveryRequest).MarshalTinyJSON(out)
+	}
+	if in.QueryInheritanceRequest != nil {
+		const prefix string = ",\"inheritance\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		(*in.QueryInheritanceRequest).MarshalTinyJSON(out)
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v QueryMsg) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
 	tinyjsonF5cd6cf9EncodeGithubComJackalLabsBurrowContractsCw1WhitelistSrcTypes7(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalTinyJSON supports tinyjson.Marshaler interface
-func (v ExecuteRequest) MarshalTinyJSON(w *jwriter.Writer) {
-	tinyjsonF5cd6cf9EncodeGithubComJackalLabsBurrowContractsCw1WhitelistSrcTypes7(w, v)
+func (v QueryMsg) MarshalTinyJSON(w *jwriter.Writer) {
+	tinyjsonF5cd6cf9EncodeGithubComJackalLabsBurrowContractsCw1WhitelistSrcTypes7(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *QueryMsg) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	tinyjsonF5cd6cf9DecodeGithubComJackalLabsBurrowContractsCw1WhitelistSrcTypes7(&r, v)
+	return r.Error()
+}
+
+// UnmarshalTinyJSON supports tinyjson.Unmarshaler interface
+func (v *QueryMsg) UnmarshalTinyJSON(l *jlexer.Lexer) {
+	tinyjsonF5cd6cf9DecodeGithubComJackalLabsBurrowContractsCw1WhitelistSrcTypes7(l, v)
+}
+func tinyjsonF5cd6cf9DecodeGithubComJackalLabsBurrowContractsCw1WhitelistSrcTypes8(in *jlexer.Lexer, out *QueryInheritanceRequest) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func tinyjsonF5cd6cf9EncodeGithubComJackalLabsBurrowContractsCw1WhitelistSrcTypes8(out *jwriter.Writer, in QueryInheritanceRequest) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v QueryInheritanceRequest) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	tinyjsonF5cd6cf9EncodeGithubComJackalLabsBurrowContractsCw1WhitelistSrcTypes8(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalTinyJSON supports tinyjson.Marshaler interface
+func (v QueryInheritanceRequest) MarshalTinyJSON(w *jwriter.Writer) {
+	tinyjsonF5cd6cf9EncodeGithubComJackalLabsBurrowContractsCw1WhitelistSrcTypes8(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *QueryInheritanceRequest) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	tinyjsonF5cd6cf9DecodeGithubComJackalLabsBurrowContractsCw1WhitelistSrcTypes8(&r, v)
+	return r.Error()
+}
+
+// UnmarshalTinyJSON supports tinyjson.Unmarshaler interface
+func (v *QueryInheritanceRequest) UnmarshalTinyJSON(l *jlexer.Lexer) {
+	tinyjsonF5cd6cf9DecodeGithubComJackalLabsBurrowContractsCw1WhitelistSrcTypes8(l, v)
+}
+func tinyjsonF5cd6cf9DecodeGithubComJackalLabsBurrowContractsCw1WhitelistSrcTypes9(in *jlexer.Lexer, out *QueryGuardiansRequest) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func tinyjsonF5cd6cf9EncodeGithubComJackalLabsBurrowContractsCw1WhitelistSrcTypes9(out *jwriter.Writer, in QueryGuardiansRequest) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v QueryGuardiansRequest) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	tinyjsonF5cd6cf9EncodeGithubComJackalLabsBurrowContractsCw1WhitelistSrcTypes9(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalTinyJSON supports tinyjson.Marshaler interface
+func (v QueryGuardiansRequest) MarshalTinyJSON(w *jwriter.Writer) {
+	tinyjsonF5cd6cf9EncodeGithubComJackalLabsBurrowContractsCw1WhitelistSrcTypes9(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *QueryGuardiansRequest) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	tinyjsonF5cd6cf9DecodeGithubComJackalLabsBurrowContractsCw1WhitelistSrcTypes9(&r, v)
+	return r.Error()
+}
+
+// UnmarshalTinyJSON supports tinyjson.Unmarshaler interface
+func (v *QueryGuardiansRequest) UnmarshalTinyJSON(l *jlexer.Lexer) {
+	tinyjsonF5cd6cf9DecodeGithubComJackalLabsBurrowContractsCw1WhitelistSrcTypes9(l, v)
+}
+func tinyjsonF5cd6cf9DecodeGithubComJackalLabsBurrowContractsCw1WhitelistSrcTypes10(in *jlexer.Lexer, out *QueryCanExecuteRequest) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "sender":
+			out.Sender = string(in.String())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func tinyjsonF5cd6cf9EncodeGithubComJackalLabsBurrowContractsCw1WhitelistSrcTypes10(out *jwriter.Writer, in QueryCanExecuteRequest) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	if in.Sender != "" {
+		const prefix string = ",\"sender\":"
+		first = false
+		out.RawString(prefix[1:])
+		out.String(string(in.Sender))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v QueryCanExecuteRequest) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	tinyjsonF5cd6cf9EncodeGithubComJackalLabsBurrowContractsCw1WhitelistSrcTypes10(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalTinyJSON supports tinyjson.Marshaler interface
+func (v QueryCanExecuteRequest) MarshalTinyJSON(w *jwriter.Writer) {
+	tinyjsonF5cd6cf9EncodeGithubComJackalLabsBurrowContractsCw1WhitelistSrcTypes10(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *QueryCanExecuteRequest) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	tinyjsonF5cd6cf9DecodeGithubComJackalLabsBurrowContractsCw1WhitelistSrcTypes10(&r, v)
+	return r.Error()
+}
+
+// UnmarshalTinyJSON supports tinyjson.Unmarshaler interface
+func (v *QueryCanExecuteRequest) UnmarshalTinyJSON(l *jlexer.Lexer) {
+	tinyjsonF5cd6cf9DecodeGithubComJackalLabsBurrowContractsCw1WhitelistSrcTypes10(l, v)
+}
+func tinyjsonF5cd6cf9DecodeGithubComJackalLabsBurrowContractsCw1WhitelistSrcTypes11(in *jlexer.Lexer, out *QueryAdminListRequest) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "height":
+			out.Height = uint64(in.Uint64())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func tinyjsonF5cd6cf9EncodeGithubComJackalLabsBurrowContractsCw1WhitelistSrcTypes11(out *jwriter.Writer, in QueryAdminListRequest) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	if in.Height != 0 {
+		const prefix string = ",\"height\":"
+		first = false
+		out.RawString(prefix[1:])
+		out.Uint64(uint64(in.Height))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v QueryAdminListRequest) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	tinyjsonF5cd6cf9EncodeGithubComJackalLabsBurrowContractsCw1WhitelistSrcTypes11(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalTinyJSON supports tinyjson.Marshaler interface
+func (v QueryAdminListRequest) MarshalTinyJSON(w *jwriter.Writer) {
+	tinyjsonF5cd6cf9EncodeGithubComJackalLabsBurrowContractsCw1WhitelistSrcTypes11(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *QueryAdminListRequest) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	tinyjsonF5cd6cf9DecodeGithubComJackalLabsBurrowContractsCw1WhitelistSrcTypes11(&r, v)
+	return r.Error()
+}
+
+// UnmarshalTinyJSON supports tinyjson.Unmarshaler interface
+func (v *QueryAdminListRequest) UnmarshalTinyJSON(l *jlexer.Lexer) {
+	tinyjsonF5cd6cf9DecodeGithubComJackalLabsBurrowContractsCw1WhitelistSrcTypes11(l, v)
+}
+func tinyjsonF5cd6cf9DecodeGithubComJackalLabsBurrowContractsCw1WhitelistSrcTypes12(in *jlexer.Lexer, out *ProposeRecoveryRequest) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "admins":
+			if in.IsNull() {
+				in.Skip()
+				out.Admins = nil
+			} else {
+				in.Delim('[')
+				if out.Admins == nil {
+					if !in.IsDelim(']') {
+						out.Admins = make([]string, 0, 4)
+					} else {
+						out.Admins = []string{}
+					}
+				} else {
+					out.Admins = (out.Admins)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v4 string
+					v4 = string(in.String())
+					out.Admins = append(out.Admins, v4)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func tinyjsonF5cd6cf9EncodeGithubComJackalLabsBurrowContractsCw1WhitelistSrcTypes12(out *jwriter.Writer, in ProposeRecoveryRequest) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	if len(in.Admins) != 0 {
+		const prefix string = ",\"admins\":"
+		first = false
+		out.RawString(prefix[1:])
+		{
+			out.RawByte('[')
+			for v5, v6 := range in.Admins {
+				if v5 > 0 {
+					out.RawByte(',')
+				}
+				out.String(string(v6))
+			}
+			out.RawByte(']')
+		}
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v ProposeRecoveryRequest) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	tinyjsonF5cd6cf9EncodeGithubComJackalLabsBurrowContractsCw1WhitelistSrcTypes12(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalTinyJSON supports tinyjson.Marshaler interface
+func (v ProposeRecoveryRequest) MarshalTinyJSON(w *jwriter.Writer) {
+	tinyjsonF5cd6cf9EncodeGithubComJackalLabsBurrowContractsCw1WhitelistSrcTypes12(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *ProposeRecoveryRequest) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	tinyjsonF5cd6cf9DecodeGithubComJackalLabsBurrowContractsCw1WhitelistSrcTypes12(&r, v)
+	return r.Error()
+}
+
+// UnmarshalTinyJSON supports tinyjson.Unmarshaler interface
+func (v *ProposeRecoveryRequest) UnmarshalTinyJSON(l *jlexer.Lexer) {
+	tinyjsonF5cd6cf9DecodeGithubComJackalLabsBurrowContractsCw1WhitelistSrcTypes12(l, v)
+}
+func tinyjsonF5cd6cf9DecodeGithubComJackalLabsBurrowContractsCw1WhitelistSrcTypes13(in *jlexer.Lexer, out *MigrateMsg) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func tinyjsonF5cd6cf9EncodeGithubComJackalLabsBurrowContractsCw1WhitelistSrcTypes13(out *jwriter.Writer, in MigrateMsg) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v MigrateMsg) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	tinyjsonF5cd6cf9EncodeGithubComJackalLabsBurrowContractsCw1WhitelistSrcTypes13(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalTinyJSON supports tinyjson.Marshaler interface
+func (v MigrateMsg) MarshalTinyJSON(w *jwriter.Writer) {
+	tinyjsonF5cd6cf9EncodeGithubComJackalLabsBurrowContractsCw1WhitelistSrcTypes13(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *MigrateMsg) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	tinyjsonF5cd6cf9DecodeGithubComJackalLabsBurrowContractsCw1WhitelistSrcTypes13(&r, v)
+	return r.Error()
+}
+
+// UnmarshalTinyJSON supports tinyjson.Unmarshaler interface
+func (v *MigrateMsg) UnmarshalTinyJSON(l *jlexer.Lexer) {
+	tinyjsonF5cd6cf9DecodeGithubComJackalLabsBurrowContractsCw1WhitelistSrcTypes13(l, v)
+}
+func tinyjsonF5cd6cf9DecodeGithubComJackalLabsBurrowContractsCw1WhitelistSrcTypes14(in *jlexer.Lexer, out *InitMsg) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "admins":
+			if in.IsNull() {
+				in.Skip()
+				out.Admins = nil
+			} else {
+				in.Delim('[')
+				if out.Admins == nil {
+					if !in.IsDelim(']') {
+						out.Admins = make([]string, 0, 4)
+					} else {
+						out.Admins = []string{}
+					}
+				} else {
+					out.Admins = (out.Admins)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v7 string
+					v7 = string(in.String())
+					out.Admins = append(out.Admins, v7)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "mutable":
+			out.Mutable = bool(in.Bool())
+		case "guardians":
+			if in.IsNull() {
+				in.Skip()
+				out.Guardians = nil
+			} else {
+				if out.Guardians == nil {
+					out.Guardians = new(GuardianConfig)
+				}
+				(*out.Guardians).UnmarshalTinyJSON(in)
+			}
+		case "inheritance":
+			if in.IsNull() {
+				in.Skip()
+				out.Inheritance = nil
+			} else {
+				if out.Inheritance == nil {
+					out.Inheritance = new(InheritanceConfig)
+				}
+				(*out.Inheritance).UnmarshalTinyJSON(in)
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func tinyjsonF5cd6cf9EncodeGithubComJackalLabsBurrowContractsCw1WhitelistSrcTypes14(out *jwriter.Writer, in InitMsg) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"admins\":"
+		out.RawString(prefix[1:])
+		if in.Admins == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v8, v9 := range in.Admins {
+				if v8 > 0 {
+					out.RawByte(',')
+				}
+				out.String(string(v9))
+			}
+			out.RawByte(']')
+		}
+	}
+	{
+		const prefix string = ",\"mutable\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.Mutable))
+	}
+	if in.Guardians != nil {
+		const prefix string = ",\"guardians\":"
+		out.RawString(prefix)
+		(*in.Guardians).MarshalTinyJSON(out)
+	}
+	if in.Inheritance != nil {
+		const prefix string = ",\"inheritance\":"
+		out.RawString(prefix)
+		(*in.Inheritance).MarshalTinyJSON(out)
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v InitMsg) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	tinyjsonF5cd6cf9EncodeGithubComJackalLabsBurrowContractsCw1WhitelistSrcTypes14(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalTinyJSON supports tinyjson.Marshaler interface
+func (v InitMsg) MarshalTinyJSON(w *jwriter.Writer) {
+	tinyjsonF5cd6cf9EncodeGithubComJackalLabsBurrowContractsCw1WhitelistSrcTypes14(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *InitMsg) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	tinyjsonF5cd6cf9DecodeGithubComJackalLabsBurrowContractsCw1WhitelistSrcTypes14(&r, v)
+	return r.Error()
+}
+
+// UnmarshalTinyJSON supports tinyjson.Unmarshaler interface
+func (v *InitMsg) UnmarshalTinyJSON(l *jlexer.Lexer) {
+	tinyjsonF5cd6cf9DecodeGithubComJackalLabsBurrowContractsCw1WhitelistSrcTypes14(l, v)
+}
+func tinyjsonF5cd6cf9DecodeGithubComJackalLabsBurrowContractsCw1WhitelistSrcTypes15(in *jlexer.Lexer, out *InheritanceResponse) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "inheritance":
+			if in.IsNull() {
+				in.Skip()
+				out.Inheritance = nil
+			} else {
+				if out.Inheritance == nil {
+					out.Inheritance = new(InheritanceConfig)
+				}
+				(*out.Inheritance).UnmarshalTinyJSON(in)
+			}
+		case "last_activity":
+			(out.LastActivity).UnmarshalTinyJSON(in)
+		case "claimable":
+			out.Claimable = bool(in.Bool())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func tinyjsonF5cd6cf9EncodeGithubComJackalLabsBurrowContractsCw1WhitelistSrcTypes15(out *jwriter.Writer, in InheritanceResponse) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	if in.Inheritance != nil {
+		const prefix string = ",\"inheritance\":"
+		first = false
+		out.RawString(prefix[1:])
+		(*in.Inheritance).MarshalTinyJSON(out)
+	}
+	{
+		const prefix string = ",\"last_activity\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		(in.LastActivity).MarshalTinyJSON(out)
+	}
+	{
+		const prefix string = ",\"claimable\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.Claimable))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v InheritanceResponse) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	tinyjsonF5cd6cf9EncodeGithubComJackalLabsBurrowContractsCw1WhitelistSrcTypes15(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalTinyJSON supports tinyjson.Marshaler interface
+func (v InheritanceResponse) MarshalTinyJSON(w *jwriter.Writer) {
+	tinyjsonF5cd6cf9EncodeGithubComJackalLabsBurrowContractsCw1WhitelistSrcTypes15(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *InheritanceResponse) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	tinyjsonF5cd6cf9DecodeGithubComJackalLabsBurrowContractsCw1WhitelistSrcTypes15(&r, v)
+	return r.Error()
+}
+
+// UnmarshalTinyJSON supports tinyjson.Unmarshaler interface
+func (v *InheritanceResponse) UnmarshalTinyJSON(l *jlexer.Lexer) {
+	tinyjsonF5cd6cf9DecodeGithubComJackalLabsBurrowContractsCw1WhitelistSrcTypes15(l, v)
+}
+func tinyjsonF5cd6cf9DecodeGithubComJackalLabsBurrowContractsCw1WhitelistSrcTypes16(in *jlexer.Lexer, out *HeartbeatRequest) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func tinyjsonF5cd6cf9EncodeGithubComJackalLabsBurrowContractsCw1WhitelistSrcTypes16(out *jwriter.Writer, in HeartbeatRequest) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v HeartbeatRequest) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	tinyjsonF5cd6cf9EncodeGithubComJackalLabsBurrowContractsCw1WhitelistSrcTypes16(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalTinyJSON supports tinyjson.Marshaler interface
+func (v HeartbeatRequest) MarshalTinyJSON(w *jwriter.Writer) {
+	tinyjsonF5cd6cf9EncodeGithubComJackalLabsBurrowContractsCw1WhitelistSrcTypes16(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *HeartbeatRequest) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	tinyjsonF5cd6cf9DecodeGithubComJackalLabsBurrowContractsCw1WhitelistSrcTypes16(&r, v)
+	return r.Error()
+}
+
+// UnmarshalTinyJSON supports tinyjson.Unmarshaler interface
+func (v *HeartbeatRequest) UnmarshalTinyJSON(l *jlexer.Lexer) {
+	tinyjsonF5cd6cf9DecodeGithubComJackalLabsBurrowContractsCw1WhitelistSrcTypes16(l, v)
+}
+func tinyjsonF5cd6cf9DecodeGithubComJackalLabsBurrowContractsCw1WhitelistSrcTypes17(in *jlexer.Lexer, out *GuardiansResponse) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "guardians":
+			if in.IsNull() {
+				in.Skip()
+				out.Guardians = nil
+			} else {
+				if out.Guardians == nil {
+					out.Guardians = new(GuardianConfig)
+				}
+				(*out.Guardians).UnmarshalTinyJSON(in)
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func tinyjsonF5cd6cf9EncodeGithubComJackalLabsBurrowContractsCw1WhitelistSrcTypes17(out *jwriter.Writer, in GuardiansResponse) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	if in.Guardians != nil {
+		const prefix string = ",\"guardians\":"
+		first = false
+		out.RawString(prefix[1:])
+		(*in.Guardians).MarshalTinyJSON(out)
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v GuardiansResponse) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	tinyjsonF5cd6cf9EncodeGithubComJackalLabsBurrowContractsCw1WhitelistSrcTypes17(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalTinyJSON supports tinyjson.Marshaler interface
+func (v GuardiansResponse) MarshalTinyJSON(w *jwriter.Writer) {
+	tinyjsonF5cd6cf9EncodeGithubComJackalLabsBurrowContractsCw1WhitelistSrcTypes17(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *GuardiansResponse) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	tinyjsonF5cd6cf9DecodeGithubComJackalLabsBurrowContractsCw1WhitelistSrcTypes17(&r, v)
+	return r.Error()
+}
+
+// UnmarshalTinyJSON supports tinyjson.Unmarshaler interface
+func (v *GuardiansResponse) UnmarshalTinyJSON(l *jlexer.Lexer) {
+	tinyjsonF5cd6cf9DecodeGithubComJackalLabsBurrowContractsCw1WhitelistSrcTypes17(l, v)
+}
+func tinyjsonF5cd6cf9DecodeGithubComJackalLabsBurrowContractsCw1WhitelistSrcTypes18(in *jlexer.Lexer, out *FreezeRequest) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func tinyjsonF5cd6cf9EncodeGithubComJackalLabsBurrowContractsCw1WhitelistSrcTypes18(out *jwriter.Writer, in FreezeRequest) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v FreezeRequest) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	tinyjsonF5cd6cf9EncodeGithubComJackalLabsBurrowContractsCw1WhitelistSrcTypes18(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalTinyJSON supports tinyjson.Marshaler interface
+func (v FreezeRequest) MarshalTinyJSON(w *jwriter.Writer) {
+	tinyjsonF5cd6cf9EncodeGithubComJackalLabsBurrowContractsCw1WhitelistSrcTypes18(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *FreezeRequest) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	tinyjsonF5cd6cf9DecodeGithubComJackalLabsBurrowContractsCw1WhitelistSrcTypes18(&r, v)
+	return r.Error()
+}
+
+// UnmarshalTinyJSON supports tinyjson.Unmarshaler interface
+func (v *FreezeRequest) UnmarshalTinyJSON(l *jlexer.Lexer) {
+	tinyjsonF5cd6cf9DecodeGithubComJackalLabsBurrowContractsCw1WhitelistSrcTypes18(l, v)
+}
+func tinyjsonF5cd6cf9DecodeGithubComJackalLabsBurrowContractsCw1WhitelistSrcTypes19(in *jlexer.Lexer, out *ExecuteRequest) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "msgs":
+			if in.IsNull() {
+				in.Skip()
+				out.Msgs = nil
+			} else {
+				in.Delim('[')
+				if out.Msgs == nil {
+					if !in.IsDelim(']') {
+						out.Msgs = make([]types.CosmosMsg, 0, 0)
+					} else {
+						out.Msgs = []types.CosmosMsg{}
+					}
+				} else {
+					out.Msgs = (out.Msgs)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v10 types.CosmosMsg
+					(v10).UnmarshalTinyJSON(in)
+					out.Msgs = append(out.Msgs, v10)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func tinyjsonF5cd6cf9EncodeGithubComJackalLabsBurrowContractsCw1WhitelistSrcTypes19(out *jwriter.Writer, in ExecuteRequest) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	if len(in.Msgs) != 0 {
+		const prefix string = ",\"msgs\":"
+		first = false
+		out.RawString(prefix[1:])
+		{
+			out.RawByte('[')
+			for v11, v12 := range in.Msgs {
+				if v11 > 0 {
+					out.RawByte(',')
+				}
+				(v12).MarshalTinyJSON(out)
+			}
+			out.RawByte(']')
+		}
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v ExecuteRequest) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	tinyjsonF5cd6cf9EncodeGithubComJackalLabsBurrowContractsCw1WhitelistSrcTypes19(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalTinyJSON supports tinyjson.Marshaler interface
+func (v ExecuteRequest) MarshalTinyJSON(w *jwriter.Writer) {
+	tinyjsonF5cd6cf9EncodeGithubComJackalLabsBurrowContractsCw1WhitelistSrcTypes19(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *ExecuteRequest) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	tinyjsonF5cd6cf9DecodeGithubComJackalLabsBurrowContractsCw1WhitelistSrcTypes19(&r, v)
+	return r.Error()
+}
+
+// UnmarshalTinyJSON supports tinyjson.Unmarshaler interface
+func (v *ExecuteRequest) UnmarshalTinyJSON(l *jlexer.Lexer) {
+	tinyjsonF5cd6cf9DecodeGithubComJackalLabsBurrowContractsCw1WhitelistSrcTypes19(l, v)
+}
+func tinyjsonF5cd6cf9DecodeGithubComJackalLabsBurrowContractsCw1WhitelistSrcTypes20(in *jlexer.Lexer, out *ExecuteRecoveryRequest) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func tinyjsonF5cd6cf9EncodeGithubComJackalLabsBurrowContractsCw1WhitelistSrcTypes20(out *jwriter.Writer, in ExecuteRecoveryRequest) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v ExecuteRecoveryRequest) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	tinyjsonF5cd6cf9EncodeGithubComJackalLabsBurrowContractsCw1WhitelistSrcTypes20(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalTinyJSON supports tinyjson.Marshaler interface
+func (v ExecuteRecoveryRequest) MarshalTinyJSON(w *jwriter.Writer) {
+	tinyjsonF5cd6cf9EncodeGithubComJackalLabsBurrowContractsCw1WhitelistSrcTypes20(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
-func (v *ExecuteRequest) UnmarshalJSON(data []byte) error {
+func (v *ExecuteRecoveryRequest) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	tinyjsonF5cd6cf9DecodeGithubComJackalLabsBurrowContractsCw1WhitelistSrcTypes7(&r, v)
+	tinyjsonF5cd6cf9DecodeGithubComJackalLabsBurrowContractsCw1WhitelistSrcTypes20(&r, v)
 	return r.Error()
 }
 
 // UnmarshalTinyJSON supports tinyjson.Unmarshaler interface
-func (v *ExecuteRequest) UnmarshalTinyJSON(l *jlexer.Lexer) {
-	tinyjsonF5cd6cf9DecodeGithubComJackalLabsBurrowContractsCw1WhitelistSrcTypes7(l, v)
+func (v *ExecuteRecoveryRequest) UnmarshalTinyJSON(l *jlexer.Lexer) {
+	tinyjsonF5cd6cf9DecodeGithubComJackalLabsBurrowContractsCw1WhitelistSrcTypes20(l, v)
 }
-func tinyjsonF5cd6cf9DecodeGithubComJackalLabsBurrowContractsCw1WhitelistSrcTypes8(in *jlexer.Lexer, out *ExecuteMsg) {
+func tinyjsonF5cd6cf9DecodeGithubComJackalLabsBurrowContractsCw1WhitelistSrcTypes21(in *jlexer.Lexer, out *ExecuteMsg) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -703,6 +1715,96 @@ func tinyjsonF5cd6cf9DecodeGithubComJackalLabsBurrowContractsCw1WhitelistSrcType
 				}
 				(*out.UpdateAdminsRequest).UnmarshalTinyJSON(in)
 			}
+		case "update_guardians":
+			if in.IsNull() {
+				in.Skip()
+				out.UpdateGuardiansRequest = nil
+			} else {
+				if out.UpdateGuardiansRequest == nil {
+					out.UpdateGuardiansRequest = new(UpdateGuardiansRequest)
+				}
+				(*out.UpdateGuardiansRequest).UnmarshalTinyJSON(in)
+			}
+		case "propose_recovery":
+			if in.IsNull() {
+				in.Skip()
+				out.ProposeRecoveryRequest = nil
+			} else {
+				if out.ProposeRecoveryRequest == nil {
+					out.ProposeRecoveryRequest = new(ProposeRecoveryRequest)
+				}
+				(*out.ProposeRecoveryRequest).UnmarshalTinyJSON(in)
+			}
+		case "approve_recovery":
+			if in.IsNull() {
+				in.Skip()
+				out.ApproveRecoveryRequest = nil
+			} else {
+				if out.ApproveRecoveryRequest == nil {
+					out.ApproveRecoveryRequest = new(ApproveRecoveryRequest)
+				}
+				(*out.ApproveRecoveryRequest).UnmarshalTinyJSON(in)
+			}
+		case "veto_recovery":
+			if in.IsNull() {
+				in.Skip()
+				out.VetoRecoveryRequest = nil
+			} else {
+				if out.VetoRecoveryRequest == nil {
+					out.VetoRecoveryRequest = new(VetoRecoveryRequest)
+				}
+				(*out.VetoRecoveryRequest).UnmarshalTinyJSON(in)
+			}
+		case "execute_recovery":
+			if in.IsNull() {
+				in.Skip()
+				out.ExecuteRecoveryRequest = nil
+			} else {
+				if out.ExecuteRecoveryRequest == nil {
+					out.ExecuteRecoveryRequest = new(ExecuteRecoveryRequest)
+				}
+				(*out.ExecuteRecoveryRequest).UnmarshalTinyJSON(in)
+			}
+		case "heartbeat":
+			if in.IsNull() {
+				in.Skip()
+				out.HeartbeatRequest = nil
+			} else {
+				if out.HeartbeatRequest == nil {
+					out.HeartbeatRequest = new(HeartbeatRequest)
+				}
+				(*out.HeartbeatRequest).UnmarshalTinyJSON(in)
+			}
+		case "set_inheritance":
+			if in.IsNull() {
+				in.Skip()
+				out.SetInheritanceRequest = nil
+			} else {
+				if out.SetInheritanceRequest == nil {
+					out.SetInheritanceRequest = new(SetInheritanceRequest)
+				}
+				(*out.SetInheritanceRequest).UnmarshalTinyJSON(in)
+			}
+		case "claim_inheritance":
+			if in.IsNull() {
+				in.Skip()
+				out.ClaimInheritanceRequest = nil
+			} else {
+				if out.ClaimInheritanceRequest == nil {
+					out.ClaimInheritanceRequest = new(ClaimInheritanceRequest)
+				}
+				(*out.ClaimInheritanceRequest).UnmarshalTinyJSON(in)
+			}
+		case "sweep_inheritance":
+			if in.IsNull() {
+				in.Skip()
+				out.SweepInheritanceRequest = nil
+			} else {
+				if out.SweepInheritanceRequest == nil {
+					out.SweepInheritanceRequest = new(SweepInheritanceRequest)
+				}
+				(*out.SweepInheritanceRequest).UnmarshalTinyJSON(in)
+			}
 		default:
 			in.SkipRecursive()
 		}
@@ -713,7 +1815,7 @@ func tinyjsonF5cd6cf9DecodeGithubComJackalLabsBurrowContractsCw1WhitelistSrcType
 		in.Consumed()
 	}
 }
-func tinyjsonF5cd6cf9EncodeGithubComJackalLabsBurrowContractsCw1WhitelistSrcTypes8(out *jwriter.Writer, in ExecuteMsg) {
+func tinyjsonF5cd6cf9EncodeGithubComJackalLabsBurrowContractsCw1WhitelistSrcTypes21(out *jwriter.Writer, in ExecuteMsg) {
 	out.RawByte('{')
 	first := true
 	_ = first
@@ -743,33 +1845,182 @@ func tinyjsonF5cd6cf9EncodeGithubComJackalLabsBurrowContractsCw1WhitelistSrcType
 		}
 		(*in.UpdateAdminsRequest).MarshalTinyJSON(out)
 	}
+	if in.UpdateGuardiansRequest != nil {
+		const prefix string = ",\"update_guardians\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		(*in.UpdateGuardiansRequest).MarshalTinyJSON(out)
+	}
+	if in.ProposeRecoveryRequest != nil {
+		const prefix string = ",\"propose_recovery\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		(*in.ProposeRecoveryRequest).MarshalTinyJSON(out)
+	}
+	if in.ApproveRecoveryRequest != nil {
+		const prefix string = ",\"approve_recovery\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		(*in.ApproveRecoveryRequest).MarshalTinyJSON(out)
+	}
+	if in.VetoRecoveryRequest != nil {
+		const prefix string = ",\"veto_recovery\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		(*in.VetoRecoveryRequest).MarshalTinyJSON(out)
+	}
+	if in.ExecuteRecoveryRequest != nil {
+		const prefix string = ",\"execute_recovery\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		(*in.ExecuteRecoveryRequest).MarshalTinyJSON(out)
+	}
+	if in.HeartbeatRequest != nil {
+		const prefix string = ",\"heartbeat\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		(*in.HeartbeatRequest).MarshalTinyJSON(out)
+	}
+	if in.SetInheritanceRequest != nil {
+		const prefix string = ",\"set_inheritance\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		(*in.SetInheritanceRequest).MarshalTinyJSON(out)
+	}
+	if in.ClaimInheritanceRequest != nil {
+		const prefix string = ",\"claim_inheritance\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		(*in.ClaimInheritanceRequest).MarshalTinyJSON(out)
+	}
+	if in.SweepInheritanceRequest != nil {
+		const prefix string = ",\"sweep_inheritance\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		(*in.SweepInheritanceRequest).MarshalTinyJSON(out)
+	}
 	out.RawByte('}')
 }
 
 // MarshalJSON supports json.Marshaler interface
 func (v ExecuteMsg) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	tinyjsonF5cd6cf9EncodeGithubComJackalLabsBurrowContractsCw1WhitelistSrcTypes8(&w, v)
+	tinyjsonF5cd6cf9EncodeGithubComJackalLabsBurrowContractsCw1WhitelistSrcTypes21(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalTinyJSON supports tinyjson.Marshaler interface
 func (v ExecuteMsg) MarshalTinyJSON(w *jwriter.Writer) {
-	tinyjsonF5cd6cf9EncodeGithubComJackalLabsBurrowContractsCw1WhitelistSrcTypes8(w, v)
+	tinyjsonF5cd6cf9EncodeGithubComJackalLabsBurrowContractsCw1WhitelistSrcTypes21(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
 func (v *ExecuteMsg) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	tinyjsonF5cd6cf9DecodeGithubComJackalLabsBurrowContractsCw1WhitelistSrcTypes8(&r, v)
+	tinyjsonF5cd6cf9DecodeGithubComJackalLabsBurrowContractsCw1WhitelistSrcTypes21(&r, v)
 	return r.Error()
 }
 
 // UnmarshalTinyJSON supports tinyjson.Unmarshaler interface
 func (v *ExecuteMsg) UnmarshalTinyJSON(l *jlexer.Lexer) {
-	tinyjsonF5cd6cf9DecodeGithubComJackalLabsBurrowContractsCw1WhitelistSrcTypes8(l, v)
+	tinyjsonF5cd6cf9DecodeGithubComJackalLabsBurrowContractsCw1WhitelistSrcTypes21(l, v)
+}
+func tinyjsonF5cd6cf9DecodeGithubComJackalLabsBurrowContractsCw1WhitelistSrcTypes22(in *jlexer.Lexer, out *ClaimInheritanceRequest) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func tinyjsonF5cd6cf9EncodeGithubComJackalLabsBurrowContractsCw1WhitelistSrcTypes22(out *jwriter.Writer, in ClaimInheritanceRequest) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v ClaimInheritanceRequest) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	tinyjsonF5cd6cf9EncodeGithubComJackalLabsBurrowContractsCw1WhitelistSrcTypes22(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalTinyJSON supports tinyjson.Marshaler interface
+func (v ClaimInheritanceRequest) MarshalTinyJSON(w *jwriter.Writer) {
+	tinyjsonF5cd6cf9EncodeGithubComJackalLabsBurrowContractsCw1WhitelistSrcTypes22(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *ClaimInheritanceRequest) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	tinyjsonF5cd6cf9DecodeGithubComJackalLabsBurrowContractsCw1WhitelistSrcTypes22(&r, v)
+	return r.Error()
 }
-func tinyjsonF5cd6cf9DecodeGithubComJackalLabsBurrowContractsCw1WhitelistSrcTypes9(in *jlexer.Lexer, out *CanExecuteResponse) {
+
+// UnmarshalTinyJSON supports tinyjson.Unmarshaler interface
+func (v *ClaimInheritanceRequest) UnmarshalTinyJSON(l *jlexer.Lexer) {
+	tinyjsonF5cd6cf9DecodeGithubComJackalLabsBurrowContractsCw1WhitelistSrcTypes22(l, v)
+}
+func tinyjsonF5cd6cf9DecodeGithubComJackalLabsBurrowContractsCw1WhitelistSrcTypes23(in *jlexer.Lexer, out *CanExecuteResponse) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -800,7 +2051,7 @@ func tinyjsonF5cd6cf9DecodeGithubComJackalLabsBurrowContractsCw1WhitelistSrcType
 		in.Consumed()
 	}
 }
-func tinyjsonF5cd6cf9EncodeGithubComJackalLabsBurrowContractsCw1WhitelistSrcTypes9(out *jwriter.Writer, in CanExecuteResponse) {
+func tinyjsonF5cd6cf9EncodeGithubComJackalLabsBurrowContractsCw1WhitelistSrcTypes23(out *jwriter.Writer, in CanExecuteResponse) {
 	out.RawByte('{')
 	first := true
 	_ = first
@@ -815,27 +2066,93 @@ func tinyjsonF5cd6cf9EncodeGithubComJackalLabsBurrowContractsCw1WhitelistSrcType
 // MarshalJSON supports json.Marshaler interface
 func (v CanExecuteResponse) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	tinyjsonF5cd6cf9EncodeGithubComJackalLabsBurrowContractsCw1WhitelistSrcTypes9(&w, v)
+	tinyjsonF5cd6cf9EncodeGithubComJackalLabsBurrowContractsCw1WhitelistSrcTypes23(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalTinyJSON supports tinyjson.Marshaler interface
 func (v CanExecuteResponse) MarshalTinyJSON(w *jwriter.Writer) {
-	tinyjsonF5cd6cf9EncodeGithubComJackalLabsBurrowContractsCw1WhitelistSrcTypes9(w, v)
+	tinyjsonF5cd6cf9EncodeGithubComJackalLabsBurrowContractsCw1WhitelistSrcTypes23(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
 func (v *CanExecuteResponse) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	tinyjsonF5cd6cf9DecodeGithubComJackalLabsBurrowContractsCw1WhitelistSrcTypes9(&r, v)
+	tinyjsonF5cd6cf9DecodeGithubComJackalLabsBurrowContractsCw1WhitelistSrcTypes23(&r, v)
 	return r.Error()
 }
 
 // UnmarshalTinyJSON supports tinyjson.Unmarshaler interface
 func (v *CanExecuteResponse) UnmarshalTinyJSON(l *jlexer.Lexer) {
-	tinyjsonF5cd6cf9DecodeGithubComJackalLabsBurrowContractsCw1WhitelistSrcTypes9(l, v)
+	tinyjsonF5cd6cf9DecodeGithubComJackalLabsBurrowContractsCw1WhitelistSrcTypes23(l, v)
+}
+func tinyjsonF5cd6cf9DecodeGithubComJackalLabsBurrowContractsCw1WhitelistSrcTypes24(in *jlexer.Lexer, out *ApproveRecoveryRequest) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "id":
+			out.ID = uint64(in.Uint64())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func tinyjsonF5cd6cf9EncodeGithubComJackalLabsBurrowContractsCw1WhitelistSrcTypes24(out *jwriter.Writer, in ApproveRecoveryRequest) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"id\":"
+		out.RawString(prefix[1:])
+		out.Uint64(uint64(in.ID))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v ApproveRecoveryRequest) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	tinyjsonF5cd6cf9EncodeGithubComJackalLabsBurrowContractsCw1WhitelistSrcTypes24(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
 }
-func tinyjsonF5cd6cf9DecodeGithubComJackalLabsBurrowContractsCw1WhitelistSrcTypes10(in *jlexer.Lexer, out *AdminListResponse) {
+
+// MarshalTinyJSON supports tinyjson.Marshaler interface
+func (v ApproveRecoveryRequest) MarshalTinyJSON(w *jwriter.Writer) {
+	tinyjsonF5cd6cf9EncodeGithubComJackalLabsBurrowContractsCw1WhitelistSrcTypes24(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *ApproveRecoveryRequest) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	tinyjsonF5cd6cf9DecodeGithubComJackalLabsBurrowContractsCw1WhitelistSrcTypes24(&r, v)
+	return r.Error()
+}
+
+// UnmarshalTinyJSON supports tinyjson.Unmarshaler interface
+func (v *ApproveRecoveryRequest) UnmarshalTinyJSON(l *jlexer.Lexer) {
+	tinyjsonF5cd6cf9DecodeGithubComJackalLabsBurrowContractsCw1WhitelistSrcTypes24(l, v)
+}
+func tinyjsonF5cd6cf9DecodeGithubComJackalLabsBurrowContractsCw1WhitelistSrcTypes25(in *jlexer.Lexer, out *AdminListResponse) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -870,9 +2187,9 @@ func tinyjsonF5cd6cf9DecodeGithubComJackalLabsBurrowContractsCw1WhitelistSrcType
 					out.Admins = (out.Admins)[:0]
 				}
 				for !in.IsDelim(']') {
-					var v10 string
-					v10 = string(in.String())
-					out.Admins = append(out.Admins, v10)
+					var v13 string
+					v13 = string(in.String())
+					out.Admins = append(out.Admins, v13)
 					in.WantComma()
 				}
 				in.Delim(']')
@@ -889,7 +2206,7 @@ func tinyjsonF5cd6cf9DecodeGithubComJackalLabsBurrowContractsCw1WhitelistSrcType
 		in.Consumed()
 	}
 }
-func tinyjsonF5cd6cf9EncodeGithubComJackalLabsBurrowContractsCw1WhitelistSrcTypes10(out *jwriter.Writer, in AdminListResponse) {
+func tinyjsonF5cd6cf9EncodeGithubComJackalLabsBurrowContractsCw1WhitelistSrcTypes25(out *jwriter.Writer, in AdminListResponse) {
 	out.RawByte('{')
 	first := true
 	_ = first
@@ -900,11 +2217,11 @@ func tinyjsonF5cd6cf9EncodeGithubComJackalLabsBurrowContractsCw1WhitelistSrcType
 			out.RawString("null")
 		} else {
 			out.RawByte('[')
-			for v11, v12 := range in.Admins {
-				if v11 > 0 {
+			for v14, v15 := range in.Admins {
+				if v14 > 0 {
 					out.RawByte(',')
 				}
-				out.String(string(v12))
+				out.String(string(v15))
 			}
 			out.RawByte(']')
 		}
@@ -920,23 +2237,23 @@ func tinyjsonF5cd6cf9EncodeGithubComJackalLabsBurrowContractsCw1WhitelistSrcType
 // MarshalJSON supports json.Marshaler interface
 func (v AdminListResponse) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	tinyjsonF5cd6cf9EncodeGithubComJackalLabsBurrowContractsCw1WhitelistSrcTypes10(&w, v)
+	tinyjsonF5cd6cf9EncodeGithubComJackalLabsBurrowContractsCw1WhitelistSrcTypes25(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalTinyJSON supports tinyjson.Marshaler interface
 func (v AdminListResponse) MarshalTinyJSON(w *jwriter.Writer) {
-	tinyjsonF5cd6cf9EncodeGithubComJackalLabsBurrowContractsCw1WhitelistSrcTypes10(w, v)
+	tinyjsonF5cd6cf9EncodeGithubComJackalLabsBurrowContractsCw1WhitelistSrcTypes25(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
 func (v *AdminListResponse) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	tinyjsonF5cd6cf9DecodeGithubComJackalLabsBurrowContractsCw1WhitelistSrcTypes10(&r, v)
+	tinyjsonF5cd6cf9DecodeGithubComJackalLabsBurrowContractsCw1WhitelistSrcTypes25(&r, v)
 	return r.Error()
 }
 
 // UnmarshalTinyJSON supports tinyjson.Unmarshaler interface
 func (v *AdminListResponse) UnmarshalTinyJSON(l *jlexer.Lexer) {
-	tinyjsonF5cd6cf9DecodeGithubComJackalLabsBurrowContractsCw1WhitelistSrcTypes10(l, v)
+	tinyjsonF5cd6cf9DecodeGithubComJackalLabsBurrowContractsCw1WhitelistSrcTypes25(l, v)
 }
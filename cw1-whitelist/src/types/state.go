@@ -1,6 +1,12 @@
 package types
 
-import "slices"
+import (
+	"errors"
+	"math/bits"
+	"slices"
+
+	"github.com/CosmWasm/cosmwasm-go/std/types"
+)
 
 type AdminList struct {
 	Admins  []string `json:"admins"`
@@ -15,3 +21,130 @@ func (a AdminList) IsAdmin(addr string) bool {
 func (a AdminList) CanModify(addr string) bool {
 	return a.IsAdmin(addr) && a.Mutable
 }
+
+// Duration is a span of either blocks or seconds, never both.
+type Duration struct {
+	Height uint64 `json:"height,omitempty"`
+	Time   uint64 `json:"time,omitempty"`
+}
+
+// Validate checks that exactly one of Height or Time is set.
+func (d Duration) Validate() error {
+	switch {
+	case d.Height != 0 && d.Time != 0:
+		return errors.New("Duration cannot be both height and time")
+	case d.Height == 0 && d.Time == 0:
+		return errors.New("Duration cannot be empty")
+	default:
+		return nil
+	}
+}
+
+// Elapsed checks if the duration has passed between the since and now blocks.
+// A duration ending past the last height or timestamp never elapses
+func (d Duration) Elapsed(since types.BlockInfo, now types.BlockInfo) bool {
+	switch {
+	case d.Height != 0:
+		end, carry := bits.Add64(since.Height, d.Height, 0)
+		return carry == 0 && now.Height >= end
+	case d.Time != 0:
+		hi, nanos := bits.Mul64(d.Time, 1_000_000_000)
+		end, carry := bits.Add64(since.Time, nanos, 0)
+		return hi == 0 && carry == 0 && now.Time >= end
+	default:
+		return true
+	}
+}
+
+// GuardianConfig holds the addresses able to recover the admin list
+type GuardianConfig struct {
+	Guardians []string `json:"guardians"`
+	/// Threshold is the number of guardian approvals needed to replace the admins
+	Threshold uint32 `json:"threshold"`
+	/// ApprovalWindow is how long guardians have to reach the threshold once a recovery is proposed
+	ApprovalWindow Duration `json:"approval_window"`
+	/// VetoPeriod is how long admins have to veto a recovery after it reached the threshold
+	VetoPeriod Duration `json:"veto_period"`
+}
+
+func (g GuardianConfig) IsGuardian(addr string) bool {
+	return slices.Contains(g.Guardians, addr)
+}
+
+// Validate checks the threshold is reachable and both durations are set
+func (g GuardianConfig) Validate() error {
+	if len(g.Guardians) == 0 {
+		return errors.New("Guardian list cannot be empty")
+	}
+
+	for i, guardian := range g.Guardians {
+		if slices.Contains(g.Guardians[i+1:], guardian) {
+			return errors.New("Duplicate guardian " + guardian)
+		}
+	}
+
+	if g.Threshold == 0 || int(g.Threshold) > len(g.Guardians) {
+		return errors.New("Invalid guardian threshold")
+	}
+
+	if err := g.ApprovalWindow.Validate(); err != nil {
+		return err
+	}
+
+	return g.VetoPeriod.Validate()
+}
+
+const (
+	RecoveryPending  = "pending"
+	RecoveryVetoed   = "vetoed"
+	RecoveryExecuted = "executed"
+)
+
+// RecoveryProposal is a guardian request to replace the admin list
+type RecoveryProposal struct {
+	ID         uint64           `json:"id"`
+	Admins     []string         `json:"admins"`
+	Approvals  []string         `json:"approvals"`
+	Status     string           `json:"status"`
+	ProposedAt types.BlockInfo  `json:"proposed_at"`
+	ApprovedAt *types.BlockInfo `json:"approved_at,omitempty"`
+}
+
+// IsOpen checks if the proposal can still be approved, vetoed or executed
+func (p RecoveryProposal) IsOpen(config GuardianConfig, now types.BlockInfo) bool {
+	if p.Status != RecoveryPending {
+		return false
+	}
+
+	return p.ApprovedAt != nil || !config.ApprovalWindow.Elapsed(p.ProposedAt, now)
+}
+
+// CanExecute checks if the proposal reached the threshold and survived the veto period
+func (p RecoveryProposal) CanExecute(config GuardianConfig, now types.BlockInfo) bool {
+	if p.Status != RecoveryPending || p.ApprovedAt == nil {
+		return false
+	}
+
+	return config.VetoPeriod.Elapsed(*p.ApprovedAt, now)
+}
+
+// InheritanceConfig names who can take over the proxy once the admins stop acting
+type InheritanceConfig struct {
+	Beneficiary string `json:"beneficiary"`
+	/// Inactivity is how long the admins must stay idle before the beneficiary can claim
+	Inactivity Duration `json:"inactivity"`
+}
+
+// Validate checks that a beneficiary and an inactivity period are set
+func (i InheritanceConfig) Validate() error {
+	if i.Beneficiary == "" {
+		return errors.New("Beneficiary cannot be empty")
+	}
+
+	return i.Inactivity.Validate()
+}
+
+// IsClaimable checks if the admins have been idle for the whole inactivity period
+func (i InheritanceConfig) IsClaimable(lastActivity types.BlockInfo, now types.BlockInfo) bool {
+	return i.Inactivity.Elapsed(lastActivity, now)
+}
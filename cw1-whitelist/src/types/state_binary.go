@@ -0,0 +1,43 @@
+package types
+
+import (
+	"github.com/JackalLabs/burrow-contracts/storage"
+)
+
+// binary codecs of the stored state. Values start with the version of their layout,
+// a changed layout bumps it and keeps decoding the older ones.
+// Version 0 is the layout written before values had a version, which is version 1 without the version byte
+
+const adminListVersion = 1
+
+func (a AdminList) MarshalBinary() ([]byte, error) {
+	var enc storage.Encoder
+	enc.Version(adminListVersion)
+	enc.Uint64(uint64(len(a.Admins)))
+	for _, admin := range a.Admins {
+		enc.String(admin)
+	}
+	enc.Bool(a.Mutable)
+	return enc.Data(), nil
+}
+
+func (a *AdminList) UnmarshalBinary(data []byte) error {
+	dec := storage.NewDecoder(data)
+	dec.Version(adminListVersion)
+	a.decodeBinary(dec)
+	return dec.Finish()
+}
+
+func (a *AdminList) UnmarshalUnversionedBinary(data []byte) error {
+	dec := storage.NewDecoder(data)
+	a.decodeBinary(dec)
+	return dec.Finish()
+}
+
+func (a *AdminList) decodeBinary(dec *storage.Decoder) {
+	a.Admins = make([]string, dec.Len())
+	for i := range a.Admins {
+		a.Admins[i] = dec.String()
+	}
+	a.Mutable = dec.Bool()
+}
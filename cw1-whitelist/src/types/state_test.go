@@ -1,7 +1,11 @@
 package types
 
 import (
+	"math"
+	"slices"
 	"testing"
+
+	"github.com/CosmWasm/cosmwasm-go/std/types"
 )
 
 type testCase struct {
@@ -79,3 +83,64 @@ func TestCanModify(t *testing.T) {
 		})
 	}
 }
+
+func TestDurationElapsed(t *testing.T) {
+	since := types.BlockInfo{Height: 100, Time: 1_000_000_000}
+	tt := []struct {
+		duration Duration
+		now      types.BlockInfo
+		expect   bool
+	}{
+		{Duration{Height: 10}, types.BlockInfo{Height: 109}, false},
+		{Duration{Height: 10}, types.BlockInfo{Height: 110}, true},
+		{Duration{Time: 10}, types.BlockInfo{Time: 10_999_999_999}, false},
+		{Duration{Time: 10}, types.BlockInfo{Time: 11_000_000_000}, true},
+		// durations past the last height or timestamp never elapse, rather than wrapping around
+		{Duration{Height: math.MaxUint64}, types.BlockInfo{Height: math.MaxUint64}, false},
+		{Duration{Time: math.MaxUint64 / 1_000_000_000}, types.BlockInfo{Time: math.MaxUint64}, false},
+		{Duration{Time: math.MaxUint64}, types.BlockInfo{Time: math.MaxUint64}, false},
+	}
+
+	for _, tc := range tt {
+		result := tc.duration.Elapsed(since, tc.now)
+		if result != tc.expect {
+			t.Errorf("%v elapsed at %v is %t", tc.duration, tc.now, result)
+		}
+	}
+}
+
+func TestAdminListBinary(t *testing.T) {
+	adminList := AdminList{Admins: []string{"alice", "bob"}, Mutable: true}
+
+	bz, err := adminList.MarshalBinary()
+	if err != nil {
+		t.Fatal(err)
+	}
+	// the layout is frozen, changing it needs a new version
+	if string(bz) != "\x01\x02\x05alice\x03bob\x01" {
+		t.Errorf("%q changed the layout", bz)
+	}
+
+	var decoded AdminList
+	err = decoded.UnmarshalBinary(bz)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !slices.Equal(adminList.Admins, decoded.Admins) || !decoded.Mutable {
+		t.Errorf("%v doesn't equal %v", decoded, adminList)
+	}
+
+	if decoded.UnmarshalBinary(bz[:len(bz)-2]) == nil {
+		t.Error("truncated admin list decoded")
+	}
+
+	// written before the layout had a version
+	decoded = AdminList{}
+	err = decoded.UnmarshalUnversionedBinary([]byte("\x02\x05alice\x03bob\x01"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !slices.Equal(adminList.Admins, decoded.Admins) || !decoded.Mutable {
+		t.Errorf("%v doesn't equal %v", decoded, adminList)
+	}
+}
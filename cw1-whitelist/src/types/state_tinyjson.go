@@ -3,6 +3,7 @@
 package types
 
 import (
+	types "github.com/CosmWasm/cosmwasm-go/std/types"
 	tinyjson "github.com/CosmWasm/tinyjson"
 	jlexer "github.com/CosmWasm/tinyjson/jlexer"
 	jwriter "github.com/CosmWasm/tinyjson/jwriter"
@@ -15,7 +16,7 @@ var (
 	_ tinyjson.Marshaler
 )
 
-func tinyjson4e1b3cc1DecodeGithubComJackalLabsBurrowContractsCw1WhitelistSrcTypes(in *jlexer.Lexer, out *AdminList) {
+func tinyjson4e1b3cc1DecodeGithubComJackalLabsBurrowContractsCw1WhitelistSrcTypes(in *jlexer.Lexer, out *RecoveryProposal) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -34,6 +35,8 @@ func tinyjson4e1b3cc1DecodeGithubComJackalLabsBurrowContractsCw1WhitelistSrcType
 			continue
 		}
 		switch key {
+		case "id":
+			out.ID = uint64(in.Uint64())
 		case "admins":
 			if in.IsNull() {
 				in.Skip()
@@ -57,6 +60,448 @@ func tinyjson4e1b3cc1DecodeGithubComJackalLabsBurrowContractsCw1WhitelistSrcType
 				}
 				in.Delim(']')
 			}
+		case "approvals":
+			if in.IsNull() {
+				in.Skip()
+				out.Approvals = nil
+			} else {
+				in.Delim('[')
+				if out.Approvals == nil {
+					if !in.IsDelim(']') {
+						out.Approvals = make([]string, 0, 4)
+					} else {
+						out.Approvals = []string{}
+					}
+				} else {
+					out.Approvals = (out.Approvals)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v2 string
+					v2 = string(in.String())
+					out.Approvals = append(out.Approvals, v2)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "status":
+			out.Status = string(in.String())
+		case "proposed_at":
+			(out.ProposedAt).UnmarshalTinyJSON(in)
+		case "approved_at":
+			if in.IsNull() {
+				in.Skip()
+				out.ApprovedAt = nil
+			} else {
+				if out.ApprovedAt == nil {
+					out.ApprovedAt = new(types.BlockInfo)
+				}
+				(*out.ApprovedAt).UnmarshalTinyJSON(in)
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func tinyjson4e1b3cc1EncodeGithubComJackalLabsBurrowContractsCw1WhitelistSrcTypes(out *jwriter.Writer, in RecoveryProposal) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"id\":"
+		out.RawString(prefix[1:])
+		out.Uint64(uint64(in.ID))
+	}
+	{
+		const prefix string = ",\"admins\":"
+		out.RawString(prefix)
+		if in.Admins == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v3, v4 := range in.Admins {
+				if v3 > 0 {
+					out.RawByte(',')
+				}
+				out.String(string(v4))
+			}
+			out.RawByte(']')
+		}
+	}
+	{
+		const prefix string = ",\"approvals\":"
+		out.RawString(prefix)
+		if in.Approvals == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v5, v6 := range in.Approvals {
+				if v5 > 0 {
+					out.RawByte(',')
+				}
+				out.String(string(v6))
+			}
+			out.RawByte(']')
+		}
+	}
+	{
+		const prefix string = ",\"status\":"
+		out.RawString(prefix)
+		out.String(string(in.Status))
+	}
+	{
+		const prefix string = ",\"proposed_at\":"
+		out.RawString(prefix)
+		(in.ProposedAt).MarshalTinyJSON(out)
+	}
+	if in.ApprovedAt != nil {
+		const prefix string = ",\"approved_at\":"
+		out.RawString(prefix)
+		(*in.ApprovedAt).MarshalTinyJSON(out)
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v RecoveryProposal) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	tinyjson4e1b3cc1EncodeGithubComJackalLabsBurrowContractsCw1WhitelistSrcTypes(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalTinyJSON supports tinyjson.Marshaler interface
+func (v RecoveryProposal) MarshalTinyJSON(w *jwriter.Writer) {
+	tinyjson4e1b3cc1EncodeGithubComJackalLabsBurrowContractsCw1WhitelistSrcTypes(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *RecoveryProposal) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	tinyjson4e1b3cc1DecodeGithubComJackalLabsBurrowContractsCw1WhitelistSrcTypes(&r, v)
+	return r.Error()
+}
+
+// UnmarshalTinyJSON supports tinyjson.Unmarshaler interface
+func (v *RecoveryProposal) UnmarshalTinyJSON(l *jlexer.Lexer) {
+	tinyjson4e1b3cc1DecodeGithubComJackalLabsBurrowContractsCw1WhitelistSrcTypes(l, v)
+}
+func tinyjson4e1b3cc1DecodeGithubComJackalLabsBurrowContractsCw1WhitelistSrcTypes1(in *jlexer.Lexer, out *InheritanceConfig) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "beneficiary":
+			out.Beneficiary = string(in.String())
+		case "inactivity":
+			(out.Inactivity).UnmarshalTinyJSON(in)
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func tinyjson4e1b3cc1EncodeGithubComJackalLabsBurrowContractsCw1WhitelistSrcTypes1(out *jwriter.Writer, in InheritanceConfig) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"beneficiary\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.Beneficiary))
+	}
+	{
+		const prefix string = ",\"inactivity\":"
+		out.RawString(prefix)
+		(in.Inactivity).MarshalTinyJSON(out)
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v InheritanceConfig) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	tinyjson4e1b3cc1EncodeGithubComJackalLabsBurrowContractsCw1WhitelistSrcTypes1(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalTinyJSON supports tinyjson.Marshaler interface
+func (v InheritanceConfig) MarshalTinyJSON(w *jwriter.Writer) {
+	tinyjson4e1b3cc1EncodeGithubComJackalLabsBurrowContractsCw1WhitelistSrcTypes1(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *InheritanceConfig) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	tinyjson4e1b3cc1DecodeGithubComJackalLabsBurrowContractsCw1WhitelistSrcTypes1(&r, v)
+	return r.Error()
+}
+
+// UnmarshalTinyJSON supports tinyjson.Unmarshaler interface
+func (v *InheritanceConfig) UnmarshalTinyJSON(l *jlexer.Lexer) {
+	tinyjson4e1b3cc1DecodeGithubComJackalLabsBurrowContractsCw1WhitelistSrcTypes1(l, v)
+}
+func tinyjson4e1b3cc1DecodeGithubComJackalLabsBurrowContractsCw1WhitelistSrcTypes2(in *jlexer.Lexer, out *GuardianConfig) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "guardians":
+			if in.IsNull() {
+				in.Skip()
+				out.Guardians = nil
+			} else {
+				in.Delim('[')
+				if out.Guardians == nil {
+					if !in.IsDelim(']') {
+						out.Guardians = make([]string, 0, 4)
+					} else {
+						out.Guardians = []string{}
+					}
+				} else {
+					out.Guardians = (out.Guardians)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v7 string
+					v7 = string(in.String())
+					out.Guardians = append(out.Guardians, v7)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "threshold":
+			out.Threshold = uint32(in.Uint32())
+		case "approval_window":
+			(out.ApprovalWindow).UnmarshalTinyJSON(in)
+		case "veto_period":
+			(out.VetoPeriod).UnmarshalTinyJSON(in)
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func tinyjson4e1b3cc1EncodeGithubComJackalLabsBurrowContractsCw1WhitelistSrcTypes2(out *jwriter.Writer, in GuardianConfig) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"guardians\":"
+		out.RawString(prefix[1:])
+		if in.Guardians == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v8, v9 := range in.Guardians {
+				if v8 > 0 {
+					out.RawByte(',')
+				}
+				out.String(string(v9))
+			}
+			out.RawByte(']')
+		}
+	}
+	{
+		const prefix string = ",\"threshold\":"
+		out.RawString(prefix)
+		out.Uint32(uint32(in.Threshold))
+	}
+	{
+		const prefix string = ",\"approval_window\":"
+		out.RawString(prefix)
+		(in.ApprovalWindow).MarshalTinyJSON(out)
+	}
+	{
+		const prefix string = ",\"veto_period\":"
+		out.RawString(prefix)
+		(in.VetoPeriod).MarshalTinyJSON(out)
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v GuardianConfig) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	tinyjson4e1b3cc1EncodeGithubComJackalLabsBurrowContractsCw1WhitelistSrcTypes2(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalTinyJSON supports tinyjson.Marshaler interface
+func (v GuardianConfig) MarshalTinyJSON(w *jwriter.Writer) {
+	tinyjson4e1b3cc1EncodeGithubComJackalLabsBurrowContractsCw1WhitelistSrcTypes2(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *GuardianConfig) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	tinyjson4e1b3cc1DecodeGithubComJackalLabsBurrowContractsCw1WhitelistSrcTypes2(&r, v)
+	return r.Error()
+}
+
+// UnmarshalTinyJSON supports tinyjson.Unmarshaler interface
+func (v *GuardianConfig) UnmarshalTinyJSON(l *jlexer.Lexer) {
+	tinyjson4e1b3cc1DecodeGithubComJackalLabsBurrowContractsCw1WhitelistSrcTypes2(l, v)
+}
+func tinyjson4e1b3cc1DecodeGithubComJackalLabsBurrowContractsCw1WhitelistSrcTypes3(in *jlexer.Lexer, out *Duration) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "height":
+			out.Height = uint64(in.Uint64())
+		case "time":
+			out.Time = uint64(in.Uint64())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func tinyjson4e1b3cc1EncodeGithubComJackalLabsBurrowContractsCw1WhitelistSrcTypes3(out *jwriter.Writer, in Duration) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	if in.Height != 0 {
+		const prefix string = ",\"height\":"
+		first = false
+		out.RawString(prefix[1:])
+		out.Uint64(uint64(in.Height))
+	}
+	if in.Time != 0 {
+		const prefix string = ",\"time\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		out.Uint64(uint64(in.Time))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v Duration) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	tinyjson4e1b3cc1EncodeGithubComJackalLabsBurrowContractsCw1WhitelistSrcTypes3(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalTinyJSON supports tinyjson.Marshaler interface
+func (v Duration) MarshalTinyJSON(w *jwriter.Writer) {
+	tinyjson4e1b3cc1EncodeGithubComJackalLabsBurrowContractsCw1WhitelistSrcTypes3(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *Duration) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	tinyjson4e1b3cc1DecodeGithubComJackalLabsBurrowContractsCw1WhitelistSrcTypes3(&r, v)
+	return r.Error()
+}
+
+// UnmarshalTinyJSON supports tinyjson.Unmarshaler interface
+func (v *Duration) UnmarshalTinyJSON(l *jlexer.Lexer) {
+	tinyjson4e1b3cc1DecodeGithubComJackalLabsBurrowContractsCw1WhitelistSrcTypes3(l, v)
+}
+func tinyjson4e1b3cc1DecodeGithubComJackalLabsBurrowContractsCw1WhitelistSrcTypes4(in *jlexer.Lexer, out *AdminList) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "admins":
+			if in.IsNull() {
+				in.Skip()
+				out.Admins = nil
+			} else {
+				in.Delim('[')
+				if out.Admins == nil {
+					if !in.IsDelim(']') {
+						out.Admins = make([]string, 0, 4)
+					} else {
+						out.Admins = []string{}
+					}
+				} else {
+					out.Admins = (out.Admins)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v10 string
+					v10 = string(in.String())
+					out.Admins = append(out.Admins, v10)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
 		case "mutable":
 			out.Mutable = bool(in.Bool())
 		default:
@@ -69,7 +514,7 @@ func tinyjson4e1b3cc1DecodeGithubComJackalLabsBurrowContractsCw1WhitelistSrcType
 		in.Consumed()
 	}
 }
-func tinyjson4e1b3cc1EncodeGithubComJackalLabsBurrowContractsCw1WhitelistSrcTypes(out *jwriter.Writer, in AdminList) {
+func tinyjson4e1b3cc1EncodeGithubComJackalLabsBurrowContractsCw1WhitelistSrcTypes4(out *jwriter.Writer, in AdminList) {
 	out.RawByte('{')
 	first := true
 	_ = first
@@ -80,11 +525,11 @@ func tinyjson4e1b3cc1EncodeGithubComJackalLabsBurrowContractsCw1WhitelistSrcType
 			out.RawString("null")
 		} else {
 			out.RawByte('[')
-			for v2, v3 := range in.Admins {
-				if v2 > 0 {
+			for v11, v12 := range in.Admins {
+				if v11 > 0 {
 					out.RawByte(',')
 				}
-				out.String(string(v3))
+				out.String(string(v12))
 			}
 			out.RawByte(']')
 		}
@@ -100,23 +545,23 @@ func tinyjson4e1b3cc1EncodeGithubComJackalLabsBurrowContractsCw1WhitelistSrcType
 // MarshalJSON supports json.Marshaler interface
 func (v AdminList) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	tinyjson4e1b3cc1EncodeGithubComJackalLabsBurrowContractsCw1WhitelistSrcTypes(&w, v)
+	tinyjson4e1b3cc1EncodeGithubComJackalLabsBurrowContractsCw1WhitelistSrcTypes4(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalTinyJSON supports tinyjson.Marshaler interface
 func (v AdminList) MarshalTinyJSON(w *jwriter.Writer) {
-	tinyjson4e1b3cc1EncodeGithubComJackalLabsBurrowContractsCw1WhitelistSrcTypes(w, v)
+	tinyjson4e1b3cc1EncodeGithubComJackalLabsBurrowContractsCw1WhitelistSrcTypes4(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
 func (v *AdminList) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	tinyjson4e1b3cc1DecodeGithubComJackalLabsBurrowContractsCw1WhitelistSrcTypes(&r, v)
+	tinyjson4e1b3cc1DecodeGithubComJackalLabsBurrowContractsCw1WhitelistSrcTypes4(&r, v)
 	return r.Error()
 }
 
 // UnmarshalTinyJSON supports tinyjson.Unmarshaler interface
 func (v *AdminList) UnmarshalTinyJSON(l *jlexer.Lexer) {
-	tinyjson4e1b3cc1DecodeGithubComJackalLabsBurrowContractsCw1WhitelistSrcTypes(l, v)
+	tinyjson4e1b3cc1DecodeGithubComJackalLabsBurrowContractsCw1WhitelistSrcTypes4(l, v)
 }
@@ -36,3 +36,7 @@ require (
 	google.golang.org/protobuf v1.27.1 // indirect
 	gopkg.in/yaml.v3 v3.0.1 // indirect
 )
+
+require github.com/JackalLabs/burrow-contracts/storage v0.0.0
+
+replace github.com/JackalLabs/burrow-contracts/storage => ../storage
@@ -2,6 +2,7 @@ package src
 
 import (
 	contractTypes "github.com/JackalLabs/burrow-contracts/example/src/types"
+	"github.com/JackalLabs/burrow-contracts/storage"
 
 	"github.com/CosmWasm/cosmwasm-go/std"
 	"github.com/CosmWasm/cosmwasm-go/std/types"
@@ -26,7 +27,7 @@ func executeExample(deps *std.Deps, env *types.Env, info *types.MessageInfo, msg
 
 func example(deps *std.Deps, sender types.HumanAddress) error {
 
-	deps.Storage.Set([]byte(sender), []byte("example!"))
+	deps.Storage.Set(storage.MapKey("example", storage.Addr(sender)), []byte("example!"))
 
 	return nil
 }
@@ -4,33 +4,21 @@ import (
 	"errors"
 
 	"github.com/JackalLabs/burrow-contracts/example/src/types"
+	"github.com/JackalLabs/burrow-contracts/storage"
 
 	"github.com/CosmWasm/cosmwasm-go/std"
 )
 
-var StateKey = []byte("config")
+var StateKey = storage.NewItem[types.State]("config")
 
-func LoadState(storage std.Storage) (*types.State, error) {
-	data := storage.Get(StateKey)
-	if data == nil {
+func LoadState(store std.Storage) (*types.State, error) {
+	state, err := StateKey.MayLoad(store)
+	if err == nil && state == nil {
 		return nil, errors.New("state not found") // TODO(fdymylja): replace when errors API is ready
 	}
-
-	var state types.State
-	err := state.UnmarshalJSON(data)
-	if err != nil {
-		return nil, err
-	}
-	return &state, nil
+	return state, err
 }
 
-func SaveState(storage std.Storage, state *types.State) error {
-	bz, err := state.MarshalJSON()
-	if err != nil {
-		return err
-	}
-
-	storage.Set(StateKey, bz)
-
-	return nil
+func SaveState(store std.Storage, state *types.State) error {
+	return StateKey.Save(store, state)
 }
@@ -0,0 +1,202 @@
+package storage
+
+import (
+	"encoding/binary"
+	"errors"
+	"math"
+)
+
+// Format is how an Item, Map or Deque writes its values. Every value starts with the byte of its format,
+// and JSON text never starts with a control character, so values of both formats and untagged JSON
+// written before the markers existed can be read side by side while state migrates
+type Format byte
+
+const (
+	// FormatJSON writes values with their tinyjson codec, it's the default
+	FormatJSON Format = 0x00
+	// FormatBinary writes values with their BinaryCodec, which the value type must implement
+	FormatBinary Format = 0x02
+
+	// formatUnversionedBinary marks values written before binary codecs had layout versions,
+	// they're only read, by codecs implementing UnversionedBinaryCodec
+	formatUnversionedBinary Format = 0x01
+)
+
+var (
+	errInvalidBinary  = errors.New("invalid binary value")
+	errOutOfRange     = errors.New("binary value out of range")
+	errUnknownVersion = errors.New("unknown binary layout version")
+	errUnknownFormat  = errors.New("unknown storage format")
+	errNoBinaryCodec  = errors.New("type has no binary codec")
+)
+
+// BinaryCodec is implemented by types with a compact binary encoding, written with an Encoder.
+// Codecs start their values with a layout version, so a changed layout can still read the older ones
+type BinaryCodec interface {
+	MarshalBinary() ([]byte, error)
+	UnmarshalBinary([]byte) error
+}
+
+// UnversionedBinaryCodec is implemented by codecs that still read the layout written before values had a version
+type UnversionedBinaryCodec interface {
+	UnmarshalUnversionedBinary([]byte) error
+}
+
+// Encoder writes the fields of a value in order, with no field names.
+// Integers are varints and strings and slices are prefixed with their length
+type Encoder struct {
+	buf []byte
+}
+
+// Version writes the layout version, ahead of the fields
+func (e *Encoder) Version(v byte) {
+	e.buf = append(e.buf, v)
+}
+
+func (e *Encoder) Uint64(v uint64) {
+	e.buf = binary.AppendUvarint(e.buf, v)
+}
+
+func (e *Encoder) Int64(v int64) {
+	e.buf = binary.AppendVarint(e.buf, v)
+}
+
+func (e *Encoder) Bool(v bool) {
+	if v {
+		e.buf = append(e.buf, 1)
+	} else {
+		e.buf = append(e.buf, 0)
+	}
+}
+
+func (e *Encoder) Bytes(v []byte) {
+	e.Uint64(uint64(len(v)))
+	e.buf = append(e.buf, v...)
+}
+
+func (e *Encoder) String(v string) {
+	e.Uint64(uint64(len(v)))
+	e.buf = append(e.buf, v...)
+}
+
+// Data returns what was written so far
+func (e *Encoder) Data() []byte {
+	return e.buf
+}
+
+// Decoder reads the fields written by an Encoder in the same order.
+// The first failure is kept and returned by Finish, reads after it return zero values
+type Decoder struct {
+	buf []byte
+	err error
+}
+
+func NewDecoder(data []byte) *Decoder {
+	return &Decoder{buf: data}
+}
+
+// Version reads the layout version, failing on 0 and on versions after latest,
+// which were written by a newer codec
+func (d *Decoder) Version(latest byte) byte {
+	v := d.next(1)[0]
+	if d.err == nil && (v == 0 || v > latest) {
+		d.err = errUnknownVersion
+	}
+	return v
+}
+
+// Fail records an error of the codec, such as a value it doesn't know, unless the decoder already failed
+func (d *Decoder) Fail(err error) {
+	if d.err == nil {
+		d.err = err
+	}
+}
+
+// Uint32 reads a Uint64, failing if it doesn't fit
+func (d *Decoder) Uint32() uint32 {
+	v := d.Uint64()
+	if v > math.MaxUint32 {
+		if d.err == nil {
+			d.err = errOutOfRange
+		}
+		return 0
+	}
+	return uint32(v)
+}
+
+func (d *Decoder) Uint64() uint64 {
+	if d.err != nil {
+		return 0
+	}
+	v, n := binary.Uvarint(d.buf)
+	if n <= 0 {
+		d.err = errInvalidBinary
+		return 0
+	}
+	d.buf = d.buf[n:]
+	return v
+}
+
+func (d *Decoder) Int64() int64 {
+	if d.err != nil {
+		return 0
+	}
+	v, n := binary.Varint(d.buf)
+	if n <= 0 {
+		d.err = errInvalidBinary
+		return 0
+	}
+	d.buf = d.buf[n:]
+	return v
+}
+
+func (d *Decoder) Bool() bool {
+	switch d.next(1)[0] {
+	case 0:
+		return false
+	case 1:
+		return true
+	default:
+		d.err = errInvalidBinary
+		return false
+	}
+}
+
+func (d *Decoder) Bytes() []byte {
+	return append([]byte{}, d.next(d.Len())...)
+}
+
+func (d *Decoder) String() string {
+	return string(d.next(d.Len()))
+}
+
+// Len reads the length of a slice, which can't be more than the bytes left
+func (d *Decoder) Len() int {
+	size := d.Uint64()
+	if size > uint64(len(d.buf)) {
+		d.err = errInvalidBinary
+		return 0
+	}
+	return int(size)
+}
+
+// next consumes n bytes, returning zeros once the decoder failed
+func (d *Decoder) next(n int) []byte {
+	if d.err == nil && len(d.buf) < n {
+		d.err = errInvalidBinary
+	}
+	if d.err != nil {
+		return make([]byte, n)
+	}
+	bz := d.buf[:n]
+	d.buf = d.buf[n:]
+	return bz
+}
+
+// Finish returns the first failure, or an error if bytes are left over
+func (d *Decoder) Finish() error {
+	if d.err == nil && len(d.buf) != 0 {
+		d.err = errInvalidBinary
+	}
+	return d.err
+}
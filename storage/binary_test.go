@@ -0,0 +1,162 @@
+package storage
+
+import (
+	stdmath "math"
+	"testing"
+
+	"github.com/CosmWasm/cosmwasm-go/std/math"
+	"github.com/CosmWasm/cosmwasm-go/std/mock"
+	"github.com/CosmWasm/cosmwasm-go/std/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// binaryCoin is a coin with a binary codec, version 2 dropped the high half of the amount
+type binaryCoin struct {
+	types.Coin
+}
+
+func (c binaryCoin) MarshalBinary() ([]byte, error) {
+	var enc Encoder
+	enc.Version(2)
+	enc.String(c.Denom)
+	enc.Uint64(c.Amount.Lo)
+	return enc.Data(), nil
+}
+
+func (c *binaryCoin) UnmarshalBinary(data []byte) error {
+	dec := NewDecoder(data)
+	version := dec.Version(2)
+	c.Denom = dec.String()
+	c.Amount = math.NewUint128FromUint64(dec.Uint64())
+	if version == 1 {
+		dec.Uint64()
+	}
+	return dec.Finish()
+}
+
+// UnmarshalUnversionedBinary reads the layout of version 1, with no version
+func (c *binaryCoin) UnmarshalUnversionedBinary(data []byte) error {
+	return c.UnmarshalBinary(append([]byte{1}, data...))
+}
+
+func TestFormat(t *testing.T) {
+	store := mock.Storage()
+	jsonItem := NewItem[binaryCoin]("coin")
+	binaryItem := jsonItem.WithFormat(FormatBinary)
+
+	// written before the format markers existed
+	store.Set(jsonItem.Key(), []byte(`{"denom":"ujkl","amount":"10"}`))
+	c, err := binaryItem.Load(store)
+	require.NoError(t, err)
+	assert.Equal(t, "10", c.Amount.String())
+
+	// binary is opt-in
+	require.NoError(t, jsonItem.Save(store, &binaryCoin{Coin: *coin(20)}))
+	assert.Equal(t, append([]byte{0x00}, `{"denom":"ujkl","amount":"20"}`...), store.Get(jsonItem.Key()))
+
+	require.NoError(t, binaryItem.Save(store, &binaryCoin{Coin: *coin(300)}))
+	assert.Equal(t, []byte("\x02\x02\x04ujkl\xac\x02"), store.Get(binaryItem.Key()))
+
+	// either item reads either format
+	for _, item := range []Item[binaryCoin, *binaryCoin]{jsonItem, binaryItem} {
+		c, err = item.Load(store)
+		require.NoError(t, err)
+		assert.Equal(t, *coin(300), c.Coin)
+	}
+
+	// and older layouts of the codec
+	store.Set(binaryItem.Key(), []byte("\x02\x01\x04ujkl\x05\x00"))
+	c, err = jsonItem.Load(store)
+	require.NoError(t, err)
+	assert.Equal(t, *coin(5), c.Coin)
+
+	// and values written before codecs had versions
+	store.Set(binaryItem.Key(), []byte("\x01\x04ujkl\x06\x00"))
+	c, err = jsonItem.Load(store)
+	require.NoError(t, err)
+	assert.Equal(t, *coin(6), c.Coin)
+
+	// but not newer ones
+	store.Set(binaryItem.Key(), []byte("\x02\x03\x04ujkl\x05"))
+	_, err = jsonItem.Load(store)
+	assert.ErrorIs(t, err, errUnknownVersion)
+
+	// JSON only types can't be written or read in binary
+	plain := NewItem[types.Coin]("plain").WithFormat(FormatBinary)
+	assert.ErrorIs(t, plain.Save(store, coin(1)), errNoBinaryCodec)
+	store.Set(plain.Key(), store.Get(binaryItem.Key()))
+	_, err = plain.Load(store)
+	assert.ErrorIs(t, err, errNoBinaryCodec)
+	store.Set(plain.Key(), []byte("\x01\x04ujkl\x06\x00"))
+	_, err = plain.Load(store)
+	assert.ErrorIs(t, err, errNoBinaryCodec)
+
+	// unknown formats aren't written
+	assert.ErrorIs(t, jsonItem.WithFormat(3).Save(store, &binaryCoin{}), errUnknownFormat)
+}
+
+func TestDecoder(t *testing.T) {
+	var enc Encoder
+	enc.Bool(true)
+	enc.Int64(-3)
+	enc.Bytes([]byte{0, 1})
+	enc.String("abc")
+
+	dec := NewDecoder(enc.Data())
+	assert.True(t, dec.Bool())
+	assert.Equal(t, int64(-3), dec.Int64())
+	assert.Equal(t, []byte{0, 1}, dec.Bytes())
+	assert.Equal(t, "abc", dec.String())
+	require.NoError(t, dec.Finish())
+
+	// truncated
+	dec = NewDecoder(enc.Data()[:4])
+	dec.Bool()
+	dec.Int64()
+	dec.Bytes()
+	assert.Equal(t, "", dec.String())
+	assert.Error(t, dec.Finish())
+
+	// left over
+	dec = NewDecoder(enc.Data())
+	dec.Bool()
+	assert.Error(t, dec.Finish())
+
+	// not a bool
+	dec = NewDecoder([]byte{2})
+	dec.Bool()
+	assert.Error(t, dec.Finish())
+
+	// integers that don't fit
+	enc = Encoder{}
+	enc.Uint64(stdmath.MaxUint32 + 1)
+	dec = NewDecoder(enc.Data())
+	assert.Zero(t, dec.Uint32())
+	assert.ErrorIs(t, dec.Finish(), errOutOfRange)
+
+	enc = Encoder{}
+	enc.Uint64(stdmath.MaxUint32)
+	dec = NewDecoder(enc.Data())
+	assert.Equal(t, uint32(stdmath.MaxUint32), dec.Uint32())
+	require.NoError(t, dec.Finish())
+
+	// codecs can fail on values they don't know, the first failure is kept
+	dec = NewDecoder([]byte{0x05})
+	dec.Fail(errOutOfRange)
+	dec.Fail(errInvalidBinary)
+	assert.Zero(t, dec.Uint64())
+	assert.ErrorIs(t, dec.Finish(), errOutOfRange)
+
+	// versions are 1 up to the latest the codec knows
+	for _, version := range []byte{0, 3} {
+		dec = NewDecoder([]byte{version})
+		dec.Version(2)
+		assert.ErrorIs(t, dec.Finish(), errUnknownVersion)
+	}
+
+	// lengths can't run past the data
+	dec = NewDecoder([]byte{0xff, 0x01})
+	dec.Bytes()
+	assert.Error(t, dec.Finish())
+}
@@ -0,0 +1,34 @@
+package storage
+
+// Bound limits one end of a range to a key, including or excluding it
+type Bound[K Key] struct {
+	Key       K
+	Exclusive bool
+}
+
+// Inclusive bounds a range at key, key included
+func Inclusive[K Key](key K) *Bound[K] {
+	return &Bound[K]{Key: key}
+}
+
+// Exclusive bounds a range at key, key excluded.
+// Passing the last key of a page as an exclusive bound fetches the next page
+func Exclusive[K Key](key K) *Bound[K] {
+	return &Bound[K]{Key: key, Exclusive: true}
+}
+
+// rawMin returns the first raw key at or above the lower bound of keys starting with raw
+func rawMin(raw []byte, exclusive bool) []byte {
+	if exclusive {
+		return append(raw, 0)
+	}
+	return raw
+}
+
+// rawMax returns the first raw key past the upper bound of keys starting with raw
+func rawMax(raw []byte, inclusive bool) []byte {
+	if inclusive {
+		return append(raw, 0)
+	}
+	return raw
+}
@@ -0,0 +1,104 @@
+// Package storage provides typed Item and Map helpers over std.Storage,
+// so contracts don't hand-write a Load/Save pair for every value they keep.
+// Values are encoded with their tinyjson MarshalJSON/UnmarshalJSON, which keeps the package usable under TinyGo,
+// or in binary for Items and Maps set to FormatBinary.
+package storage
+
+import (
+	"strconv"
+
+	"github.com/CosmWasm/cosmwasm-go/std"
+	"github.com/CosmWasm/cosmwasm-go/std/types"
+)
+
+// Codec is satisfied by a pointer to any tinyjson generated type.
+// It's inferred from the value type, so NewItem[types.State]("state") is enough
+type Codec[T any] interface {
+	*T
+	MarshalJSON() ([]byte, error)
+	UnmarshalJSON([]byte) error
+}
+
+// NotFoundError is returned when loading a key that was never saved,
+// Name is the key of the Item or the namespace of the Map
+type NotFoundError struct {
+	Name string
+	Key  []byte
+}
+
+func (e NotFoundError) Error() string {
+	return strconv.Quote(e.Name) + " not found"
+}
+
+// IsNotFound checks if the error came from loading a missing key
+func IsNotFound(err error) bool {
+	_, ok := err.(NotFoundError)
+	return ok
+}
+
+// encode writes the value in format, after the format's marker
+func encode[T any, P Codec[T]](format Format, value *T) ([]byte, error) {
+	var bz []byte
+	var err error
+	switch format {
+	case FormatJSON:
+		bz, err = P(value).MarshalJSON()
+	case FormatBinary:
+		codec, ok := any(P(value)).(BinaryCodec)
+		if !ok {
+			return nil, errNoBinaryCodec
+		}
+		bz, err = codec.MarshalBinary()
+	default:
+		return nil, errUnknownFormat
+	}
+	if err != nil {
+		return nil, err
+	}
+	return append([]byte{byte(format)}, bz...), nil
+}
+
+// decode reads values in any format, and untagged JSON written before the format markers
+func decode[T any, P Codec[T]](data []byte) (*T, error) {
+	var value T
+	var err error
+	switch {
+	case len(data) > 0 && data[0] == byte(FormatJSON):
+		err = P(&value).UnmarshalJSON(data[1:])
+	case len(data) > 0 && data[0] == byte(FormatBinary):
+		codec, ok := any(P(&value)).(BinaryCodec)
+		if !ok {
+			return nil, errNoBinaryCodec
+		}
+		err = codec.UnmarshalBinary(data[1:])
+	case len(data) > 0 && data[0] == byte(formatUnversionedBinary):
+		codec, ok := any(P(&value)).(UnversionedBinaryCodec)
+		if !ok {
+			return nil, errNoBinaryCodec
+		}
+		err = codec.UnmarshalUnversionedBinary(data[1:])
+	default:
+		err = P(&value).UnmarshalJSON(data)
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &value, nil
+}
+
+// queryRaw reads the raw key of another contract, returning nil if it isn't set
+func queryRaw[T any, P Codec[T]](querier std.QuerierWrapper, contract string, key []byte) (*T, error) {
+	request, err := types.RawQuery{ContractAddr: contract, Key: key}.ToQuery().MarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+
+	data, err := querier.RawQuery(request)
+	if err != nil {
+		return nil, err
+	}
+	if len(data) == 0 {
+		return nil, nil
+	}
+	return decode[T, P](data)
+}
@@ -0,0 +1,194 @@
+package storage
+
+import (
+	"encoding/binary"
+	"errors"
+
+	"github.com/CosmWasm/cosmwasm-go/std"
+)
+
+// Deque is a double-ended queue, laid out as in cosmwasm storage-plus:
+// the head and tail positions are stored under the namespace with "h" and "t",
+// and values under the namespace with their big-endian uint32 position.
+// Positions wrap around, so pushing and popping never moves the other values.
+// Used as a queue, push to the back and pop from the front
+type Deque[T any, P Codec[T]] struct {
+	namespace []byte
+	format    Format
+}
+
+var errDequeFull = errors.New("deque is full")
+
+func NewDeque[T any, P Codec[T]](namespace string) Deque[T, P] {
+	return Deque[T, P]{namespace: lengthPrefixed([]byte(namespace))}
+}
+
+// WithFormat returns the deque writing its values in format
+func (d Deque[T, P]) WithFormat(format Format) Deque[T, P] {
+	d.format = format
+	return d
+}
+
+func (d Deque[T, P]) key(suffix []byte) []byte {
+	return append(append([]byte{}, d.namespace...), suffix...)
+}
+
+func (d Deque[T, P]) valueKey(pos uint32) []byte {
+	return d.key(binary.BigEndian.AppendUint32(nil, pos))
+}
+
+func (d Deque[T, P]) loadPosition(storage std.Storage, name string) uint32 {
+	data := storage.Get(d.key([]byte(name)))
+	if len(data) != 4 {
+		return 0
+	}
+	return binary.BigEndian.Uint32(data)
+}
+
+func (d Deque[T, P]) savePosition(storage std.Storage, name string, pos uint32) {
+	storage.Set(d.key([]byte(name)), binary.BigEndian.AppendUint32(nil, pos))
+}
+
+// head is the position of the front value, tail the position after the back value
+func (d Deque[T, P]) head(storage std.Storage) uint32 {
+	return d.loadPosition(storage, "h")
+}
+
+func (d Deque[T, P]) tail(storage std.Storage) uint32 {
+	return d.loadPosition(storage, "t")
+}
+
+// Len returns the number of values in the deque
+func (d Deque[T, P]) Len(storage std.Storage) uint32 {
+	return d.tail(storage) - d.head(storage)
+}
+
+func (d Deque[T, P]) IsEmpty(storage std.Storage) bool {
+	return d.Len(storage) == 0
+}
+
+func (d Deque[T, P]) PushBack(storage std.Storage, value *T) error {
+	if d.Len(storage) == ^uint32(0) {
+		return errDequeFull
+	}
+
+	bz, err := encode[T, P](d.format, value)
+	if err != nil {
+		return err
+	}
+
+	tail := d.tail(storage)
+	storage.Set(d.valueKey(tail), bz)
+	d.savePosition(storage, "t", tail+1)
+
+	return nil
+}
+
+func (d Deque[T, P]) PushFront(storage std.Storage, value *T) error {
+	if d.Len(storage) == ^uint32(0) {
+		return errDequeFull
+	}
+
+	bz, err := encode[T, P](d.format, value)
+	if err != nil {
+		return err
+	}
+
+	head := d.head(storage) - 1
+	storage.Set(d.valueKey(head), bz)
+	d.savePosition(storage, "h", head)
+
+	return nil
+}
+
+// PopBack removes and returns the back value, or nil if the deque is empty
+func (d Deque[T, P]) PopBack(storage std.Storage) (*T, error) {
+	if d.IsEmpty(storage) {
+		return nil, nil
+	}
+
+	tail := d.tail(storage) - 1
+	value, err := d.load(storage, tail)
+	if err != nil {
+		return nil, err
+	}
+
+	storage.Remove(d.valueKey(tail))
+	d.savePosition(storage, "t", tail)
+
+	return value, nil
+}
+
+// PopFront removes and returns the front value, or nil if the deque is empty
+func (d Deque[T, P]) PopFront(storage std.Storage) (*T, error) {
+	if d.IsEmpty(storage) {
+		return nil, nil
+	}
+
+	head := d.head(storage)
+	value, err := d.load(storage, head)
+	if err != nil {
+		return nil, err
+	}
+
+	storage.Remove(d.valueKey(head))
+	d.savePosition(storage, "h", head+1)
+
+	return value, nil
+}
+
+// Front returns the front value without removing it, or nil if the deque is empty
+func (d Deque[T, P]) Front(storage std.Storage) (*T, error) {
+	return d.Get(storage, 0)
+}
+
+// Back returns the back value without removing it, or nil if the deque is empty
+func (d Deque[T, P]) Back(storage std.Storage) (*T, error) {
+	size := d.Len(storage)
+	if size == 0 {
+		return nil, nil
+	}
+	return d.Get(storage, size-1)
+}
+
+// Get returns the value at the index counted from the front, or nil if it's past the back
+func (d Deque[T, P]) Get(storage std.Storage, index uint32) (*T, error) {
+	if index >= d.Len(storage) {
+		return nil, nil
+	}
+	return d.load(storage, d.head(storage)+index)
+}
+
+func (d Deque[T, P]) load(storage std.Storage, pos uint32) (*T, error) {
+	data := storage.Get(d.valueKey(pos))
+	if data == nil {
+		return nil, errors.New("deque value missing")
+	}
+	return decode[T, P](data)
+}
+
+// Range returns up to limit values starting at the index, from the front when ascending
+// and from the back when descending. A limit of 0 returns everything after the start
+func (d Deque[T, P]) Range(storage std.Storage, start uint32, order std.Order, limit int) ([]T, error) {
+	head, size := d.head(storage), d.Len(storage)
+
+	values := []T{}
+	for i := start; i < size; i++ {
+		if limit > 0 && len(values) == limit {
+			break
+		}
+
+		pos := head + i
+		if order == std.Descending {
+			pos = head + size - 1 - i
+		}
+
+		value, err := d.load(storage, pos)
+		if err != nil {
+			return nil, err
+		}
+		values = append(values, *value)
+	}
+
+	return values, nil
+}
@@ -0,0 +1,101 @@
+package storage
+
+import (
+	"testing"
+
+	"github.com/CosmWasm/cosmwasm-go/std"
+	"github.com/CosmWasm/cosmwasm-go/std/mock"
+	"github.com/CosmWasm/cosmwasm-go/std/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func amounts(coins []types.Coin) []string {
+	values := make([]string, len(coins))
+	for i, c := range coins {
+		values[i] = c.Amount.String()
+	}
+	return values
+}
+
+func TestDequeQueue(t *testing.T) {
+	store := mock.Storage()
+	queue := NewDeque[types.Coin]("queue")
+
+	c, err := queue.PopFront(store)
+	require.NoError(t, err)
+	assert.Nil(t, c)
+	assert.True(t, queue.IsEmpty(store))
+
+	for _, amount := range []uint64{1, 2, 3} {
+		require.NoError(t, queue.PushBack(store, coin(amount)))
+	}
+	assert.Equal(t, uint32(3), queue.Len(store))
+
+	// first in, first out
+	c, err = queue.PopFront(store)
+	require.NoError(t, err)
+	assert.Equal(t, "1", c.Amount.String())
+
+	c, err = queue.Front(store)
+	require.NoError(t, err)
+	assert.Equal(t, "2", c.Amount.String())
+	c, err = queue.Back(store)
+	require.NoError(t, err)
+	assert.Equal(t, "3", c.Amount.String())
+	assert.Equal(t, uint32(2), queue.Len(store))
+
+	// laid out as in storage-plus
+	assert.Equal(t, []byte{0, 0, 0, 1}, store.Get([]byte("\x00\x05queueh")))
+	assert.Equal(t, []byte{0, 0, 0, 3}, store.Get([]byte("\x00\x05queuet")))
+	assert.Nil(t, store.Get([]byte("\x00\x05queue\x00\x00\x00\x00")))
+	assert.NotNil(t, store.Get([]byte("\x00\x05queue\x00\x00\x00\x02")))
+}
+
+func TestDequeBothEnds(t *testing.T) {
+	store := mock.Storage()
+	deque := NewDeque[types.Coin]("deque")
+
+	// pushing to the front of a new deque wraps below position 0
+	require.NoError(t, deque.PushFront(store, coin(2)))
+	require.NoError(t, deque.PushFront(store, coin(1)))
+	require.NoError(t, deque.PushBack(store, coin(3)))
+	require.NoError(t, deque.PushBack(store, coin(4)))
+
+	values, err := deque.Range(store, 0, std.Ascending, 0)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"1", "2", "3", "4"}, amounts(values))
+
+	values, err = deque.Range(store, 1, std.Descending, 2)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"3", "2"}, amounts(values))
+
+	c, err := deque.Get(store, 1)
+	require.NoError(t, err)
+	assert.Equal(t, "2", c.Amount.String())
+	c, err = deque.Get(store, 4)
+	require.NoError(t, err)
+	assert.Nil(t, c)
+
+	c, err = deque.PopBack(store)
+	require.NoError(t, err)
+	assert.Equal(t, "4", c.Amount.String())
+	c, err = deque.PopFront(store)
+	require.NoError(t, err)
+	assert.Equal(t, "1", c.Amount.String())
+
+	values, err = deque.Range(store, 0, std.Ascending, 0)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"2", "3"}, amounts(values))
+
+	for !deque.IsEmpty(store) {
+		_, err = deque.PopBack(store)
+		require.NoError(t, err)
+	}
+	c, err = deque.Back(store)
+	require.NoError(t, err)
+	assert.Nil(t, c)
+	values, err = deque.Range(store, 0, std.Ascending, 0)
+	require.NoError(t, err)
+	assert.Empty(t, values)
+}
@@ -0,0 +1,308 @@
+package storage
+
+import (
+	"errors"
+
+	"github.com/CosmWasm/cosmwasm-go/std"
+)
+
+// Index is a secondary view of an IndexedMap, kept in sync as entries are saved and removed
+type Index[V any] interface {
+	check(storage std.Storage, pk []byte, value *V) error
+	add(storage std.Storage, pk []byte, value *V)
+	remove(storage std.Storage, pk []byte, value *V)
+}
+
+// IndexedMap is a Map that updates its indexes on every save and remove
+type IndexedMap[K Key, V any, P Codec[V]] struct {
+	Map[K, V, P]
+	indexes []Index[V]
+}
+
+func NewIndexedMap[K Key, V any, P Codec[V]](namespace string, indexes ...Index[V]) IndexedMap[K, V, P] {
+	return IndexedMap[K, V, P]{
+		Map:     NewMap[K, V, P](namespace),
+		indexes: indexes,
+	}
+}
+
+// WithFormat is Map.WithFormat, keeping the indexes
+func (m IndexedMap[K, V, P]) WithFormat(format Format) IndexedMap[K, V, P] {
+	m.Map = m.Map.WithFormat(format)
+	return m
+}
+
+func (m IndexedMap[K, V, P]) Save(storage std.Storage, key K, value *V) error {
+	old, err := m.Map.MayLoad(storage, key)
+	if err != nil {
+		return err
+	}
+
+	// every index is checked first, so a rejected save writes nothing
+	pk := encodeKey(key)
+	for _, index := range m.indexes {
+		err = index.check(storage, pk, value)
+		if err != nil {
+			return err
+		}
+	}
+
+	for _, index := range m.indexes {
+		if old != nil {
+			index.remove(storage, pk, old)
+		}
+		index.add(storage, pk, value)
+	}
+
+	return m.Map.Save(storage, key, value)
+}
+
+// Update loads the value, nil if it was never saved, and saves what action returns along with its indexes
+func (m IndexedMap[K, V, P]) Update(storage std.Storage, key K, action func(*V) (*V, error)) (*V, error) {
+	value, err := m.Map.MayLoad(storage, key)
+	if err != nil {
+		return nil, err
+	}
+
+	value, err = action(value)
+	if err != nil {
+		return nil, err
+	}
+
+	err = m.Save(storage, key, value)
+	if err != nil {
+		return nil, err
+	}
+	return value, nil
+}
+
+func (m IndexedMap[K, V, P]) Remove(storage std.Storage, key K) error {
+	old, err := m.Map.MayLoad(storage, key)
+	if err != nil || old == nil {
+		return err
+	}
+
+	pk := encodeKey(key)
+	for _, index := range m.indexes {
+		index.remove(storage, pk, old)
+	}
+
+	m.Map.Remove(storage, key)
+	return nil
+}
+
+// IndexEntry is an index key and the primary key of the entry it points at
+type IndexEntry[IK Key, K Key] struct {
+	Index IK
+	Key   K
+}
+
+// UniqueIndex maps each index key to at most one entry
+type UniqueIndex[IK Key, K Key, V any] struct {
+	name      string
+	namespace []byte
+	indexFn   func(*V) (IK, bool)
+}
+
+// NewUniqueIndex indexes entries by the key indexFn returns, entries it returns false for aren't indexed
+func NewUniqueIndex[IK Key, K Key, V any](namespace string, indexFn func(*V) (IK, bool)) *UniqueIndex[IK, K, V] {
+	return &UniqueIndex[IK, K, V]{name: namespace, namespace: lengthPrefixed([]byte(namespace)), indexFn: indexFn}
+}
+
+func (i *UniqueIndex[IK, K, V]) key(ik IK) []byte {
+	return append(append([]byte{}, i.namespace...), encodeKey(ik)...)
+}
+
+func (i *UniqueIndex[IK, K, V]) check(storage std.Storage, pk []byte, value *V) error {
+	ik, ok := i.indexFn(value)
+	if !ok {
+		return nil
+	}
+
+	existing := storage.Get(i.key(ik))
+	if existing != nil && string(existing) != string(pk) {
+		return errors.New("Violates unique constraint on index " + i.name)
+	}
+	return nil
+}
+
+func (i *UniqueIndex[IK, K, V]) add(storage std.Storage, pk []byte, value *V) {
+	ik, ok := i.indexFn(value)
+	if ok {
+		storage.Set(i.key(ik), pk)
+	}
+}
+
+func (i *UniqueIndex[IK, K, V]) remove(storage std.Storage, pk []byte, value *V) {
+	ik, ok := i.indexFn(value)
+	if ok {
+		storage.Remove(i.key(ik))
+	}
+}
+
+// Load returns the primary key of the entry with the index key, or a NotFoundError
+func (i *UniqueIndex[IK, K, V]) Load(storage std.Storage, ik IK) (K, error) {
+	rawKey := i.key(ik)
+	pk := storage.Get(rawKey)
+	if pk == nil {
+		var empty K
+		return empty, NotFoundError{Name: i.name, Key: rawKey}
+	}
+	return decodeKey[K](pk)
+}
+
+// Range returns up to limit entries with an index key between min and max, a zero limit returns every entry
+func (i *UniqueIndex[IK, K, V]) Range(storage std.Storage, min *Bound[IK], max *Bound[IK], order std.Order, limit int) ([]IndexEntry[IK, K], error) {
+	var bounds [2][]byte
+	if min != nil {
+		bounds[0] = rawMin(i.key(min.Key), min.Exclusive)
+	}
+	if max != nil {
+		bounds[1] = rawMax(i.key(max.Key), !max.Exclusive)
+	}
+
+	entries := []IndexEntry[IK, K]{}
+	err := iterate(storage, i.namespace, bounds, order, limit, func(rawKey []byte, pk []byte) error {
+		ik, err := decodeKey[IK](rawKey)
+		if err != nil {
+			return err
+		}
+		key, err := decodeKey[K](pk)
+		if err != nil {
+			return err
+		}
+		entries = append(entries, IndexEntry[IK, K]{Index: ik, Key: key})
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return entries, nil
+}
+
+// MultiIndex maps each index key to any number of entries, stored under the (index key, primary key) pair.
+// As the index key is length-prefixed, fixed width keys like heights range in order
+// while strings range by length first
+type MultiIndex[IK Key, K Key, V any] struct {
+	namespace []byte
+	indexFn   func(*V) []IK
+}
+
+// NewMultiIndex indexes entries under every key indexFn returns, none leaves the entry unindexed
+func NewMultiIndex[IK Key, K Key, V any](namespace string, indexFn func(*V) []IK) *MultiIndex[IK, K, V] {
+	return &MultiIndex[IK, K, V]{namespace: lengthPrefixed([]byte(namespace)), indexFn: indexFn}
+}
+
+// prefix returns the raw prefix of every entry under ik
+func (i *MultiIndex[IK, K, V]) prefix(ik IK) []byte {
+	return append(append([]byte{}, i.namespace...), lengthPrefixed(encodeKey(ik))...)
+}
+
+func (i *MultiIndex[IK, K, V]) check(storage std.Storage, pk []byte, value *V) error {
+	return nil
+}
+
+func (i *MultiIndex[IK, K, V]) add(storage std.Storage, pk []byte, value *V) {
+	for _, ik := range i.indexFn(value) {
+		storage.Set(append(i.prefix(ik), pk...), pk)
+	}
+}
+
+func (i *MultiIndex[IK, K, V]) remove(storage std.Storage, pk []byte, value *V) {
+	for _, ik := range i.indexFn(value) {
+		storage.Remove(append(i.prefix(ik), pk...))
+	}
+}
+
+// Prefix returns up to limit primary keys indexed under ik, after startAfter if it's set
+func (i *MultiIndex[IK, K, V]) Prefix(storage std.Storage, ik IK, startAfter *K, limit int) ([]K, error) {
+	keys := []K{}
+	err := i.iteratePrefix(storage, ik, startAfter, limit, func(key K) error {
+		keys = append(keys, key)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return keys, nil
+}
+
+// WalkPrefix calls fn with the primary keys indexed under ik in order, after startAfter if it's set,
+// until it returns false
+func (i *MultiIndex[IK, K, V]) WalkPrefix(storage std.Storage, ik IK, startAfter *K, fn func(K) (bool, error)) error {
+	return i.iteratePrefix(storage, ik, startAfter, 0, func(key K) error {
+		return walkStep(fn(key))
+	})
+}
+
+func (i *MultiIndex[IK, K, V]) iteratePrefix(storage std.Storage, ik IK, startAfter *K, limit int, fn func(K) error) error {
+	prefix := i.prefix(ik)
+
+	var bounds [2][]byte
+	if startAfter != nil {
+		bounds[0] = rawMin(append(append([]byte{}, prefix...), encodeKey(*startAfter)...), true)
+	}
+
+	return iterate(storage, prefix, bounds, std.Ascending, limit, func(rawKey []byte, _ []byte) error {
+		key, err := decodeKey[K](rawKey)
+		if err != nil {
+			return err
+		}
+		return fn(key)
+	})
+}
+
+// Range returns up to limit entries with an index key between min and max, a zero limit returns every entry.
+// Entries sharing an index key are ordered by primary key, and after continues a page from the given entry
+func (i *MultiIndex[IK, K, V]) Range(storage std.Storage, min *Bound[IK], max *Bound[IK], after *IndexEntry[IK, K], order std.Order, limit int) ([]IndexEntry[IK, K], error) {
+	var bounds [2][]byte
+	if min != nil {
+		bounds[0] = i.prefix(min.Key)
+		if min.Exclusive {
+			bounds[0] = prefixEnd(bounds[0])
+		}
+	}
+	if max != nil {
+		bounds[1] = i.prefix(max.Key)
+		if !max.Exclusive {
+			bounds[1] = prefixEnd(bounds[1])
+		}
+	}
+
+	if after != nil {
+		cursor := append(i.prefix(after.Index), encodeKey(after.Key)...)
+		if order == std.Descending {
+			if bounds[1] == nil || string(cursor) < string(bounds[1]) {
+				bounds[1] = cursor
+			}
+		} else {
+			cursor = append(cursor, 0)
+			if string(cursor) > string(bounds[0]) {
+				bounds[0] = cursor
+			}
+		}
+	}
+
+	entries := []IndexEntry[IK, K]{}
+	err := iterate(storage, i.namespace, bounds, order, limit, func(rawKey []byte, _ []byte) error {
+		parts, err := splitKey(rawKey, 2)
+		if err != nil {
+			return err
+		}
+
+		ik, err := decodeKey[IK](parts[0])
+		if err != nil {
+			return err
+		}
+		key, err := decodeKey[K](parts[1])
+		if err != nil {
+			return err
+		}
+		entries = append(entries, IndexEntry[IK, K]{Index: ik, Key: key})
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return entries, nil
+}
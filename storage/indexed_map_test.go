@@ -0,0 +1,86 @@
+package storage
+
+import (
+	"testing"
+
+	"github.com/CosmWasm/cosmwasm-go/std"
+	"github.com/CosmWasm/cosmwasm-go/std/mock"
+	"github.com/CosmWasm/cosmwasm-go/std/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestIndexedMap(t *testing.T) {
+	store := mock.Storage()
+
+	byHeight := NewMultiIndex[uint64, string]("blocks_height_", func(b *types.BlockInfo) []uint64 {
+		return []uint64{b.Height}
+	})
+	byChain := NewUniqueIndex[string, string]("blocks_chain_", func(b *types.BlockInfo) (string, bool) {
+		return b.ChainID, b.ChainID != ""
+	})
+	blocks := NewIndexedMap[string, types.BlockInfo]("blocks_", byHeight, byChain)
+
+	require.NoError(t, blocks.Save(store, "dave", &types.BlockInfo{Height: 20, ChainID: "jackal"}))
+	require.NoError(t, blocks.Save(store, "erin", &types.BlockInfo{Height: 10}))
+	require.NoError(t, blocks.Save(store, "frank", &types.BlockInfo{Height: 20}))
+	require.NoError(t, blocks.Save(store, "alice", &types.BlockInfo{Height: 300}))
+
+	err := blocks.Save(store, "bob", &types.BlockInfo{Height: 5, ChainID: "jackal"})
+	require.EqualError(t, err, "Violates unique constraint on index blocks_chain_")
+
+	pk, err := byChain.Load(store, "jackal")
+	require.NoError(t, err)
+	assert.Equal(t, "dave", pk)
+
+	keys, err := byHeight.Prefix(store, 20, nil, 0)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"dave", "frank"}, keys)
+
+	keys = nil
+	err = byHeight.WalkPrefix(store, 20, nil, func(key string) (bool, error) {
+		keys = append(keys, key)
+		return false, nil
+	})
+	require.NoError(t, err)
+	assert.Equal(t, []string{"dave"}, keys)
+
+	// everything below height 100, in height order
+	entries, err := byHeight.Range(store, nil, Exclusive[uint64](100), nil, std.Ascending, 0)
+	require.NoError(t, err)
+	assert.Equal(t, []IndexEntry[uint64, string]{{10, "erin"}, {20, "dave"}, {20, "frank"}}, entries)
+
+	// paging through entries that share an index key
+	entries, err = byHeight.Range(store, Inclusive[uint64](10), nil, nil, std.Ascending, 2)
+	require.NoError(t, err)
+	require.Len(t, entries, 2)
+	entries, err = byHeight.Range(store, Inclusive[uint64](10), nil, &entries[1], std.Ascending, 2)
+	require.NoError(t, err)
+	assert.Equal(t, []IndexEntry[uint64, string]{{20, "frank"}, {300, "alice"}}, entries)
+
+	entries, err = byHeight.Range(store, nil, nil, &IndexEntry[uint64, string]{20, "frank"}, std.Descending, 0)
+	require.NoError(t, err)
+	assert.Equal(t, []IndexEntry[uint64, string]{{20, "dave"}, {10, "erin"}}, entries)
+
+	// moving an entry moves its index entries
+	_, err = blocks.Update(store, "dave", func(b *types.BlockInfo) (*types.BlockInfo, error) {
+		b.Height = 400
+		b.ChainID = ""
+		return b, nil
+	})
+	require.NoError(t, err)
+	keys, err = byHeight.Prefix(store, 20, nil, 0)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"frank"}, keys)
+	_, err = byChain.Load(store, "jackal")
+	assert.True(t, IsNotFound(err))
+
+	require.NoError(t, blocks.Remove(store, "alice"))
+	entries, err = byHeight.Range(store, Exclusive[uint64](20), nil, nil, std.Ascending, 0)
+	require.NoError(t, err)
+	assert.Equal(t, []IndexEntry[uint64, string]{{400, "dave"}}, entries)
+
+	unique, err := byChain.Range(store, nil, nil, std.Ascending, 0)
+	require.NoError(t, err)
+	assert.Empty(t, unique)
+}
@@ -0,0 +1,88 @@
+package storage
+
+import (
+	"github.com/CosmWasm/cosmwasm-go/std"
+)
+
+// Item stores a single value under a fixed key
+type Item[T any, P Codec[T]] struct {
+	key    []byte
+	format Format
+}
+
+func NewItem[T any, P Codec[T]](key string) Item[T, P] {
+	return Item[T, P]{key: []byte(key)}
+}
+
+// WithFormat returns the item writing its values in format, stored values are read in any format
+func (i Item[T, P]) WithFormat(format Format) Item[T, P] {
+	i.format = format
+	return i
+}
+
+// Key returns the raw storage key of the item
+func (i Item[T, P]) Key() []byte {
+	return i.key
+}
+
+// Load returns the stored value, or a NotFoundError if it was never saved
+func (i Item[T, P]) Load(storage std.Storage) (*T, error) {
+	data := storage.Get(i.key)
+	if data == nil {
+		return nil, NotFoundError{Name: string(i.key), Key: i.key}
+	}
+	return decode[T, P](data)
+}
+
+// MayLoad returns the stored value, or nil if it was never saved
+func (i Item[T, P]) MayLoad(storage std.Storage) (*T, error) {
+	data := storage.Get(i.key)
+	if data == nil {
+		return nil, nil
+	}
+	return decode[T, P](data)
+}
+
+func (i Item[T, P]) Exists(storage std.Storage) bool {
+	return storage.Get(i.key) != nil
+}
+
+func (i Item[T, P]) Save(storage std.Storage, value *T) error {
+	bz, err := encode[T, P](i.format, value)
+	if err != nil {
+		return err
+	}
+
+	storage.Set(i.key, bz)
+
+	return nil
+}
+
+// Update loads the value, nil if it was never saved, and saves what action returns.
+// Nothing is written if action fails
+func (i Item[T, P]) Update(storage std.Storage, action func(*T) (*T, error)) (*T, error) {
+	value, err := i.MayLoad(storage)
+	if err != nil {
+		return nil, err
+	}
+
+	value, err = action(value)
+	if err != nil {
+		return nil, err
+	}
+
+	err = i.Save(storage, value)
+	if err != nil {
+		return nil, err
+	}
+	return value, nil
+}
+
+func (i Item[T, P]) Remove(storage std.Storage) {
+	storage.Remove(i.key)
+}
+
+// QueryRaw loads the item of another contract with the same layout, or nil if it was never saved
+func (i Item[T, P]) QueryRaw(querier std.QuerierWrapper, contract string) (*T, error) {
+	return queryRaw[T, P](querier, contract, i.key)
+}
@@ -0,0 +1,55 @@
+package storage
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/CosmWasm/cosmwasm-go/std/mock"
+	"github.com/CosmWasm/cosmwasm-go/std/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestItem(t *testing.T) {
+	store := mock.Storage()
+	item := NewItem[types.BlockInfo]("block")
+
+	_, err := item.Load(store)
+	require.EqualError(t, err, `"block" not found`)
+	assert.True(t, IsNotFound(err))
+
+	block, err := item.MayLoad(store)
+	require.NoError(t, err)
+	assert.Nil(t, block)
+	assert.False(t, item.Exists(store))
+
+	require.NoError(t, item.Save(store, &types.BlockInfo{Height: 10}))
+	block, err = item.Load(store)
+	require.NoError(t, err)
+	assert.Equal(t, uint64(10), block.Height)
+
+	// the raw value is the JSON format marker and the tinyjson encoding
+	raw := store.Get(item.Key())
+	assert.Equal(t, byte(FormatJSON), raw[0])
+	assert.JSONEq(t, `{"height":10,"time":"0","chain_id":""}`, string(raw[1:]))
+
+	block, err = item.Update(store, func(b *types.BlockInfo) (*types.BlockInfo, error) {
+		b.Height++
+		return b, nil
+	})
+	require.NoError(t, err)
+	assert.Equal(t, uint64(11), block.Height)
+
+	// failed updates write nothing
+	_, err = item.Update(store, func(b *types.BlockInfo) (*types.BlockInfo, error) {
+		b.Height = 99
+		return nil, errors.New("boom")
+	})
+	require.EqualError(t, err, "boom")
+	block, err = item.Load(store)
+	require.NoError(t, err)
+	assert.Equal(t, uint64(11), block.Height)
+
+	item.Remove(store)
+	assert.False(t, item.Exists(store))
+}
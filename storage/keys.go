@@ -0,0 +1,235 @@
+package storage
+
+import (
+	"encoding/binary"
+	"errors"
+)
+
+// Key constrains map keys. Supported are string, Addr, []byte, the fixed size integers
+// and Pair or Triple of those; maps with any other key type panic when created.
+//
+// Keys are laid out as in cosmwasm storage-plus: the namespace and every tuple element
+// but the last are prefixed with their length as a big-endian uint16, so no key of one
+// map can collide with a key of another or with an Item
+type Key interface{}
+
+// Addr is a bech32 address used as a key, it's encoded like a string
+type Addr string
+
+// compositeKey is implemented by the tuple keys, compositeDecoder by pointers to them
+type compositeKey interface {
+	elements() [][]byte
+}
+
+type compositeDecoder interface {
+	decodeElements(bz []byte) error
+}
+
+// Pair keys a map by two values, ranging by A first
+type Pair[A Key, B Key] struct {
+	A A
+	B B
+}
+
+func NewPair[A Key, B Key](a A, b B) Pair[A, B] {
+	return Pair[A, B]{A: a, B: b}
+}
+
+func (p Pair[A, B]) elements() [][]byte {
+	return [][]byte{encodeElement(p.A), encodeElement(p.B)}
+}
+
+func (p *Pair[A, B]) decodeElements(bz []byte) error {
+	parts, err := splitKey(bz, 2)
+	if err != nil {
+		return err
+	}
+	p.A, err = decodeElement[A](parts[0])
+	if err != nil {
+		return err
+	}
+	p.B, err = decodeElement[B](parts[1])
+	return err
+}
+
+// Triple keys a map by three values, ranging by A, then B
+type Triple[A Key, B Key, C Key] struct {
+	A A
+	B B
+	C C
+}
+
+func NewTriple[A Key, B Key, C Key](a A, b B, c C) Triple[A, B, C] {
+	return Triple[A, B, C]{A: a, B: b, C: c}
+}
+
+func (t Triple[A, B, C]) elements() [][]byte {
+	return [][]byte{encodeElement(t.A), encodeElement(t.B), encodeElement(t.C)}
+}
+
+func (t *Triple[A, B, C]) decodeElements(bz []byte) error {
+	parts, err := splitKey(bz, 3)
+	if err != nil {
+		return err
+	}
+	t.A, err = decodeElement[A](parts[0])
+	if err != nil {
+		return err
+	}
+	t.B, err = decodeElement[B](parts[1])
+	if err != nil {
+		return err
+	}
+	t.C, err = decodeElement[C](parts[2])
+	return err
+}
+
+// lengthPrefixed prepends the length of bz as a big-endian uint16
+func lengthPrefixed(bz []byte) []byte {
+	if len(bz) > 0xffff {
+		panic("storage key element longer than 65535 bytes")
+	}
+	return append(binary.BigEndian.AppendUint16(make([]byte, 0, 2+len(bz)), uint16(len(bz))), bz...)
+}
+
+// splitKey cuts a composite key into its n elements, the last one isn't length-prefixed
+func splitKey(bz []byte, n int) ([][]byte, error) {
+	parts := make([][]byte, n)
+	for i := 0; i < n-1; i++ {
+		if len(bz) < 2 {
+			return nil, errors.New("invalid composite key")
+		}
+		size := int(binary.BigEndian.Uint16(bz))
+		if len(bz) < 2+size {
+			return nil, errors.New("invalid composite key")
+		}
+		parts[i] = bz[2 : 2+size]
+		bz = bz[2+size:]
+	}
+	parts[n-1] = bz
+	return parts, nil
+}
+
+// encodeKey encodes a key as it follows the namespace
+func encodeKey[K Key](key K) []byte {
+	composite, ok := any(key).(compositeKey)
+	if !ok {
+		return encodeElement(key)
+	}
+
+	elements := composite.elements()
+	var bz []byte
+	for _, element := range elements[:len(elements)-1] {
+		bz = append(bz, lengthPrefixed(element)...)
+	}
+	return append(bz, elements[len(elements)-1]...)
+}
+
+func decodeKey[K Key](bz []byte) (K, error) {
+	var key K
+	if composite, ok := any(&key).(compositeDecoder); ok {
+		err := composite.decodeElements(bz)
+		return key, err
+	}
+	return decodeElement[K](bz)
+}
+
+// encodeElement encodes a single key value.
+// Integers are big-endian so they range in numeric order, signed ones with the sign bit flipped
+func encodeElement[K Key](key K) []byte {
+	switch k := any(key).(type) {
+	case string:
+		return []byte(k)
+	case Addr:
+		return []byte(k)
+	case []byte:
+		return append([]byte{}, k...)
+	case uint8:
+		return []byte{k}
+	case uint16:
+		return binary.BigEndian.AppendUint16(nil, k)
+	case uint32:
+		return binary.BigEndian.AppendUint32(nil, k)
+	case uint64:
+		return binary.BigEndian.AppendUint64(nil, k)
+	case int8:
+		return []byte{uint8(k) ^ 0x80}
+	case int16:
+		return binary.BigEndian.AppendUint16(nil, uint16(k)^0x8000)
+	case int32:
+		return binary.BigEndian.AppendUint32(nil, uint32(k)^0x80000000)
+	case int64:
+		return binary.BigEndian.AppendUint64(nil, uint64(k)^0x8000000000000000)
+	default:
+		panic("unsupported storage key type")
+	}
+}
+
+func decodeElement[K Key](bz []byte) (K, error) {
+	var key K
+
+	fixed := func(n int) error {
+		if len(bz) != n {
+			return errors.New("invalid integer key")
+		}
+		return nil
+	}
+
+	var err error
+	switch k := any(&key).(type) {
+	case *string:
+		*k = string(bz)
+	case *Addr:
+		*k = Addr(bz)
+	case *[]byte:
+		*k = append([]byte{}, bz...)
+	case *uint8:
+		if err = fixed(1); err == nil {
+			*k = bz[0]
+		}
+	case *uint16:
+		if err = fixed(2); err == nil {
+			*k = binary.BigEndian.Uint16(bz)
+		}
+	case *uint32:
+		if err = fixed(4); err == nil {
+			*k = binary.BigEndian.Uint32(bz)
+		}
+	case *uint64:
+		if err = fixed(8); err == nil {
+			*k = binary.BigEndian.Uint64(bz)
+		}
+	case *int8:
+		if err = fixed(1); err == nil {
+			*k = int8(bz[0] ^ 0x80)
+		}
+	case *int16:
+		if err = fixed(2); err == nil {
+			*k = int16(binary.BigEndian.Uint16(bz) ^ 0x8000)
+		}
+	case *int32:
+		if err = fixed(4); err == nil {
+			*k = int32(binary.BigEndian.Uint32(bz) ^ 0x80000000)
+		}
+	case *int64:
+		if err = fixed(8); err == nil {
+			*k = int64(binary.BigEndian.Uint64(bz) ^ 0x8000000000000000)
+		}
+	default:
+		panic("unsupported storage key type")
+	}
+	return key, err
+}
+
+// prefixEnd returns the first key after every key starting with prefix, nil if there is none
+func prefixEnd(prefix []byte) []byte {
+	end := append([]byte{}, prefix...)
+	for len(end) > 0 {
+		if end[len(end)-1] != 0xff {
+			end[len(end)-1]++
+			return end
+		}
+		end = end[:len(end)-1]
+	}
+	return nil
+}
@@ -0,0 +1,79 @@
+package storage
+
+import (
+	"testing"
+
+	"github.com/CosmWasm/cosmwasm-go/std"
+	"github.com/CosmWasm/cosmwasm-go/std/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// vectors from cosmwasm storage-plus, so clients of either can read the other's keys
+func TestMapKey(t *testing.T) {
+	assert.Equal(t, []byte("\x00\x06peoplejohn"), MapKey("people", "john"))
+	assert.Equal(t, []byte("\x00\x06peoplejohn"), MapKey("people", Addr("john")))
+	assert.Equal(t, []byte("\x00\x05allow\x00\x05ownerspender"), MapKey("allow", NewPair("owner", "spender")))
+	assert.Equal(t, []byte("\x00\x06triple\x00\x01a\x00\x02bcd"), MapKey("triple", NewTriple("a", "bc", "d")))
+	assert.Equal(t, []byte("\x00\x03num\x00\x00\x00\x00\x00\x00\x01\x00"), MapKey("num", uint64(256)))
+	assert.Equal(t, []byte("\x00\x03num\x7f\xff\xff\xff"), MapKey("num", int32(-1)))
+	assert.Equal(t, []byte("\x00\x03num\x80\x00"), MapKey("num", int16(0)))
+
+	m := NewMap[Pair[Addr, uint32], types.Coin]("allow")
+	assert.Equal(t, MapKey("allow", NewPair[Addr]("owner", uint32(1))), m.Key(NewPair[Addr]("owner", uint32(1))))
+}
+
+func TestKeyRoundTrip(t *testing.T) {
+	pair := NewPair[Addr, int64]("owner", -5)
+	decoded, err := decodeKey[Pair[Addr, int64]](encodeKey(pair))
+	require.NoError(t, err)
+	assert.Equal(t, pair, decoded)
+
+	triple := NewTriple[string, uint8, []byte]("a", 7, []byte{0, 1})
+	decodedTriple, err := decodeKey[Triple[string, uint8, []byte]](encodeKey(triple))
+	require.NoError(t, err)
+	assert.Equal(t, triple, decodedTriple)
+
+	_, err = decodeKey[uint64]([]byte{1, 2})
+	assert.Error(t, err)
+	_, err = decodeKey[Pair[string, string]]([]byte{0, 9, 'a'})
+	assert.Error(t, err)
+}
+
+func TestUnsupportedKey(t *testing.T) {
+	assert.Panics(t, func() { NewMap[float64, types.Coin]("floats") })
+	assert.Panics(t, func() { NewMap[Pair[string, bool], types.Coin]("bools") })
+}
+
+// rawQuerier answers raw queries from a map of keys
+type rawQuerier map[string][]byte
+
+func (q rawQuerier) RawQuery(request []byte) ([]byte, error) {
+	var query types.QueryRequest
+	if err := query.UnmarshalJSON(request); err != nil {
+		return nil, err
+	}
+	return q[string(query.Wasm.Raw.Key)], nil
+}
+
+func TestQueryRaw(t *testing.T) {
+	balances := NewMap[Addr, types.Coin]("balances")
+	config := NewItem[types.Coin]("config")
+
+	querier := std.QuerierWrapper{Querier: rawQuerier{
+		"\x00\x08balancesdave": []byte(`{"denom":"ujkl","amount":"10"}`),
+		"config":               []byte(`{"denom":"ujkl","amount":"20"}`),
+	}}
+
+	c, err := balances.QueryRaw(querier, "contract", "dave")
+	require.NoError(t, err)
+	assert.Equal(t, "10", c.Amount.String())
+
+	c, err = balances.QueryRaw(querier, "contract", "erin")
+	require.NoError(t, err)
+	assert.Nil(t, c)
+
+	c, err = config.QueryRaw(querier, "contract")
+	require.NoError(t, err)
+	assert.Equal(t, "20", c.Amount.String())
+}
@@ -0,0 +1,220 @@
+package storage
+
+import (
+	"errors"
+
+	"github.com/CosmWasm/cosmwasm-go/std"
+)
+
+// Map stores values under the length-prefixed namespace followed by the encoded key
+type Map[K Key, V any, P Codec[V]] struct {
+	name      string
+	namespace []byte
+	format    Format
+}
+
+// NewMap panics if K isn't a supported key type
+func NewMap[K Key, V any, P Codec[V]](namespace string) Map[K, V, P] {
+	var zero K
+	encodeKey(zero)
+
+	return Map[K, V, P]{
+		name:      namespace,
+		namespace: lengthPrefixed([]byte(namespace)),
+	}
+}
+
+// WithFormat returns the map writing its values in format, stored values are read in any format
+func (m Map[K, V, P]) WithFormat(format Format) Map[K, V, P] {
+	m.format = format
+	return m
+}
+
+// Record is a key and its value, as returned by Range
+type Record[K Key, V any] struct {
+	Key   K
+	Value *V
+}
+
+// Key returns the raw storage key of the entry
+func (m Map[K, V, P]) Key(key K) []byte {
+	return append(append([]byte{}, m.namespace...), encodeKey(key)...)
+}
+
+// MapKey returns the raw storage key of an entry in a Map with the namespace,
+// for clients building raw queries without the Map at hand
+func MapKey[K Key](namespace string, key K) []byte {
+	return append(lengthPrefixed([]byte(namespace)), encodeKey(key)...)
+}
+
+// Load returns the stored value, or a NotFoundError if it was never saved
+func (m Map[K, V, P]) Load(storage std.Storage, key K) (*V, error) {
+	rawKey := m.Key(key)
+	data := storage.Get(rawKey)
+	if data == nil {
+		return nil, NotFoundError{Name: m.name, Key: rawKey}
+	}
+	return decode[V, P](data)
+}
+
+// MayLoad returns the stored value, or nil if it was never saved
+func (m Map[K, V, P]) MayLoad(storage std.Storage, key K) (*V, error) {
+	data := storage.Get(m.Key(key))
+	if data == nil {
+		return nil, nil
+	}
+	return decode[V, P](data)
+}
+
+func (m Map[K, V, P]) Has(storage std.Storage, key K) bool {
+	return storage.Get(m.Key(key)) != nil
+}
+
+func (m Map[K, V, P]) Save(storage std.Storage, key K, value *V) error {
+	bz, err := encode[V, P](m.format, value)
+	if err != nil {
+		return err
+	}
+
+	storage.Set(m.Key(key), bz)
+
+	return nil
+}
+
+// Update loads the value, nil if it was never saved, and saves what action returns.
+// Nothing is written if action fails
+func (m Map[K, V, P]) Update(storage std.Storage, key K, action func(*V) (*V, error)) (*V, error) {
+	value, err := m.MayLoad(storage, key)
+	if err != nil {
+		return nil, err
+	}
+
+	value, err = action(value)
+	if err != nil {
+		return nil, err
+	}
+
+	err = m.Save(storage, key, value)
+	if err != nil {
+		return nil, err
+	}
+	return value, nil
+}
+
+func (m Map[K, V, P]) Remove(storage std.Storage, key K) {
+	storage.Remove(m.Key(key))
+}
+
+// Range returns up to limit entries between min and max, nil bounds are open and a zero limit returns every entry
+func (m Map[K, V, P]) Range(storage std.Storage, min *Bound[K], max *Bound[K], order std.Order, limit int) ([]Record[K, V], error) {
+	records := []Record[K, V]{}
+	err := iterate(storage, m.namespace, m.bounds(min, max), order, limit, func(rawKey []byte, data []byte) error {
+		key, err := decodeKey[K](rawKey)
+		if err != nil {
+			return err
+		}
+		value, err := decode[V, P](data)
+		if err != nil {
+			return err
+		}
+		records = append(records, Record[K, V]{Key: key, Value: value})
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return records, nil
+}
+
+// Walk calls fn with the entries between min and max in order until it returns false,
+// decoding one value at a time so filtered pages stop reading once they're full
+func (m Map[K, V, P]) Walk(storage std.Storage, min *Bound[K], max *Bound[K], order std.Order, fn func(Record[K, V]) (bool, error)) error {
+	return iterate(storage, m.namespace, m.bounds(min, max), order, 0, func(rawKey []byte, data []byte) error {
+		key, err := decodeKey[K](rawKey)
+		if err != nil {
+			return err
+		}
+		value, err := decode[V, P](data)
+		if err != nil {
+			return err
+		}
+		return walkStep(fn(Record[K, V]{Key: key, Value: value}))
+	})
+}
+
+// Keys returns up to limit keys between min and max without decoding the values
+func (m Map[K, V, P]) Keys(storage std.Storage, min *Bound[K], max *Bound[K], order std.Order, limit int) ([]K, error) {
+	keys := []K{}
+	err := iterate(storage, m.namespace, m.bounds(min, max), order, limit, func(rawKey []byte, _ []byte) error {
+		key, err := decodeKey[K](rawKey)
+		if err != nil {
+			return err
+		}
+		keys = append(keys, key)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return keys, nil
+}
+
+// bounds converts min and max to raw start and end keys
+func (m Map[K, V, P]) bounds(min *Bound[K], max *Bound[K]) [2][]byte {
+	var raw [2][]byte
+	if min != nil {
+		raw[0] = rawMin(m.Key(min.Key), min.Exclusive)
+	}
+	if max != nil {
+		raw[1] = rawMax(m.Key(max.Key), !max.Exclusive)
+	}
+	return raw
+}
+
+// errStopWalk ends an iteration early without failing it
+var errStopWalk = errors.New("stop walk")
+
+// walkStep turns the result of a Walk callback into the error that continues or stops iterate
+func walkStep(more bool, err error) error {
+	if err == nil && !more {
+		return errStopWalk
+	}
+	return err
+}
+
+// iterate calls fn with the keys, stripped of prefix, and values of up to limit entries under prefix.
+// Missing bounds default to the whole prefix, and fn can return errStopWalk to stop early
+func iterate(storage std.Storage, prefix []byte, bounds [2][]byte, order std.Order, limit int, fn func([]byte, []byte) error) error {
+	start, end := bounds[0], bounds[1]
+	if start == nil {
+		start = prefix
+	}
+	if end == nil {
+		end = prefixEnd(prefix)
+	}
+
+	iter := storage.Range(start, end, order)
+	for count := 0; limit == 0 || count < limit; count++ {
+		rawKey, data, err := iter.Next()
+		if err == std.ErrIteratorDone {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+
+		err = fn(rawKey[len(prefix):], data)
+		if err == errStopWalk {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// QueryRaw loads the entry of another contract with the same layout, or nil if it was never saved
+func (m Map[K, V, P]) QueryRaw(querier std.QuerierWrapper, contract string, key K) (*V, error) {
+	return queryRaw[V, P](querier, contract, m.Key(key))
+}
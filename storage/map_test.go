@@ -0,0 +1,190 @@
+package storage
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/CosmWasm/cosmwasm-go/std"
+	"github.com/CosmWasm/cosmwasm-go/std/math"
+	"github.com/CosmWasm/cosmwasm-go/std/mock"
+	"github.com/CosmWasm/cosmwasm-go/std/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func coin(amount uint64) *types.Coin {
+	c := types.NewCoin(math.NewUint128FromUint64(amount), "ujkl")
+	return &c
+}
+
+func TestMap(t *testing.T) {
+	store := mock.Storage()
+	balances := NewMap[string, types.Coin]("balances")
+
+	_, err := balances.Load(store, "dave")
+	require.EqualError(t, err, `"balances" not found`)
+	assert.False(t, balances.Has(store, "dave"))
+
+	require.NoError(t, balances.Save(store, "dave", coin(10)))
+	require.NoError(t, balances.Save(store, "erin", coin(20)))
+	require.NoError(t, balances.Save(store, "alice", coin(30)))
+	// outside the namespace
+	store.Set([]byte("\x00\x08balancet"), []byte("{}"))
+	store.Set([]byte("\x00\x09balancesx"), []byte("{}"))
+
+	c, err := balances.Load(store, "dave")
+	require.NoError(t, err)
+	assert.Equal(t, "10", c.Amount.String())
+
+	c, err = balances.Update(store, "frank", func(c *types.Coin) (*types.Coin, error) {
+		assert.Nil(t, c)
+		return coin(5), nil
+	})
+	require.NoError(t, err)
+	assert.Equal(t, "5", c.Amount.String())
+
+	records, err := balances.Range(store, nil, nil, std.Ascending, 0)
+	require.NoError(t, err)
+	require.Len(t, records, 4)
+	assert.Equal(t, "alice", records[0].Key)
+	assert.Equal(t, "30", records[0].Value.Amount.String())
+	assert.Equal(t, "frank", records[3].Key)
+
+	keys, err := balances.Keys(store, Inclusive("b"), Exclusive("erin"), std.Descending, 0)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"dave"}, keys)
+
+	keys, err = balances.Keys(store, Exclusive("alice"), Inclusive("erin"), std.Ascending, 0)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"dave", "erin"}, keys)
+
+	// pages continue after the last key
+	keys, err = balances.Keys(store, nil, nil, std.Ascending, 2)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"alice", "dave"}, keys)
+	keys, err = balances.Keys(store, Exclusive(keys[1]), nil, std.Ascending, 2)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"erin", "frank"}, keys)
+
+	balances.Remove(store, "dave")
+	keys, err = balances.Keys(store, nil, nil, std.Ascending, 0)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"alice", "erin", "frank"}, keys)
+}
+
+func TestMapWalk(t *testing.T) {
+	store := mock.Storage()
+	balances := NewMap[string, types.Coin]("balances")
+
+	require.NoError(t, balances.Save(store, "alice", coin(30)))
+	require.NoError(t, balances.Save(store, "dave", coin(10)))
+	require.NoError(t, balances.Save(store, "erin", coin(20)))
+	// a walk that stops before this value never decodes it
+	store.Set(balances.Key("frank"), []byte("not json"))
+
+	var keys []string
+	err := balances.Walk(store, Exclusive("alice"), nil, std.Ascending, func(record Record[string, types.Coin]) (bool, error) {
+		keys = append(keys, record.Key)
+		return len(keys) < 2, nil
+	})
+	require.NoError(t, err)
+	assert.Equal(t, []string{"dave", "erin"}, keys)
+
+	keys = nil
+	err = balances.Walk(store, nil, nil, std.Descending, func(record Record[string, types.Coin]) (bool, error) {
+		keys = append(keys, record.Key)
+		return true, nil
+	})
+	assert.Error(t, err)
+	assert.Empty(t, keys)
+
+	err = balances.Walk(store, nil, Exclusive("frank"), std.Ascending, func(record Record[string, types.Coin]) (bool, error) {
+		return true, errors.New("failed")
+	})
+	require.EqualError(t, err, "failed")
+}
+
+func TestMapIntKeys(t *testing.T) {
+	store := mock.Storage()
+	requests := NewMap[uint64, types.Coin]("requests")
+
+	// big-endian keys range numerically, not lexically
+	for _, id := range []uint64{256, 2, 10, 1} {
+		require.NoError(t, requests.Save(store, id, coin(id)))
+	}
+
+	keys, err := requests.Keys(store, nil, nil, std.Ascending, 0)
+	require.NoError(t, err)
+	assert.Equal(t, []uint64{1, 2, 10, 256}, keys)
+
+	records, err := requests.Range(store, Inclusive[uint64](3), nil, std.Descending, 0)
+	require.NoError(t, err)
+	require.Len(t, records, 2)
+	assert.Equal(t, uint64(256), records[0].Key)
+	assert.Equal(t, "10", records[1].Value.Amount.String())
+}
+
+func TestMapNamespaces(t *testing.T) {
+	store := mock.Storage()
+	balances := NewMap[string, types.Coin]("balances")
+	balance := NewMap[string, types.Coin]("balance")
+
+	// without the length prefix both would be stored at "balancesdave"
+	require.NoError(t, balances.Save(store, "dave", coin(10)))
+	require.NoError(t, balance.Save(store, "sdave", coin(20)))
+
+	c, err := balances.Load(store, "dave")
+	require.NoError(t, err)
+	assert.Equal(t, "10", c.Amount.String())
+
+	keys, err := balance.Keys(store, nil, nil, std.Ascending, 0)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"sdave"}, keys)
+}
+
+func TestMapCompositeKeys(t *testing.T) {
+	store := mock.Storage()
+	grants := NewMap[Pair[Addr, string], types.Coin]("grants")
+
+	for _, key := range []Pair[Addr, string]{
+		NewPair[Addr]("bob", "ujkl"),
+		NewPair[Addr]("alice", "uatom"),
+		NewPair[Addr]("al", "ujkl"),
+		NewPair[Addr]("alice", "ujkl"),
+	} {
+		require.NoError(t, grants.Save(store, key, coin(1)))
+	}
+
+	// the first element is length-prefixed, so shorter addresses come first
+	keys, err := grants.Keys(store, nil, nil, std.Ascending, 0)
+	require.NoError(t, err)
+	assert.Equal(t, []Pair[Addr, string]{
+		NewPair[Addr]("al", "ujkl"),
+		NewPair[Addr]("bob", "ujkl"),
+		NewPair[Addr]("alice", "uatom"),
+		NewPair[Addr]("alice", "ujkl"),
+	}, keys)
+
+	keys, err = grants.Keys(store, Exclusive(NewPair[Addr]("alice", "uatom")), nil, std.Ascending, 0)
+	require.NoError(t, err)
+	assert.Equal(t, []Pair[Addr, string]{NewPair[Addr]("alice", "ujkl")}, keys)
+}
+
+func TestMapSignedKeys(t *testing.T) {
+	store := mock.Storage()
+	deltas := NewMap[int64, types.Coin]("deltas")
+
+	for _, id := range []int64{5, -1, 0, -300, 300} {
+		require.NoError(t, deltas.Save(store, id, coin(1)))
+	}
+
+	keys, err := deltas.Keys(store, nil, nil, std.Ascending, 0)
+	require.NoError(t, err)
+	assert.Equal(t, []int64{-300, -1, 0, 5, 300}, keys)
+}
+
+func TestPrefixEnd(t *testing.T) {
+	assert.Equal(t, []byte("ab"), prefixEnd([]byte("aa")))
+	assert.Equal(t, []byte{0x01}, prefixEnd([]byte{0x00, 0xff}))
+	assert.Nil(t, prefixEnd([]byte{0xff, 0xff}))
+}
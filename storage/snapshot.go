@@ -0,0 +1,232 @@
+package storage
+
+import (
+	"encoding/binary"
+
+	"github.com/CosmWasm/cosmwasm-go/std"
+)
+
+// changelog keeps, per entry and height, the raw value the entry held before its first change at that height
+type changelog struct {
+	namespace []byte
+}
+
+// prefix returns the raw prefix of the changes of pk, which are keyed by the (pk, height) pair
+func (c changelog) prefix(pk []byte) []byte {
+	return append(append([]byte{}, c.namespace...), lengthPrefixed(pk)...)
+}
+
+// record stores old as the value before height, unless an earlier change at height already did
+func (c changelog) record(storage std.Storage, pk []byte, height uint64, old []byte) {
+	key := binary.BigEndian.AppendUint64(c.prefix(pk), height)
+	if storage.Get(key) != nil {
+		return
+	}
+
+	// a leading byte tells a missing value apart from an empty one
+	if old == nil {
+		storage.Set(key, []byte{0})
+	} else {
+		storage.Set(key, append([]byte{1}, old...))
+	}
+}
+
+// at returns the raw value the entry held at the start of height.
+// found is false if the entry didn't change since, in which case the current value applies
+func (c changelog) at(storage std.Storage, pk []byte, height uint64) (old []byte, found bool, err error) {
+	prefix := c.prefix(pk)
+	start := binary.BigEndian.AppendUint64(append([]byte{}, prefix...), height)
+
+	iter := storage.Range(start, prefixEnd(prefix), std.Ascending)
+	_, data, err := iter.Next()
+	if err == std.ErrIteratorDone {
+		return nil, false, nil
+	}
+	if err != nil {
+		return nil, false, err
+	}
+
+	if len(data) == 0 || data[0] == 0 {
+		return nil, true, nil
+	}
+	return data[1:], true, nil
+}
+
+// loadAt decodes the value the entry held at the start of height, nil if it didn't exist
+func loadAt[V any, P Codec[V]](storage std.Storage, c changelog, rawKey []byte, pk []byte, height uint64) (*V, error) {
+	data, found, err := c.at(storage, pk, height)
+	if err != nil {
+		return nil, err
+	}
+	if !found {
+		data = storage.Get(rawKey)
+	}
+	if data == nil {
+		return nil, nil
+	}
+	return decode[V, P](data)
+}
+
+// SnapshotItem is an Item that remembers its past values by block height
+type SnapshotItem[T any, P Codec[T]] struct {
+	Item[T, P]
+	changelog changelog
+}
+
+func NewSnapshotItem[T any, P Codec[T]](key string, changelogNamespace string) SnapshotItem[T, P] {
+	return SnapshotItem[T, P]{
+		Item:      NewItem[T, P](key),
+		changelog: changelog{namespace: lengthPrefixed([]byte(changelogNamespace))},
+	}
+}
+
+// WithFormat is Item.WithFormat, keeping the changelog
+func (i SnapshotItem[T, P]) WithFormat(format Format) SnapshotItem[T, P] {
+	i.Item = i.Item.WithFormat(format)
+	return i
+}
+
+func (i SnapshotItem[T, P]) Save(storage std.Storage, value *T, height uint64) error {
+	i.changelog.record(storage, nil, height, storage.Get(i.key))
+	return i.Item.Save(storage, value)
+}
+
+func (i SnapshotItem[T, P]) Update(storage std.Storage, height uint64, action func(*T) (*T, error)) (*T, error) {
+	value, err := i.MayLoad(storage)
+	if err != nil {
+		return nil, err
+	}
+
+	value, err = action(value)
+	if err != nil {
+		return nil, err
+	}
+
+	err = i.Save(storage, value, height)
+	if err != nil {
+		return nil, err
+	}
+	return value, nil
+}
+
+func (i SnapshotItem[T, P]) Remove(storage std.Storage, height uint64) {
+	i.changelog.record(storage, nil, height, storage.Get(i.key))
+	i.Item.Remove(storage)
+}
+
+// MayLoadAt returns the value at the start of height, before that block's changes, or nil if there was none
+func (i SnapshotItem[T, P]) MayLoadAt(storage std.Storage, height uint64) (*T, error) {
+	return loadAt[T, P](storage, i.changelog, i.key, nil, height)
+}
+
+// SnapshotMap is a Map that remembers the past values of its entries by block height
+type SnapshotMap[K Key, V any, P Codec[V]] struct {
+	Map[K, V, P]
+	changelog changelog
+}
+
+func NewSnapshotMap[K Key, V any, P Codec[V]](namespace string, changelogNamespace string) SnapshotMap[K, V, P] {
+	return SnapshotMap[K, V, P]{
+		Map:       NewMap[K, V, P](namespace),
+		changelog: changelog{namespace: lengthPrefixed([]byte(changelogNamespace))},
+	}
+}
+
+// WithFormat is Map.WithFormat, keeping the changelog
+func (m SnapshotMap[K, V, P]) WithFormat(format Format) SnapshotMap[K, V, P] {
+	m.Map = m.Map.WithFormat(format)
+	return m
+}
+
+func (m SnapshotMap[K, V, P]) Save(storage std.Storage, key K, value *V, height uint64) error {
+	m.changelog.record(storage, encodeKey(key), height, storage.Get(m.Key(key)))
+	return m.Map.Save(storage, key, value)
+}
+
+func (m SnapshotMap[K, V, P]) Update(storage std.Storage, key K, height uint64, action func(*V) (*V, error)) (*V, error) {
+	value, err := m.MayLoad(storage, key)
+	if err != nil {
+		return nil, err
+	}
+
+	value, err = action(value)
+	if err != nil {
+		return nil, err
+	}
+
+	err = m.Save(storage, key, value, height)
+	if err != nil {
+		return nil, err
+	}
+	return value, nil
+}
+
+func (m SnapshotMap[K, V, P]) Remove(storage std.Storage, key K, height uint64) {
+	m.changelog.record(storage, encodeKey(key), height, storage.Get(m.Key(key)))
+	m.Map.Remove(storage, key)
+}
+
+// MayLoadAt returns the entry's value at the start of height, before that block's changes, or nil if there was none
+func (m SnapshotMap[K, V, P]) MayLoadAt(storage std.Storage, key K, height uint64) (*V, error) {
+	return loadAt[V, P](storage, m.changelog, m.Key(key), encodeKey(key), height)
+}
+
+// IndexedSnapshotMap is an IndexedMap that remembers the past values of its entries by block height.
+// Indexes only cover the current values
+type IndexedSnapshotMap[K Key, V any, P Codec[V]] struct {
+	IndexedMap[K, V, P]
+	changelog changelog
+}
+
+func NewIndexedSnapshotMap[K Key, V any, P Codec[V]](namespace string, changelogNamespace string, indexes ...Index[V]) IndexedSnapshotMap[K, V, P] {
+	return IndexedSnapshotMap[K, V, P]{
+		IndexedMap: NewIndexedMap[K, V, P](namespace, indexes...),
+		changelog:  changelog{namespace: lengthPrefixed([]byte(changelogNamespace))},
+	}
+}
+
+// WithFormat is Map.WithFormat, keeping the changelog
+func (m IndexedSnapshotMap[K, V, P]) WithFormat(format Format) IndexedSnapshotMap[K, V, P] {
+	m.IndexedMap = m.IndexedMap.WithFormat(format)
+	return m
+}
+
+func (m IndexedSnapshotMap[K, V, P]) Save(storage std.Storage, key K, value *V, height uint64) error {
+	old := storage.Get(m.Key(key))
+
+	err := m.IndexedMap.Save(storage, key, value)
+	if err != nil {
+		return err
+	}
+
+	m.changelog.record(storage, encodeKey(key), height, old)
+	return nil
+}
+
+func (m IndexedSnapshotMap[K, V, P]) Update(storage std.Storage, key K, height uint64, action func(*V) (*V, error)) (*V, error) {
+	value, err := m.MayLoad(storage, key)
+	if err != nil {
+		return nil, err
+	}
+
+	value, err = action(value)
+	if err != nil {
+		return nil, err
+	}
+
+	err = m.Save(storage, key, value, height)
+	if err != nil {
+		return nil, err
+	}
+	return value, nil
+}
+
+func (m IndexedSnapshotMap[K, V, P]) Remove(storage std.Storage, key K, height uint64) error {
+	m.changelog.record(storage, encodeKey(key), height, storage.Get(m.Key(key)))
+	return m.IndexedMap.Remove(storage, key)
+}
+
+// MayLoadAt returns the entry's value at the start of height, before that block's changes, or nil if there was none
+func (m IndexedSnapshotMap[K, V, P]) MayLoadAt(storage std.Storage, key K, height uint64) (*V, error) {
+	return loadAt[V, P](storage, m.changelog, m.Key(key), encodeKey(key), height)
+}
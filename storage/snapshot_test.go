@@ -0,0 +1,98 @@
+package storage
+
+import (
+	"testing"
+
+	"github.com/CosmWasm/cosmwasm-go/std/mock"
+	"github.com/CosmWasm/cosmwasm-go/std/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSnapshotItem(t *testing.T) {
+	store := mock.Storage()
+	item := NewSnapshotItem[types.BlockInfo]("block", "block_changelog")
+
+	heightAt := func(height uint64) *uint64 {
+		block, err := item.MayLoadAt(store, height)
+		require.NoError(t, err)
+		if block == nil {
+			return nil
+		}
+		return &block.Height
+	}
+	ptr := func(v uint64) *uint64 { return &v }
+
+	require.NoError(t, item.Save(store, &types.BlockInfo{Height: 1}, 10))
+	// a second change in the same block keeps the value from before the block
+	require.NoError(t, item.Save(store, &types.BlockInfo{Height: 2}, 10))
+	require.NoError(t, item.Save(store, &types.BlockInfo{Height: 3}, 20))
+	item.Remove(store, 30)
+	require.NoError(t, item.Save(store, &types.BlockInfo{Height: 4}, 40))
+
+	assert.Nil(t, heightAt(5))
+	assert.Nil(t, heightAt(10))
+	assert.Equal(t, ptr(2), heightAt(11))
+	assert.Equal(t, ptr(2), heightAt(20))
+	assert.Equal(t, ptr(3), heightAt(25))
+	assert.Nil(t, heightAt(35))
+	assert.Equal(t, ptr(4), heightAt(41))
+	assert.Equal(t, ptr(4), heightAt(1000))
+}
+
+func TestSnapshotMap(t *testing.T) {
+	store := mock.Storage()
+	balances := NewSnapshotMap[string, types.Coin]("balances_", "balances_changelog_")
+
+	require.NoError(t, balances.Save(store, "dave", coin(10), 10))
+	require.NoError(t, balances.Save(store, "da", coin(99), 10))
+	_, err := balances.Update(store, "dave", 20, func(c *types.Coin) (*types.Coin, error) {
+		c.Amount = c.Amount.Add64(5)
+		return c, nil
+	})
+	require.NoError(t, err)
+
+	c, err := balances.MayLoadAt(store, "dave", 10)
+	require.NoError(t, err)
+	assert.Nil(t, c)
+
+	c, err = balances.MayLoadAt(store, "dave", 15)
+	require.NoError(t, err)
+	assert.Equal(t, "10", c.Amount.String())
+
+	c, err = balances.MayLoadAt(store, "dave", 21)
+	require.NoError(t, err)
+	assert.Equal(t, "15", c.Amount.String())
+
+	// keys sharing a prefix keep separate changelogs
+	c, err = balances.MayLoadAt(store, "da", 15)
+	require.NoError(t, err)
+	assert.Equal(t, "99", c.Amount.String())
+}
+
+func TestIndexedSnapshotMap(t *testing.T) {
+	store := mock.Storage()
+	byDenom := NewMultiIndex[string, string]("coins_denom_", func(c *types.Coin) []string {
+		return []string{c.Denom}
+	})
+	coins := NewIndexedSnapshotMap[string, types.Coin]("coins_", "coins_changelog_", byDenom)
+
+	require.NoError(t, coins.Save(store, "dave", coin(10), 10))
+	require.NoError(t, coins.Remove(store, "dave", 20))
+
+	keys, err := byDenom.Prefix(store, "ujkl", nil, 0)
+	require.NoError(t, err)
+	assert.Empty(t, keys)
+
+	c, err := coins.MayLoadAt(store, "dave", 15)
+	require.NoError(t, err)
+	assert.Equal(t, "10", c.Amount.String())
+
+	c, err = coins.MayLoadAt(store, "dave", 20)
+	require.NoError(t, err)
+	assert.Equal(t, "10", c.Amount.String())
+
+	c, err = coins.MayLoadAt(store, "dave", 21)
+	require.NoError(t, err)
+	assert.Nil(t, c)
+}
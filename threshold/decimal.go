@@ -0,0 +1,141 @@
+package threshold
+
+import (
+	"errors"
+	"strings"
+
+	"github.com/CosmWasm/cosmwasm-go/std/math"
+	"github.com/CosmWasm/tinyjson/jlexer"
+	"github.com/CosmWasm/tinyjson/jwriter"
+)
+
+// DecimalPlaces is the number of fractional digits of a Decimal
+const DecimalPlaces = 18
+
+// decimalFractional is 10^DecimalPlaces, the atomics of one
+const decimalFractional = uint64(1_000_000_000_000_000_000)
+
+var errInvalidDecimal = errors.New("Invalid decimal")
+
+// Decimal is a fixed-point number with 18 fractional digits, the cosmwasm Decimal.
+// It's encoded as a string without trailing zeros, like "0.5" or "1"
+//
+//tinyjson:skip
+type Decimal struct {
+	atomics math.Uint128
+}
+
+// NewDecimal returns the decimal atomics / 10^18
+func NewDecimal(atomics math.Uint128) Decimal {
+	return Decimal{atomics: atomics}
+}
+
+func DecimalOne() Decimal {
+	return Decimal{atomics: math.NewUint128FromUint64(decimalFractional)}
+}
+
+// DecimalPercent returns percent / 100
+func DecimalPercent(percent uint64) Decimal {
+	return Decimal{atomics: math.NewUint128FromUint64(percent).Mul64(decimalFractional / 100)}
+}
+
+// ParseDecimal reads a decimal like "1", "0.5" or "0.000000000000000001"
+func ParseDecimal(s string) (Decimal, error) {
+	whole, fraction, hasFraction := strings.Cut(s, ".")
+	if len(whole) == 0 || hasFraction && (len(fraction) == 0 || len(fraction) > DecimalPlaces) {
+		return Decimal{}, errInvalidDecimal
+	}
+
+	var atomics math.Uint128
+	err := atomics.FromString(whole + fraction + strings.Repeat("0", DecimalPlaces-len(fraction)))
+	if err != nil {
+		return Decimal{}, errInvalidDecimal
+	}
+	return Decimal{atomics: atomics}, nil
+}
+
+// Atomics returns the decimal times 10^18
+func (d Decimal) Atomics() math.Uint128 {
+	return d.atomics
+}
+
+func (d Decimal) IsZero() bool {
+	return d.atomics.IsZero()
+}
+
+// Cmp returns -1, 0 or 1 as d is less than, equal to or greater than other
+func (d Decimal) Cmp(other Decimal) int {
+	return d.atomics.Cmp(other.atomics)
+}
+
+// Sub returns d - other, or an error if other is greater
+func (d Decimal) Sub(other Decimal) (Decimal, error) {
+	atomics, err := d.atomics.SafeSub(other.atomics)
+	return Decimal{atomics: atomics}, err
+}
+
+// MulCeil returns v * d rounded up, which fits as long as d is at most one
+func (d Decimal) MulCeil(v uint64) (uint64, error) {
+	product, err := math.NewUint128FromUint64(v).SafeMul(d.atomics)
+	if err != nil {
+		return 0, err
+	}
+
+	q, r := product.QuoRem64(decimalFractional)
+	if r != 0 {
+		q, err = q.SafeAdd64(1)
+		if err != nil {
+			return 0, err
+		}
+	}
+	if q.Hi != 0 {
+		return 0, errors.New("overflow error")
+	}
+	return q.Lo, nil
+}
+
+func (d Decimal) String() string {
+	whole, fraction := d.atomics.QuoRem64(decimalFractional)
+	if fraction == 0 {
+		return whole.String()
+	}
+
+	digits := math.NewUint128FromUint64(fraction).String()
+	digits = strings.Repeat("0", DecimalPlaces-len(digits)) + digits
+	return whole.String() + "." + strings.TrimRight(digits, "0")
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (d Decimal) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	d.MarshalTinyJSON(&w)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalTinyJSON supports tinyjson.Marshaler interface
+func (d Decimal) MarshalTinyJSON(w *jwriter.Writer) {
+	w.String(d.String())
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (d *Decimal) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	d.UnmarshalTinyJSON(&r)
+	r.Consumed()
+	return r.Error()
+}
+
+// UnmarshalTinyJSON supports tinyjson.Unmarshaler interface
+func (d *Decimal) UnmarshalTinyJSON(in *jlexer.Lexer) {
+	s := in.String()
+	if !in.Ok() {
+		return
+	}
+
+	decimal, err := ParseDecimal(s)
+	if err != nil {
+		in.AddError(err)
+		return
+	}
+	*d = decimal
+}
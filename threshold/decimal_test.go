@@ -0,0 +1,76 @@
+package threshold
+
+import (
+	"testing"
+
+	"github.com/CosmWasm/cosmwasm-go/std/math"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDecimalString(t *testing.T) {
+	for _, tc := range []struct {
+		input   string
+		atomics math.Uint128
+		output  string
+	}{
+		{"0", math.ZeroUint128(), "0"},
+		{"1", math.NewUint128FromUint64(1_000_000_000_000_000_000), "1"},
+		{"0.5", math.NewUint128FromUint64(500_000_000_000_000_000), "0.5"},
+		{"1.50", math.NewUint128FromUint64(1_500_000_000_000_000_000), "1.5"},
+		{"0.000000000000000001", math.NewUint128FromUint64(1), "0.000000000000000001"},
+		{"340282366920938463463.374607431768211455", math.MaxUint128(), "340282366920938463463.374607431768211455"},
+	} {
+		d, err := ParseDecimal(tc.input)
+		require.NoError(t, err, tc.input)
+		assert.Equal(t, tc.atomics, d.Atomics(), tc.input)
+		assert.Equal(t, tc.output, d.String())
+	}
+
+	for _, input := range []string{"", ".5", "1.", "1.0000000000000000001", "-1", "+1", "1e3", "0x1", "1.2.3", "340282366920938463463.374607431768211456"} {
+		_, err := ParseDecimal(input)
+		assert.Error(t, err, input)
+	}
+}
+
+func TestDecimalJSON(t *testing.T) {
+	var d Decimal
+	require.NoError(t, d.UnmarshalJSON([]byte(`"0.75"`)))
+	assert.Equal(t, 0, d.Cmp(DecimalPercent(75)))
+
+	bz, err := d.MarshalJSON()
+	require.NoError(t, err)
+	assert.Equal(t, `"0.75"`, string(bz))
+
+	assert.Error(t, d.UnmarshalJSON([]byte(`0.75`)))
+	assert.Error(t, d.UnmarshalJSON([]byte(`"0.75x"`)))
+}
+
+func TestDecimalMath(t *testing.T) {
+	for _, tc := range []struct {
+		percent uint64
+		v       uint64
+		ceil    uint64
+	}{
+		{50, 10, 5},
+		{51, 10, 6},
+		{50, 7, 4},
+		{100, 7, 7},
+		{0, 7, 0},
+		{33, 3, 1},
+		{100, ^uint64(0), ^uint64(0)},
+	} {
+		ceil, err := DecimalPercent(tc.percent).MulCeil(tc.v)
+		require.NoError(t, err)
+		assert.Equal(t, tc.ceil, ceil, "%d%% of %d", tc.percent, tc.v)
+	}
+
+	_, err := DecimalPercent(200).MulCeil(^uint64(0))
+	assert.Error(t, err)
+
+	rest, err := DecimalOne().Sub(DecimalPercent(40))
+	require.NoError(t, err)
+	assert.Equal(t, "0.6", rest.String())
+	_, err = DecimalPercent(40).Sub(DecimalOne())
+	assert.Error(t, err)
+}
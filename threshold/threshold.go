@@ -0,0 +1,222 @@
+// Package threshold provides the cw-utils Threshold for voting contracts:
+// how much of the total weight must vote yes for a proposal to pass.
+package threshold
+
+import (
+	"errors"
+)
+
+var (
+	ErrInvalidThreshold  = errors.New("Invalid voting threshold percentage, must be in the 0.5-1.0 range")
+	ErrZeroQuorum        = errors.New("Required quorum threshold cannot be zero")
+	ErrUnreachableQuorum = errors.New("Not possible to reach required quorum threshold")
+	ErrZeroWeight        = errors.New("Required weight cannot be zero")
+	ErrUnreachableWeight = errors.New("Not possible to reach required (passing) weight")
+	errThresholdVariant  = errors.New("Threshold must be exactly one of absolute_count, absolute_percentage or threshold_quorum")
+)
+
+// Threshold is an enum, exactly one variant must be set
+type Threshold struct {
+	/// AbsoluteCount passes once the yes votes reach a fixed weight
+	AbsoluteCount *AbsoluteCount `json:"absolute_count,omitempty"`
+	/// AbsolutePercentage passes once the yes votes reach a share of the total weight,
+	/// not counting abstentions
+	AbsolutePercentage *AbsolutePercentage `json:"absolute_percentage,omitempty"`
+	/// ThresholdQuorum passes once enough of the total weight voted
+	/// and the yes votes reach a share of the votes cast, not counting abstentions
+	ThresholdQuorum *ThresholdQuorum `json:"threshold_quorum,omitempty"`
+}
+
+type AbsoluteCount struct {
+	Weight uint64 `json:"weight"`
+}
+
+type AbsolutePercentage struct {
+	Percentage Decimal `json:"percentage"`
+}
+
+type ThresholdQuorum struct {
+	Threshold Decimal `json:"threshold"`
+	Quorum    Decimal `json:"quorum"`
+}
+
+// ThresholdResponse is the threshold as returned by queries, along with the total weight
+type ThresholdResponse struct {
+	AbsoluteCount      *AbsoluteCountResponse      `json:"absolute_count,omitempty"`
+	AbsolutePercentage *AbsolutePercentageResponse `json:"absolute_percentage,omitempty"`
+	ThresholdQuorum    *ThresholdQuorumResponse    `json:"threshold_quorum,omitempty"`
+}
+
+type AbsoluteCountResponse struct {
+	Weight      uint64 `json:"weight"`
+	TotalWeight uint64 `json:"total_weight"`
+}
+
+type AbsolutePercentageResponse struct {
+	Percentage  Decimal `json:"percentage"`
+	TotalWeight uint64  `json:"total_weight"`
+}
+
+type ThresholdQuorumResponse struct {
+	Threshold   Decimal `json:"threshold"`
+	Quorum      Decimal `json:"quorum"`
+	TotalWeight uint64  `json:"total_weight"`
+}
+
+// Votes is the weight cast for each option
+type Votes struct {
+	Yes     uint64 `json:"yes"`
+	No      uint64 `json:"no"`
+	Abstain uint64 `json:"abstain"`
+	Veto    uint64 `json:"veto"`
+}
+
+func (v Votes) Total() uint64 {
+	return v.Yes + v.No + v.Abstain + v.Veto
+}
+
+// against is the weight voting against, a veto counts as a no
+func (v Votes) against() uint64 {
+	return v.No + v.Veto
+}
+
+func (t Threshold) variants() int {
+	variants := 0
+	for _, set := range []bool{t.AbsoluteCount != nil, t.AbsolutePercentage != nil, t.ThresholdQuorum != nil} {
+		if set {
+			variants++
+		}
+	}
+	return variants
+}
+
+// Validate checks the threshold can be reached with the total weight of the voters
+func (t Threshold) Validate(totalWeight uint64) error {
+	if t.variants() != 1 {
+		return errThresholdVariant
+	}
+
+	switch {
+	case t.AbsoluteCount != nil:
+		switch {
+		case t.AbsoluteCount.Weight == 0:
+			return ErrZeroWeight
+		case t.AbsoluteCount.Weight > totalWeight:
+			return ErrUnreachableWeight
+		}
+		return nil
+	case t.AbsolutePercentage != nil:
+		return validThreshold(t.AbsolutePercentage.Percentage)
+	default:
+		err := validThreshold(t.ThresholdQuorum.Threshold)
+		if err != nil {
+			return err
+		}
+		return validQuorum(t.ThresholdQuorum.Quorum)
+	}
+}
+
+// validThreshold checks a passing percentage is a majority, but not more than everyone
+func validThreshold(percentage Decimal) error {
+	if percentage.Cmp(DecimalPercent(50)) < 0 || percentage.Cmp(DecimalOne()) > 0 {
+		return ErrInvalidThreshold
+	}
+	return nil
+}
+
+func validQuorum(quorum Decimal) error {
+	switch {
+	case quorum.IsZero():
+		return ErrZeroQuorum
+	case quorum.Cmp(DecimalOne()) > 0:
+		return ErrUnreachableQuorum
+	}
+	return nil
+}
+
+// votesNeeded returns the weight needed to reach the percentage of the weight, rounding up.
+// The percentage is at most one once validated, so it can't overflow
+func votesNeeded(weight uint64, percentage Decimal) uint64 {
+	needed, err := percentage.MulCeil(weight)
+	if err != nil {
+		return ^uint64(0)
+	}
+	return needed
+}
+
+// saturatingSub returns a - b, or 0 if b is greater
+func saturatingSub(a uint64, b uint64) uint64 {
+	if b > a {
+		return 0
+	}
+	return a - b
+}
+
+// IsPassed checks if the votes reached the threshold. Until voting is closed the voters
+// who didn't vote yet are counted against, once closed only the votes cast count
+func (t Threshold) IsPassed(votes Votes, totalWeight uint64, closed bool) bool {
+	switch {
+	case t.AbsoluteCount != nil:
+		return votes.Yes >= t.AbsoluteCount.Weight
+	case t.AbsolutePercentage != nil:
+		return votes.Yes >= votesNeeded(saturatingSub(totalWeight, votes.Abstain), t.AbsolutePercentage.Percentage)
+	case t.ThresholdQuorum != nil:
+		if votes.Total() < votesNeeded(totalWeight, t.ThresholdQuorum.Quorum) {
+			return false
+		}
+		return votes.Yes >= votesNeeded(t.opinions(votes, totalWeight, closed), t.ThresholdQuorum.Threshold)
+	default:
+		return false
+	}
+}
+
+// IsRejected checks if the votes against make reaching the threshold impossible
+func (t Threshold) IsRejected(votes Votes, totalWeight uint64, closed bool) bool {
+	switch {
+	case t.AbsoluteCount != nil:
+		return votes.against() > saturatingSub(totalWeight, t.AbsoluteCount.Weight)
+	case t.AbsolutePercentage != nil:
+		opinions := saturatingSub(totalWeight, votes.Abstain)
+		return votes.against() > saturatingSub(opinions, votesNeeded(opinions, t.AbsolutePercentage.Percentage))
+	case t.ThresholdQuorum != nil:
+		opinions := t.opinions(votes, totalWeight, closed)
+		if closed && votes.Total() < votesNeeded(totalWeight, t.ThresholdQuorum.Quorum) {
+			return true
+		}
+		return votes.against() > saturatingSub(opinions, votesNeeded(opinions, t.ThresholdQuorum.Threshold))
+	default:
+		return false
+	}
+}
+
+// opinions is the weight the threshold of a ThresholdQuorum applies to:
+// every voter who didn't abstain while voting is open, and the votes cast once it's closed
+func (t Threshold) opinions(votes Votes, totalWeight uint64, closed bool) uint64 {
+	if closed {
+		return saturatingSub(votes.Total(), votes.Abstain)
+	}
+	return saturatingSub(totalWeight, votes.Abstain)
+}
+
+func (t Threshold) ToResponse(totalWeight uint64) ThresholdResponse {
+	switch {
+	case t.AbsoluteCount != nil:
+		return ThresholdResponse{AbsoluteCount: &AbsoluteCountResponse{
+			Weight:      t.AbsoluteCount.Weight,
+			TotalWeight: totalWeight,
+		}}
+	case t.AbsolutePercentage != nil:
+		return ThresholdResponse{AbsolutePercentage: &AbsolutePercentageResponse{
+			Percentage:  t.AbsolutePercentage.Percentage,
+			TotalWeight: totalWeight,
+		}}
+	case t.ThresholdQuorum != nil:
+		return ThresholdResponse{ThresholdQuorum: &ThresholdQuorumResponse{
+			Threshold:   t.ThresholdQuorum.Threshold,
+			Quorum:      t.ThresholdQuorum.Quorum,
+			TotalWeight: totalWeight,
+		}}
+	default:
+		return ThresholdResponse{}
+	}
+}
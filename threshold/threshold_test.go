@@ -0,0 +1,110 @@
+package threshold
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func percent(p uint64) Decimal {
+	return DecimalPercent(p)
+}
+
+// fixtures as serialized by cw-utils
+func TestThresholdJSON(t *testing.T) {
+	for _, tc := range []struct {
+		json      string
+		threshold Threshold
+	}{
+		{`{"absolute_count":{"weight":3}}`, Threshold{AbsoluteCount: &AbsoluteCount{Weight: 3}}},
+		{`{"absolute_percentage":{"percentage":"0.5"}}`, Threshold{AbsolutePercentage: &AbsolutePercentage{Percentage: percent(50)}}},
+		{`{"threshold_quorum":{"threshold":"0.51","quorum":"0.3"}}`, Threshold{ThresholdQuorum: &ThresholdQuorum{Threshold: percent(51), Quorum: percent(30)}}},
+	} {
+		var threshold Threshold
+		require.NoError(t, threshold.UnmarshalJSON([]byte(tc.json)), tc.json)
+		assert.Equal(t, tc.threshold, threshold)
+
+		bz, err := threshold.MarshalJSON()
+		require.NoError(t, err)
+		assert.Equal(t, tc.json, string(bz))
+	}
+
+	bz, err := Threshold{ThresholdQuorum: &ThresholdQuorum{Threshold: percent(50), Quorum: percent(1)}}.ToResponse(100).MarshalJSON()
+	require.NoError(t, err)
+	assert.Equal(t, `{"threshold_quorum":{"threshold":"0.5","quorum":"0.01","total_weight":100}}`, string(bz))
+
+	var threshold Threshold
+	assert.Error(t, threshold.UnmarshalJSON([]byte(`{"absolute_percentage":{"percentage":0.5}}`)))
+}
+
+func TestThresholdValidate(t *testing.T) {
+	for _, tc := range []struct {
+		threshold Threshold
+		err       error
+	}{
+		{Threshold{AbsoluteCount: &AbsoluteCount{Weight: 5}}, nil},
+		{Threshold{AbsoluteCount: &AbsoluteCount{Weight: 0}}, ErrZeroWeight},
+		{Threshold{AbsoluteCount: &AbsoluteCount{Weight: 6}}, ErrUnreachableWeight},
+		{Threshold{AbsolutePercentage: &AbsolutePercentage{Percentage: percent(50)}}, nil},
+		{Threshold{AbsolutePercentage: &AbsolutePercentage{Percentage: percent(100)}}, nil},
+		{Threshold{AbsolutePercentage: &AbsolutePercentage{Percentage: percent(49)}}, ErrInvalidThreshold},
+		{Threshold{AbsolutePercentage: &AbsolutePercentage{Percentage: percent(101)}}, ErrInvalidThreshold},
+		{Threshold{ThresholdQuorum: &ThresholdQuorum{Threshold: percent(60), Quorum: percent(1)}}, nil},
+		{Threshold{ThresholdQuorum: &ThresholdQuorum{Threshold: percent(40), Quorum: percent(30)}}, ErrInvalidThreshold},
+		{Threshold{ThresholdQuorum: &ThresholdQuorum{Threshold: percent(60), Quorum: percent(0)}}, ErrZeroQuorum},
+		{Threshold{ThresholdQuorum: &ThresholdQuorum{Threshold: percent(60), Quorum: percent(101)}}, ErrUnreachableQuorum},
+	} {
+		assert.Equal(t, tc.err, tc.threshold.Validate(5), "%+v", tc.threshold)
+	}
+
+	assert.Error(t, Threshold{}.Validate(5))
+	assert.Error(t, Threshold{
+		AbsoluteCount:      &AbsoluteCount{Weight: 1},
+		AbsolutePercentage: &AbsolutePercentage{Percentage: percent(50)},
+	}.Validate(5))
+}
+
+func TestThresholdTally(t *testing.T) {
+	count := Threshold{AbsoluteCount: &AbsoluteCount{Weight: 3}}
+	majority := Threshold{AbsolutePercentage: &AbsolutePercentage{Percentage: percent(51)}}
+	quorum := Threshold{ThresholdQuorum: &ThresholdQuorum{Threshold: percent(50), Quorum: percent(30)}}
+
+	for _, tc := range []struct {
+		name      string
+		threshold Threshold
+		votes     Votes
+		total     uint64
+		closed    bool
+		passed    bool
+		rejected  bool
+	}{
+		{"count reached", count, Votes{Yes: 3}, 5, false, true, false},
+		{"count pending", count, Votes{Yes: 2, No: 2}, 5, false, false, false},
+		{"count unreachable", count, Votes{Yes: 2, No: 2, Veto: 1}, 5, false, false, true},
+
+		// 51% of 10 is 5.1, which rounds up to 6
+		{"majority short", majority, Votes{Yes: 5}, 10, false, false, false},
+		{"majority reached", majority, Votes{Yes: 6}, 10, false, true, false},
+		// abstaining lowers the weight the percentage applies to
+		{"majority with abstentions", majority, Votes{Yes: 5, Abstain: 1}, 10, false, true, false},
+		{"majority at the edge", majority, Votes{No: 4}, 10, false, false, false},
+		{"majority unreachable", majority, Votes{No: 5}, 10, false, false, true},
+
+		{"no quorum", quorum, Votes{Yes: 29}, 100, false, false, false},
+		{"no quorum once closed", quorum, Votes{Yes: 29}, 100, true, false, true},
+		// while open, everyone who didn't vote could still vote no
+		{"quorum open", quorum, Votes{Yes: 20, No: 5, Abstain: 5}, 100, false, false, false},
+		{"quorum closed", quorum, Votes{Yes: 20, No: 5, Abstain: 5}, 100, true, true, false},
+		{"quorum open reached", quorum, Votes{Yes: 48, Abstain: 5}, 100, false, true, false},
+		{"quorum open rejected", quorum, Votes{No: 40, Veto: 8, Abstain: 5}, 100, false, false, true},
+		{"quorum closed tie", quorum, Votes{Yes: 15, No: 15}, 100, true, true, false},
+		{"quorum closed rejected", quorum, Votes{Yes: 14, No: 16}, 100, true, false, true},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			require.NoError(t, tc.threshold.Validate(tc.total))
+			assert.Equal(t, tc.passed, tc.threshold.IsPassed(tc.votes, tc.total, tc.closed), "passed")
+			assert.Equal(t, tc.rejected, tc.threshold.IsRejected(tc.votes, tc.total, tc.closed), "rejected")
+		})
+	}
+}
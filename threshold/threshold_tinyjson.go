@@ -0,0 +1,765 @@
+// Code generated by tinyjson for marshaling/unmarshaling. DO NOT EDIT.
+
+package threshold
+
+import (
+	tinyjson "github.com/CosmWasm/tinyjson"
+	jlexer "github.com/CosmWasm/tinyjson/jlexer"
+	jwriter "github.com/CosmWasm/tinyjson/jwriter"
+)
+
+// suppress unused package warning
+var (
+	_ *jlexer.Lexer
+	_ *jwriter.Writer
+	_ tinyjson.Marshaler
+)
+
+func tinyjson8be73273DecodeGithubComJackalLabsBurrowContractsThreshold(in *jlexer.Lexer, out *Votes) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "yes":
+			out.Yes = uint64(in.Uint64())
+		case "no":
+			out.No = uint64(in.Uint64())
+		case "abstain":
+			out.Abstain = uint64(in.Uint64())
+		case "veto":
+			out.Veto = uint64(in.Uint64())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func tinyjson8be73273EncodeGithubComJackalLabsBurrowContractsThreshold(out *jwriter.Writer, in Votes) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"yes\":"
+		out.RawString(prefix[1:])
+		out.Uint64(uint64(in.Yes))
+	}
+	{
+		const prefix string = ",\"no\":"
+		out.RawString(prefix)
+		out.Uint64(uint64(in.No))
+	}
+	{
+		const prefix string = ",\"abstain\":"
+		out.RawString(prefix)
+		out.Uint64(uint64(in.Abstain))
+	}
+	{
+		const prefix string = ",\"veto\":"
+		out.RawString(prefix)
+		out.Uint64(uint64(in.Veto))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v Votes) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	tinyjson8be73273EncodeGithubComJackalLabsBurrowContractsThreshold(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalTinyJSON supports tinyjson.Marshaler interface
+func (v Votes) MarshalTinyJSON(w *jwriter.Writer) {
+	tinyjson8be73273EncodeGithubComJackalLabsBurrowContractsThreshold(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *Votes) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	tinyjson8be73273DecodeGithubComJackalLabsBurrowContractsThreshold(&r, v)
+	return r.Error()
+}
+
+// UnmarshalTinyJSON supports tinyjson.Unmarshaler interface
+func (v *Votes) UnmarshalTinyJSON(l *jlexer.Lexer) {
+	tinyjson8be73273DecodeGithubComJackalLabsBurrowContractsThreshold(l, v)
+}
+func tinyjson8be73273DecodeGithubComJackalLabsBurrowContractsThreshold1(in *jlexer.Lexer, out *ThresholdResponse) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "absolute_count":
+			if in.IsNull() {
+				in.Skip()
+				out.AbsoluteCount = nil
+			} else {
+				if out.AbsoluteCount == nil {
+					out.AbsoluteCount = new(AbsoluteCountResponse)
+				}
+				(*out.AbsoluteCount).UnmarshalTinyJSON(in)
+			}
+		case "absolute_percentage":
+			if in.IsNull() {
+				in.Skip()
+				out.AbsolutePercentage = nil
+			} else {
+				if out.AbsolutePercentage == nil {
+					out.AbsolutePercentage = new(AbsolutePercentageResponse)
+				}
+				(*out.AbsolutePercentage).UnmarshalTinyJSON(in)
+			}
+		case "threshold_quorum":
+			if in.IsNull() {
+				in.Skip()
+				out.ThresholdQuorum = nil
+			} else {
+				if out.ThresholdQuorum == nil {
+					out.ThresholdQuorum = new(ThresholdQuorumResponse)
+				}
+				(*out.ThresholdQuorum).UnmarshalTinyJSON(in)
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func tinyjson8be73273EncodeGithubComJackalLabsBurrowContractsThreshold1(out *jwriter.Writer, in ThresholdResponse) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	if in.AbsoluteCount != nil {
+		const prefix string = ",\"absolute_count\":"
+		first = false
+		out.RawString(prefix[1:])
+		(*in.AbsoluteCount).MarshalTinyJSON(out)
+	}
+	if in.AbsolutePercentage != nil {
+		const prefix string = ",\"absolute_percentage\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		(*in.AbsolutePercentage).MarshalTinyJSON(out)
+	}
+	if in.ThresholdQuorum != nil {
+		const prefix string = ",\"threshold_quorum\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		(*in.ThresholdQuorum).MarshalTinyJSON(out)
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v ThresholdResponse) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	tinyjson8be73273EncodeGithubComJackalLabsBurrowContractsThreshold1(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalTinyJSON supports tinyjson.Marshaler interface
+func (v ThresholdResponse) MarshalTinyJSON(w *jwriter.Writer) {
+	tinyjson8be73273EncodeGithubComJackalLabsBurrowContractsThreshold1(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *ThresholdResponse) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	tinyjson8be73273DecodeGithubComJackalLabsBurrowContractsThreshold1(&r, v)
+	return r.Error()
+}
+
+// UnmarshalTinyJSON supports tinyjson.Unmarshaler interface
+func (v *ThresholdResponse) UnmarshalTinyJSON(l *jlexer.Lexer) {
+	tinyjson8be73273DecodeGithubComJackalLabsBurrowContractsThreshold1(l, v)
+}
+func tinyjson8be73273DecodeGithubComJackalLabsBurrowContractsThreshold2(in *jlexer.Lexer, out *ThresholdQuorumResponse) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "threshold":
+			(out.Threshold).UnmarshalTinyJSON(in)
+		case "quorum":
+			(out.Quorum).UnmarshalTinyJSON(in)
+		case "total_weight":
+			out.TotalWeight = uint64(in.Uint64())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func tinyjson8be73273EncodeGithubComJackalLabsBurrowContractsThreshold2(out *jwriter.Writer, in ThresholdQuorumResponse) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"threshold\":"
+		out.RawString(prefix[1:])
+		(in.Threshold).MarshalTinyJSON(out)
+	}
+	{
+		const prefix string = ",\"quorum\":"
+		out.RawString(prefix)
+		(in.Quorum).MarshalTinyJSON(out)
+	}
+	{
+		const prefix string = ",\"total_weight\":"
+		out.RawString(prefix)
+		out.Uint64(uint64(in.TotalWeight))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v ThresholdQuorumResponse) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	tinyjson8be73273EncodeGithubComJackalLabsBurrowContractsThreshold2(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalTinyJSON supports tinyjson.Marshaler interface
+func (v ThresholdQuorumResponse) MarshalTinyJSON(w *jwriter.Writer) {
+	tinyjson8be73273EncodeGithubComJackalLabsBurrowContractsThreshold2(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *ThresholdQuorumResponse) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	tinyjson8be73273DecodeGithubComJackalLabsBurrowContractsThreshold2(&r, v)
+	return r.Error()
+}
+
+// UnmarshalTinyJSON supports tinyjson.Unmarshaler interface
+func (v *ThresholdQuorumResponse) UnmarshalTinyJSON(l *jlexer.Lexer) {
+	tinyjson8be73273DecodeGithubComJackalLabsBurrowContractsThreshold2(l, v)
+}
+func tinyjson8be73273DecodeGithubComJackalLabsBurrowContractsThreshold3(in *jlexer.Lexer, out *ThresholdQuorum) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "threshold":
+			(out.Threshold).UnmarshalTinyJSON(in)
+		case "quorum":
+			(out.Quorum).UnmarshalTinyJSON(in)
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func tinyjson8be73273EncodeGithubComJackalLabsBurrowContractsThreshold3(out *jwriter.Writer, in ThresholdQuorum) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"threshold\":"
+		out.RawString(prefix[1:])
+		(in.Threshold).MarshalTinyJSON(out)
+	}
+	{
+		const prefix string = ",\"quorum\":"
+		out.RawString(prefix)
+		(in.Quorum).MarshalTinyJSON(out)
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v ThresholdQuorum) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	tinyjson8be73273EncodeGithubComJackalLabsBurrowContractsThreshold3(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalTinyJSON supports tinyjson.Marshaler interface
+func (v ThresholdQuorum) MarshalTinyJSON(w *jwriter.Writer) {
+	tinyjson8be73273EncodeGithubComJackalLabsBurrowContractsThreshold3(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *ThresholdQuorum) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	tinyjson8be73273DecodeGithubComJackalLabsBurrowContractsThreshold3(&r, v)
+	return r.Error()
+}
+
+// UnmarshalTinyJSON supports tinyjson.Unmarshaler interface
+func (v *ThresholdQuorum) UnmarshalTinyJSON(l *jlexer.Lexer) {
+	tinyjson8be73273DecodeGithubComJackalLabsBurrowContractsThreshold3(l, v)
+}
+func tinyjson8be73273DecodeGithubComJackalLabsBurrowContractsThreshold4(in *jlexer.Lexer, out *Threshold) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "absolute_count":
+			if in.IsNull() {
+				in.Skip()
+				out.AbsoluteCount = nil
+			} else {
+				if out.AbsoluteCount == nil {
+					out.AbsoluteCount = new(AbsoluteCount)
+				}
+				(*out.AbsoluteCount).UnmarshalTinyJSON(in)
+			}
+		case "absolute_percentage":
+			if in.IsNull() {
+				in.Skip()
+				out.AbsolutePercentage = nil
+			} else {
+				if out.AbsolutePercentage == nil {
+					out.AbsolutePercentage = new(AbsolutePercentage)
+				}
+				(*out.AbsolutePercentage).UnmarshalTinyJSON(in)
+			}
+		case "threshold_quorum":
+			if in.IsNull() {
+				in.Skip()
+				out.ThresholdQuorum = nil
+			} else {
+				if out.ThresholdQuorum == nil {
+					out.ThresholdQuorum = new(ThresholdQuorum)
+				}
+				(*out.ThresholdQuorum).UnmarshalTinyJSON(in)
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func tinyjson8be73273EncodeGithubComJackalLabsBurrowContractsThreshold4(out *jwriter.Writer, in Threshold) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	if in.AbsoluteCount != nil {
+		const prefix string = ",\"absolute_count\":"
+		first = false
+		out.RawString(prefix[1:])
+		(*in.AbsoluteCount).MarshalTinyJSON(out)
+	}
+	if in.AbsolutePercentage != nil {
+		const prefix string = ",\"absolute_percentage\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		(*in.AbsolutePercentage).MarshalTinyJSON(out)
+	}
+	if in.ThresholdQuorum != nil {
+		const prefix string = ",\"threshold_quorum\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		(*in.ThresholdQuorum).MarshalTinyJSON(out)
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v Threshold) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	tinyjson8be73273EncodeGithubComJackalLabsBurrowContractsThreshold4(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalTinyJSON supports tinyjson.Marshaler interface
+func (v Threshold) MarshalTinyJSON(w *jwriter.Writer) {
+	tinyjson8be73273EncodeGithubComJackalLabsBurrowContractsThreshold4(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *Threshold) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	tinyjson8be73273DecodeGithubComJackalLabsBurrowContractsThreshold4(&r, v)
+	return r.Error()
+}
+
+// UnmarshalTinyJSON supports tinyjson.Unmarshaler interface
+func (v *Threshold) UnmarshalTinyJSON(l *jlexer.Lexer) {
+	tinyjson8be73273DecodeGithubComJackalLabsBurrowContractsThreshold4(l, v)
+}
+func tinyjson8be73273DecodeGithubComJackalLabsBurrowContractsThreshold5(in *jlexer.Lexer, out *AbsolutePercentageResponse) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "percentage":
+			(out.Percentage).UnmarshalTinyJSON(in)
+		case "total_weight":
+			out.TotalWeight = uint64(in.Uint64())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func tinyjson8be73273EncodeGithubComJackalLabsBurrowContractsThreshold5(out *jwriter.Writer, in AbsolutePercentageResponse) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"percentage\":"
+		out.RawString(prefix[1:])
+		(in.Percentage).MarshalTinyJSON(out)
+	}
+	{
+		const prefix string = ",\"total_weight\":"
+		out.RawString(prefix)
+		out.Uint64(uint64(in.TotalWeight))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v AbsolutePercentageResponse) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	tinyjson8be73273EncodeGithubComJackalLabsBurrowContractsThreshold5(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalTinyJSON supports tinyjson.Marshaler interface
+func (v AbsolutePercentageResponse) MarshalTinyJSON(w *jwriter.Writer) {
+	tinyjson8be73273EncodeGithubComJackalLabsBurrowContractsThreshold5(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *AbsolutePercentageResponse) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	tinyjson8be73273DecodeGithubComJackalLabsBurrowContractsThreshold5(&r, v)
+	return r.Error()
+}
+
+// UnmarshalTinyJSON supports tinyjson.Unmarshaler interface
+func (v *AbsolutePercentageResponse) UnmarshalTinyJSON(l *jlexer.Lexer) {
+	tinyjson8be73273DecodeGithubComJackalLabsBurrowContractsThreshold5(l, v)
+}
+func tinyjson8be73273DecodeGithubComJackalLabsBurrowContractsThreshold6(in *jlexer.Lexer, out *AbsolutePercentage) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "percentage":
+			(out.Percentage).UnmarshalTinyJSON(in)
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func tinyjson8be73273EncodeGithubComJackalLabsBurrowContractsThreshold6(out *jwriter.Writer, in AbsolutePercentage) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"percentage\":"
+		out.RawString(prefix[1:])
+		(in.Percentage).MarshalTinyJSON(out)
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v AbsolutePercentage) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	tinyjson8be73273EncodeGithubComJackalLabsBurrowContractsThreshold6(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalTinyJSON supports tinyjson.Marshaler interface
+func (v AbsolutePercentage) MarshalTinyJSON(w *jwriter.Writer) {
+	tinyjson8be73273EncodeGithubComJackalLabsBurrowContractsThreshold6(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *AbsolutePercentage) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	tinyjson8be73273DecodeGithubComJackalLabsBurrowContractsThreshold6(&r, v)
+	return r.Error()
+}
+
+// UnmarshalTinyJSON supports tinyjson.Unmarshaler interface
+func (v *AbsolutePercentage) UnmarshalTinyJSON(l *jlexer.Lexer) {
+	tinyjson8be73273DecodeGithubComJackalLabsBurrowContractsThreshold6(l, v)
+}
+func tinyjson8be73273DecodeGithubComJackalLabsBurrowContractsThreshold7(in *jlexer.Lexer, out *AbsoluteCountResponse) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "weight":
+			out.Weight = uint64(in.Uint64())
+		case "total_weight":
+			out.TotalWeight = uint64(in.Uint64())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func tinyjson8be73273EncodeGithubComJackalLabsBurrowContractsThreshold7(out *jwriter.Writer, in AbsoluteCountResponse) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"weight\":"
+		out.RawString(prefix[1:])
+		out.Uint64(uint64(in.Weight))
+	}
+	{
+		const prefix string = ",\"total_weight\":"
+		out.RawString(prefix)
+		out.Uint64(uint64(in.TotalWeight))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v AbsoluteCountResponse) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	tinyjson8be73273EncodeGithubComJackalLabsBurrowContractsThreshold7(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalTinyJSON supports tinyjson.Marshaler interface
+func (v AbsoluteCountResponse) MarshalTinyJSON(w *jwriter.Writer) {
+	tinyjson8be73273EncodeGithubComJackalLabsBurrowContractsThreshold7(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *AbsoluteCountResponse) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	tinyjson8be73273DecodeGithubComJackalLabsBurrowContractsThreshold7(&r, v)
+	return r.Error()
+}
+
+// UnmarshalTinyJSON supports tinyjson.Unmarshaler interface
+func (v *AbsoluteCountResponse) UnmarshalTinyJSON(l *jlexer.Lexer) {
+	tinyjson8be73273DecodeGithubComJackalLabsBurrowContractsThreshold7(l, v)
+}
+func tinyjson8be73273DecodeGithubComJackalLabsBurrowContractsThreshold8(in *jlexer.Lexer, out *AbsoluteCount) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "weight":
+			out.Weight = uint64(in.Uint64())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func tinyjson8be73273EncodeGithubComJackalLabsBurrowContractsThreshold8(out *jwriter.Writer, in AbsoluteCount) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"weight\":"
+		out.RawString(prefix[1:])
+		out.Uint64(uint64(in.Weight))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v AbsoluteCount) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	tinyjson8be73273EncodeGithubComJackalLabsBurrowContractsThreshold8(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalTinyJSON supports tinyjson.Marshaler interface
+func (v AbsoluteCount) MarshalTinyJSON(w *jwriter.Writer) {
+	tinyjson8be73273EncodeGithubComJackalLabsBurrowContractsThreshold8(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *AbsoluteCount) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	tinyjson8be73273DecodeGithubComJackalLabsBurrowContractsThreshold8(&r, v)
+	return r.Error()
+}
+
+// UnmarshalTinyJSON supports tinyjson.Unmarshaler interface
+func (v *AbsoluteCount) UnmarshalTinyJSON(l *jlexer.Lexer) {
+	tinyjson8be73273DecodeGithubComJackalLabsBurrowContractsThreshold8(l, v)
+}